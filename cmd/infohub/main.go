@@ -7,8 +7,11 @@ import (
 	"log"
 	"net"
 	"net/http"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -22,7 +25,6 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	auth "github.com/eclipse-xfsc/microservice-core-go/pkg/auth"
-	cache "github.com/eclipse-xfsc/microservice-core-go/pkg/cache"
 	goadec "github.com/eclipse-xfsc/microservice-core-go/pkg/goadec"
 	graceful "github.com/eclipse-xfsc/microservice-core-go/pkg/graceful"
 	goahealth "github.com/eclipse-xfsc/trusted-info-hub/gen/health"
@@ -31,14 +33,26 @@ import (
 	goaopenapisrv "github.com/eclipse-xfsc/trusted-info-hub/gen/http/openapi/server"
 	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
 	"github.com/eclipse-xfsc/trusted-info-hub/gen/openapi"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/cache"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/policy"
-	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/signer"
+	signerclient "github.com/eclipse-xfsc/trusted-info-hub/internal/clients/signer"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/transport"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/vault"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/config"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/keyfetcher"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/status"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/didresolver"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/localsigner"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/scheduler"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/schema"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/health"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/trust"
 )
 
 var Version = "0.0.0+development"
@@ -81,33 +95,214 @@ func main() {
 
 	httpClient := httpClient()
 
-	oauthClient := httpClient
+	var tokenSource oauth2.TokenSource
 	if cfg.Auth.Enabled {
-		// Create an HTTP Client which automatically issues and carries an OAuth2 token.
-		// The token will auto-refresh when its expiration is near.
+		// Create a token source which automatically issues OAuth2 tokens for the
+		// upstream services. The token will auto-refresh when its expiration is near.
 		oauthCtx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
-		oauthClient = newOAuth2Client(oauthCtx, cfg.OAuth.ClientID, cfg.OAuth.ClientSecret, cfg.OAuth.TokenURL)
+		tokenSource = newTokenSource(oauthCtx, cfg.OAuth.ClientID, cfg.OAuth.ClientSecret, cfg.OAuth.TokenURL)
+	}
+
+	// Requests to the upstream services are retried and stopped by circuit
+	// breakers, whose state is exposed as metrics and by the readiness probe.
+	var upstreams []health.Checker
+	upstreamTransport := func(name string, timeout time.Duration, opts ...transport.Option) *transport.Transport {
+		t := transport.New(name, append([]transport.Option{
+			transport.WithBase(httpClient.Transport),
+			transport.WithTimeout(timeout),
+			transport.WithRetries(cfg.Upstream.Retries),
+			transport.WithBackoff(cfg.Upstream.BackoffMin, cfg.Upstream.BackoffMax),
+			transport.WithBreaker(cfg.Upstream.BreakerThreshold, cfg.Upstream.BreakerTimeout),
+		}, opts...)...)
+		upstreams = append(upstreams, t)
+		return t
 	}
 
 	credentials := credential.New(cfg.Credential.IssuerURI, httpClient)
 
-	// create policy client
-	policy := policy.New(cfg.Policy.Addr, policy.WithHTTPClient(oauthClient))
+	// create policy client, policy evaluations don't change state and are retried
+	policyTransport := upstreamTransport("policy", cfg.Policy.Timeout, transport.WithIdempotentMethods(http.MethodPost))
+	policy := policy.New(cfg.Policy.Addr, policy.WithHTTPClient(upstreamClient(policyTransport, tokenSource)))
 
 	// create cache client
-	cache := cache.New(cfg.Cache.Addr, cache.WithHTTPClient(oauthClient))
+	cacheTransport := upstreamTransport("cache", cfg.Cache.Timeout)
+	cache := cache.New(cfg.Cache.Addr, cache.WithHTTPClient(upstreamClient(cacheTransport, tokenSource)))
+
+	// keys of issuers and holders are resolved from DIDs and web key URLs
+	resolver := didresolver.New(
+		didresolver.WithHTTPClient(httpClient),
+		didresolver.WithUniversalResolver(cfg.DID.ResolverURL),
+		didresolver.WithCacheTTL(cfg.DID.CacheTTL),
+		didresolver.WithNegativeCacheTTL(cfg.DID.NegativeCacheTTL),
+	)
+	keyFetcher := keyfetcher.New(httpClient, resolver, cfg.DID.WebKeyURLs...)
+
+	// exports are signed by the signer service, in-process with local keys
+	// or with Vault Transit keys, in which case imports are verified
+	// in-process as well
+	var signer interface {
+		infohub.Signer
+		status.CredentialVerifier
+	}
+	prover := credential.NewProver(
+		credential.WithDocumentLoader(credential.NewDocumentLoader(httpClient)),
+		credential.WithVerifier(credential.NewVerifier(keyFetcher, httpClient)),
+	)
+	switch cfg.Signer.Type {
+	case "remote":
+		if cfg.Signer.Addr == "" {
+			logger.Fatal("signer address is required for remote signer")
+		}
+		// signing doesn't change state, so signing requests are retried
+		signerTransport := upstreamTransport("signer", cfg.Signer.Timeout, transport.WithIdempotentMethods(http.MethodPost))
+		signer = signerclient.New(cfg.Signer.Addr, signerclient.WithHTTPClient(upstreamClient(signerTransport, tokenSource)))
+	case "local":
+		signer, err = localsigner.New(cfg.Signer.KeysPath, prover)
+		if err != nil {
+			logger.Fatal("error creating local signer", zap.Error(err))
+		}
+	case "vault":
+		if cfg.Signer.VaultAddr == "" {
+			logger.Fatal("vault address is required for vault signer")
+		}
+		if cfg.Signer.VaultToken == "" {
+			logger.Fatal("vault token is required for vault signer")
+		}
+		vaultTransport := upstreamTransport("vault", cfg.Signer.Timeout, transport.WithIdempotentMethods(http.MethodPost))
+		signer = vault.New(
+			cfg.Signer.VaultAddr,
+			cfg.Signer.VaultToken,
+			prover,
+			vault.WithHTTPClient(upstreamClient(vaultTransport, nil)),
+		)
+	default:
+		logger.Fatal("unknown signer type", zap.String("type", cfg.Signer.Type))
+	}
+
+	// imported presentations are verified by the signer service, unless
+	// local verification is configured
+	var (
+		verifier           infohub.Verifier
+		credentialVerifier status.CredentialVerifier = signer
+	)
+	switch cfg.Import.Verifier {
+	case "signer":
+	case "local":
+		localVerifier := credential.NewVerifier(keyFetcher, httpClient)
+		verifier, credentialVerifier = localVerifier, localVerifier
+	default:
+		logger.Fatal("unknown import verifier", zap.String("verifier", cfg.Import.Verifier))
+	}
+
+	// JWT encoded presentations and credentials are always verified in-process
+	jwtParser := jwtvc.NewParser(keyFetcher, jwtvc.WithKeyBindingMaxAge(cfg.Import.KeyBindingMaxAge))
+
+	// status lists of imported credentials are verified like the credentials
+	var statusChecker infohub.StatusChecker
+	if cfg.Import.StatusCheck {
+		statusChecker = status.New(
+			httpClient,
+			logger,
+			status.WithCredentialVerifier(credentialVerifier),
+			status.WithCacheTTL(cfg.Import.StatusCacheTTL),
+			status.WithFailOpen(cfg.Import.StatusFailOpen),
+		)
+	}
+
+	// credentials are imported only from trusted issuers,
+	// if any source of trust anchors is configured
+	var anchors []trust.Anchors
+	if len(cfg.Trust.Issuers) > 0 {
+		anchors = append(anchors, trust.NewStatic(cfg.Trust.Issuers...))
+	}
+	if cfg.Trust.Mongo {
+		anchors = append(anchors, storage)
+	}
+	if cfg.Trust.ListSource != "" {
+		list, err := trust.NewList(context.Background(), cfg.Trust.ListSource, cfg.Trust.RefreshInterval, httpClient, logger)
+		if err != nil {
+			logger.Fatal("error loading trust list", zap.Error(err))
+		}
+		anchors = append(anchors, list)
+	}
+	var trustAnchors infohub.TrustAnchors
+	if len(anchors) > 0 {
+		trustAnchors = trust.Any(anchors...)
+	}
 
-	// create signer client
-	signer := signer.New(cfg.Signer.Addr, signer.WithHTTPClient(oauthClient))
+	// JSON schemas are loaded from MongoDB and local files
+	var schemaSources []schema.Source
+	if cfg.Schema.Mongo {
+		schemaSources = append(schemaSources, storage)
+	}
+	if cfg.Schema.Dir != "" {
+		dir, err := schema.NewDir(cfg.Schema.Dir)
+		if err != nil {
+			logger.Fatal("error loading schema files", zap.Error(err))
+		}
+		schemaSources = append(schemaSources, dir)
+	}
+	schemaValidator := schema.New(cfg.Schema.CacheTTL, schemaSources...)
+
+	// imported credentials are recorded by their hashes, so that
+	// credentials which are imported again are detected
+	hasher, err := credential.NewHasher(cfg.Import.HashAlgorithm, httpClient)
+	if err != nil {
+		logger.Fatal("invalid import hash algorithm", zap.Error(err))
+	}
+
+	importNamespaces := make(map[string][]string, len(cfg.Import.AllowedNamespaces))
+	for client, namespaces := range cfg.Import.AllowedNamespaces {
+		importNamespaces[client] = strings.Fields(namespaces)
+	}
+
+	infohubOpts := []infohub.Option{
+		infohub.WithPolicyWorkers(cfg.Export.PolicyWorkers),
+		infohub.WithExportJobTimeout(cfg.Export.JobTimeout),
+		infohub.WithAdminScope(cfg.Auth.AdminScope),
+		infohub.WithImportScope(cfg.Import.RequiredScope),
+		infohub.WithImportNamespaces(importNamespaces),
+		infohub.WithImportPolicies(infohub.ImportPolicies{
+			Default:    cfg.Import.Policy,
+			Namespaces: cfg.Import.NamespacePolicies,
+			Types:      cfg.Import.TypePolicies,
+		}),
+		infohub.WithVerifier(verifier),
+		infohub.WithJWTParser(jwtParser),
+		infohub.WithTrustAnchors(trustAnchors),
+		infohub.WithStatusChecker(statusChecker),
+		infohub.WithImportRegistry(storage),
+		infohub.WithCredentialHasher(hasher),
+		infohub.WithSchemaValidator(schemaValidator),
+		infohub.WithImportSchemaValidation(cfg.Import.SchemaValidation),
+	}
+	if cfg.Import.ChallengeRequired {
+		infohubOpts = append(infohubOpts, infohub.WithImportChallenge(cfg.Import.ChallengeDomain, cfg.Import.ChallengeTTL))
+	}
 
 	// create services
 	var (
-		infohubSvc goainfohub.Service
+		infohubSvc *infohub.Service
 		healthSvc  goahealth.Service
 	)
 	{
-		infohubSvc = infohub.New(storage, policy, cache, credentials, signer, logger)
-		healthSvc = health.New(Version)
+		infohubSvc = infohub.New(
+			storage,
+			policy,
+			cache,
+			credentials,
+			signer,
+			logger,
+			infohubOpts...,
+		)
+		healthSvc = health.New(Version, upstreams...)
+	}
+
+	// export jobs of stopped instances are never finished
+	if n, err := infohubSvc.FailStaleExportJobs(context.Background()); err != nil {
+		logger.Error("error failing interrupted export jobs", zap.Error(err))
+	} else if n > 0 {
+		logger.Info("interrupted export jobs failed", zap.Int64("jobs", n))
 	}
 
 	// create endpoints
@@ -128,7 +323,7 @@ func main() {
 	// see goa.design/implement/encoding.
 	var (
 		dec = goahttp.RequestDecoder
-		enc = goahttp.ResponseEncoder
+		enc = service.ResponseEncoder
 	)
 
 	// Build the service HTTP request multiplexer and configure it to serve
@@ -167,6 +362,9 @@ func main() {
 		if err != nil {
 			logger.Fatal("failed to create authentication middleware", zap.Error(err))
 		}
+		// the last applied middleware runs first, so the token
+		// is verified before its claims are placed in the context
+		infohubServer.Use(claims.Middleware())
 		infohubServer.Use(m.Handler())
 	}
 
@@ -195,10 +393,25 @@ func main() {
 		}
 		return errors.New("server stopped successfully")
 	})
+	if cfg.Scheduler.Enabled {
+		g.Go(func() error {
+			return scheduler.New(
+				storage,
+				infohubSvc,
+				instanceID(),
+				logger,
+				scheduler.WithInterval(cfg.Scheduler.Interval),
+				scheduler.WithLeaseTTL(cfg.Scheduler.LeaseTTL),
+			).Run(ctx)
+		})
+	}
 	if err := g.Wait(); err != nil {
 		logger.Error("run group stopped", zap.Error(err))
 	}
 
+	// wait for export jobs running in background
+	infohubSvc.Wait()
+
 	logger.Info("bye bye")
 }
 
@@ -239,14 +452,32 @@ func httpClient() *http.Client {
 	}
 }
 
-func newOAuth2Client(ctx context.Context, cID, cSecret, tokenURL string) *http.Client {
+// instanceID returns a unique identifier of the running service instance.
+func instanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "infohub"
+	}
+	return hostname + "-" + uuid.NewString()
+}
+
+func newTokenSource(ctx context.Context, cID, cSecret, tokenURL string) oauth2.TokenSource {
 	oauthCfg := clientcredentials.Config{
 		ClientID:     cID,
 		ClientSecret: cSecret,
 		TokenURL:     tokenURL,
 	}
 
-	return oauthCfg.Client(ctx)
+	return oauthCfg.TokenSource(ctx)
+}
+
+// upstreamClient returns the HTTP client of an upstream service, which
+// carries OAuth2 tokens of the token source, if it's not nil.
+func upstreamClient(t *transport.Transport, ts oauth2.TokenSource) *http.Client {
+	if ts == nil {
+		return &http.Client{Transport: t}
+	}
+	return &http.Client{Transport: &oauth2.Transport{Source: ts, Base: t}}
 }
 
 func exposeMetrics(addr string, logger *zap.Logger) {
@@ -19,25 +19,150 @@ var _ = Service("infohub", func() {
 	Description("Information Hub Service enables exporting and importing information.")
 
 	Method("Export", func() {
-		Description("Export returns data signed as Verifiable Presentation.")
+		Description("Export returns data signed as Verifiable Presentation. The Accept header selects a JSON-LD presentation, a VC-JWT presentation (application/vp+jwt) or an SD-JWT VC (application/vc+sd-jwt).")
 		Payload(ExportRequest)
-		Result(Any)
+		Result(ExportResult)
 		HTTP(func() {
 			GET("/v1/export/{exportName}")
+			POST("/v1/export/{exportName}")
+			MapParams("query")
+			Header("accept:Accept")
+			Body("parameters")
+			Response(StatusAccepted, func() {
+				Tag("status", "accepted")
+				Header("location:Location")
+				Body("result")
+			})
+			Response(StatusOK, func() {
+				Header("contentType:Content-Type")
+				Header("location:Location")
+				Header("stale:X-Export-Stale")
+				Header("age:Age")
+				Body("result")
+			})
+		})
+	})
+
+	Method("GetExportJob", func() {
+		Description("GetExportJob returns the progress of an asynchronous export job.")
+		Payload(ExportJobRequest)
+		Result(ExportJob)
+		HTTP(func() {
+			GET("/v1/export/{exportName}/jobs/{id}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("ListExports", func() {
+		Description("ListExports returns all export configurations.")
+		Payload(Empty)
+		Result(ArrayOf(ExportConfiguration))
+		HTTP(func() {
+			GET("/v1/exports")
+			Response(StatusOK)
+		})
+	})
+
+	Method("CreateExport", func() {
+		Description("CreateExport stores a new export configuration.")
+		Payload(ExportConfiguration)
+		Result(ExportConfiguration)
+		HTTP(func() {
+			POST("/v1/exports")
+			Response(StatusCreated)
+		})
+	})
+
+	Method("GetExport", func() {
+		Description("GetExport returns the export configuration with the given name.")
+		Payload(ExportConfigurationRequest)
+		Result(ExportConfiguration)
+		HTTP(func() {
+			GET("/v1/exports/{exportName}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("UpdateExport", func() {
+		Description("UpdateExport replaces the export configuration with the given name.")
+		Payload(ExportConfiguration)
+		Result(ExportConfiguration)
+		HTTP(func() {
+			PUT("/v1/exports/{exportName}")
 			Response(StatusOK)
 		})
 	})
 
+	Method("DeleteExport", func() {
+		Description("DeleteExport removes the export configuration with the given name.")
+		Payload(ExportConfigurationRequest)
+		Result(Empty)
+		HTTP(func() {
+			DELETE("/v1/exports/{exportName}")
+			Response(StatusNoContent)
+		})
+	})
+
 	Method("Import", func() {
 		Description("Import the given data wrapped as Verifiable Presentation into the Cache.")
 		Payload(ImportRequest)
 		Result(ImportResult)
 		HTTP(func() {
 			POST("/v1/import")
+			Param("namespace")
+			Param("scope")
+			Param("key")
+			Param("keyFrom")
+			Param("atomic")
+			Header("idempotencyKey:Idempotency-Key")
 			Body("data")
 			Response(StatusOK)
 		})
 	})
+
+	Method("ImportChallenge", func() {
+		Description("ImportChallenge issues a single-use challenge, which must be included in the proof of an imported Verifiable Presentation.")
+		Payload(Empty)
+		Result(ChallengeResult)
+		HTTP(func() {
+			GET("/v1/import/challenge")
+			Response(StatusOK)
+		})
+	})
+
+	Method("ListImports", func() {
+		Description("ListImports returns the records of imported credentials, most recent imports first.")
+		Payload(ImportRecordsRequest)
+		Result(ArrayOf(ImportRecord))
+		HTTP(func() {
+			GET("/v1/imports")
+			Param("issuer")
+			Param("holder")
+			Param("subjectId")
+			Param("limit")
+			Response(StatusOK)
+		})
+	})
+
+	Method("GetImport", func() {
+		Description("GetImport returns the record of an imported credential.")
+		Payload(ImportRecordRequest)
+		Result(ImportRecord)
+		HTTP(func() {
+			GET("/v1/import/{id}")
+			Response(StatusOK)
+		})
+	})
+
+	Method("DeleteImport", func() {
+		Description("DeleteImport removes an imported credential subject from the Cache together with its import record.")
+		Payload(ImportRecordRequest)
+		Result(Empty)
+		HTTP(func() {
+			DELETE("/v1/import/{id}")
+			Response(StatusNoContent)
+		})
+	})
 })
 
 var _ = Service("health", func() {
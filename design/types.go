@@ -7,13 +7,216 @@ var ExportRequest = Type("ExportRequest", func() {
 	Field(1, "exportName", String, "Name of export to be performed.", func() {
 		Example("testexport")
 	})
+	Field(2, "query", MapOf(String, String), "Export parameters given as query string.", func() {
+		Example(map[string]string{"participantId": "did:web:participant.example.com"})
+	})
+	Field(3, "parameters", MapOf(String, Any), "Export parameters given in the request body.", func() {
+		Example(map[string]any{"participantId": "did:web:participant.example.com"})
+	})
+	Field(4, "accept", String, "Media types of the requested export format.", func() {
+		Example("application/vp+jwt")
+	})
 	Required("exportName")
 })
 
+var ExportResult = Type("ExportResult", func() {
+	Field(1, "result", Any, "Data signed as Verifiable Presentation or a message that the export request is accepted.")
+	Field(2, "status", String, "Status of the export request.", func() {
+		Enum("completed", "accepted")
+	})
+	Field(3, "location", String, "Location of the export job which is started when the export data is not available.", func() {
+		Example("/v1/export/testexport/jobs/585a999a-f36d-419d-bed3-8ebfa5bb79c9")
+	})
+	Field(4, "stale", Boolean, "Stale is true when the last signed presentation is returned while the export data is evaluated again.")
+	Field(5, "age", Int, "Age in seconds of a stale presentation.", func() {
+		Example(120)
+	})
+	Field(6, "contentType", String, "Media type of the exported data.", func() {
+		Enum("application/json", "application/vp+jwt", "application/vc+sd-jwt")
+	})
+	Required("result", "status")
+})
+
+var ExportJobRequest = Type("ExportJobRequest", func() {
+	Field(1, "exportName", String, "Name of the export.", func() {
+		Example("testexport")
+	})
+	Field(2, "id", String, "Unique identifier of the export job.", func() {
+		Example("585a999a-f36d-419d-bed3-8ebfa5bb79c9")
+	})
+	Required("exportName", "id")
+})
+
+var ExportJob = Type("ExportJob", func() {
+	Field(1, "id", String, "Unique identifier of the export job.", func() {
+		Example("585a999a-f36d-419d-bed3-8ebfa5bb79c9")
+	})
+	Field(2, "exportName", String, "Name of the export.", func() {
+		Example("testexport")
+	})
+	Field(3, "status", String, "Status of the export job.", func() {
+		Enum("pending", "running", "completed", "failed")
+	})
+	Field(4, "policies", ArrayOf(ExportJobPolicy), "Progress of the policy evaluations performed by the job.")
+	Field(5, "createdAt", String, "Time when the job was created.", func() {
+		Format(FormatDateTime)
+	})
+	Field(6, "updatedAt", String, "Time when the job was last updated.", func() {
+		Format(FormatDateTime)
+	})
+	Field(7, "parameters", MapOf(String, Any), "Parameter values of the export request.")
+	Required("id", "exportName", "status", "policies", "createdAt", "updatedAt")
+})
+
+var ExportJobPolicy = Type("ExportJobPolicy", func() {
+	Field(1, "policy", String, "Name of the policy formatted as 'group/policy/version'.", func() {
+		Example("example/example/1.0")
+	})
+	Field(2, "status", String, "Status of the policy evaluation.", func() {
+		Enum("pending", "evaluated", "failed")
+	})
+	Field(3, "error", String, "Error message if the policy evaluation failed.")
+	Required("policy", "status")
+})
+
+var ExportConfigurationRequest = Type("ExportConfigurationRequest", func() {
+	Field(1, "exportName", String, "Name of the export configuration.", func() {
+		Example("testexport")
+	})
+	Required("exportName")
+})
+
+var ExportConfiguration = Type("ExportConfiguration", func() {
+	Field(1, "exportName", String, "Unique name of the export.", func() {
+		Pattern(`^[A-Za-z0-9._-]+$`)
+		Example("testexport")
+	})
+	Field(2, "contexts", ArrayOf(String), "Additional JSON-LD contexts of the exported credentials.", func() {
+		Example([]string{"https://www.w3.org/2018/credentials/examples/v1"})
+	})
+	Field(3, "policies", MapOf(String, Any, func() {
+		Key(func() {
+			Pattern(`^[^/\s]+/[^/\s]+/[^/\s]+$`)
+		})
+	}), "Policies evaluated for the export, formatted as 'group/policy/version', and their input data.", func() {
+		MinLength(1)
+		Example(map[string]any{"example/example/1.0": map[string]any{"hello": "world"}})
+	})
+	Field(4, "cacheTTL", Int, "Time in seconds for which policy results are kept in Cache.", func() {
+		Minimum(1)
+		Example(3600)
+	})
+	Field(5, "issuer", String, "DID of the issuer of the exported credentials.", func() {
+		Pattern(`^did:[a-z0-9]+:.+$`)
+		Example("did:web:example.com")
+	})
+	Field(6, "keyNamespace", String, "Namespace of the key used for signing the export.", func() {
+		MinLength(1)
+		Example("transit")
+	})
+	Field(7, "key", String, "Name of the key used for signing the export.", func() {
+		MinLength(1)
+		Example("key1")
+	})
+	Field(8, "layout", String, "Layout of the exported credentials: a separate credential for each policy result labeled with the policy name in the infohub:policy field, or a single credential with all policy results merged by policy name.", func() {
+		Enum("credentialPerPolicy", "merged")
+		Default("credentialPerPolicy")
+	})
+	Field(9, "credentialTypes", ArrayOf(String), "Types added to the VerifiableCredential type of the exported credentials.", func() {
+		Example([]string{"ComplianceCredential"})
+	})
+	Field(10, "credentialSchema", CredentialSchema, "Schema of the exported credentials.")
+	Field(11, "staleWhileRevalidate", Boolean, "Return the last signed presentation of the export while its data is evaluated again, instead of accepting the export request without data.", func() {
+		Default(false)
+	})
+	Field(12, "schedule", String, "Cron expression specifying when the export data is evaluated in advance, so that it's available before the previous results expire from Cache.", func() {
+		Example("*/30 * * * *")
+	})
+	Field(13, "parameters", ArrayOf(ExportParameter), "Parameters which can be given when requesting the export. They are templated into the policy input as ${name} and are part of the Cache key of the export data.")
+	Field(14, "authorization", ExportAuthorization, "Rules which the token of a client must satisfy in order to perform the export.")
+	Field(15, "resultSchema", String, "Identifier of a JSON schema which each policy result of the export must satisfy before it is signed.", func() {
+		Example("https://schemas.example.com/compliance.json")
+	})
+	Field(16, "format", String, "Format of the exported data, unless another format is requested with the Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or unsigned JSON for trusted internal consumers.", func() {
+		Enum("jsonld", "jwt", "sd-jwt", "json")
+		Default("jsonld")
+	})
+	Field(17, "selectiveDisclosure", ArrayOf(String), "Claims of the credential subject which are selectively disclosable in SD-JWT VC exports. Nested claims are given as dot separated paths.", func() {
+		Example([]string{"legalName", "address.locality"})
+	})
+	Field(18, "policyOrder", ArrayOf(String), "Order in which the policy results are exported. It must list every policy of the export once. Policies are ordered by name by default.", func() {
+		Example([]string{"example/example/1.0"})
+	})
+	Required("exportName", "policies", "issuer", "keyNamespace", "key")
+})
+
+var ExportAuthorization = Type("ExportAuthorization", func() {
+	Field(1, "scopes", ArrayOf(String), "Scopes which the token must grant.", func() {
+		Example([]string{"export:participant-compliance"})
+	})
+	Field(2, "claims", MapOf(String, String), "Claims which the token must have with the given values.", func() {
+		Example(map[string]string{"organization": "example"})
+	})
+	Field(3, "policy", String, "Policy evaluated with the token claims as input. It must return allow set to true.", func() {
+		Pattern(`^[^/\s]+/[^/\s]+/[^/\s]+$`)
+		Example("example/exportAuthorization/1.0")
+	})
+})
+
+var ExportParameter = Type("ExportParameter", func() {
+	Field(1, "name", String, "Name of the parameter.", func() {
+		Pattern(`^[A-Za-z_][A-Za-z0-9_]*$`)
+		Example("participantId")
+	})
+	Field(2, "type", String, "Type of the parameter value.", func() {
+		Enum("string", "number", "integer", "boolean")
+		Default("string")
+	})
+	Field(3, "required", Boolean, "Whether the parameter must be given when requesting the export.", func() {
+		Default(false)
+	})
+	Field(4, "pattern", String, "Regular expression which string values must match.", func() {
+		Example("^did:web:.+$")
+	})
+	Field(5, "default", Any, "Value of the parameter when it's not given.")
+	Field(6, "description", String, "Description of the parameter.")
+	Required("name")
+})
+
+var CredentialSchema = Type("CredentialSchema", func() {
+	Field(1, "id", String, "URL of the schema.", func() {
+		Format(FormatURI)
+		Example("https://example.com/schemas/compliance.json")
+	})
+	Field(2, "type", String, "Type of the schema.", func() {
+		Example("JsonSchema")
+	})
+	Required("id", "type")
+})
+
 var ImportRequest = Type("ImportRequest", func() {
 	Field(1, "data", Bytes, "Data wrapped in Verifiable Presentation that will be imported into Cache.", func() {
 		Example("data")
 	})
+	Field(2, "namespace", String, "Cache namespace of the imported data. The namespaces which clients may import into are configured.", func() {
+		Example("participants")
+	})
+	Field(3, "scope", String, "Cache scope of the imported data.", func() {
+		Example("compliance")
+	})
+	Field(4, "key", String, "Cache key of the imported data. It can only be given for presentations with a single credential.", func() {
+		Example("participant-1")
+	})
+	Field(5, "keyFrom", String, "Source of the Cache keys of the imported credentials: random identifiers, the credential id or the credential subject id.", func() {
+		Enum("random", "credentialId", "subjectId")
+		Default("random")
+	})
+	Field(6, "atomic", Boolean, "Atomic imports either import all credentials or none of them. Entries written before a failure are removed.", func() {
+		Default(false)
+	})
+	Field(7, "idempotencyKey", String, "Idempotency key of the import request. Repeated requests with the same key return the result of the first request.", func() {
+		Example("c3b2a7c4-4c21-4d0e-9a64-6c0b2e4a7e51")
+	})
 	Required("data")
 })
 
@@ -21,7 +224,96 @@ var ImportResult = Type("ImportResult", func() {
 	Field(1, "importIds", ArrayOf(String), "importIds is an array of unique identifiers used as Cache keys to retrieve the imported data entries later.", func() {
 		Example([]string{"585a999a-f36d-419d-bed3-8ebfa5bb79c9"})
 	})
-	Required("importIds")
+	Field(2, "credentials", ArrayOf(ImportedCredential), "Result of the import of each credential of the presentation.")
+	Required("importIds", "credentials")
+})
+
+var ImportedCredential = Type("ImportedCredential", func() {
+	Field(1, "index", Int, "Position of the credential in the presentation.", func() {
+		Example(0)
+	})
+	Field(2, "credentialId", String, "Identifier of the credential, if it has one.", func() {
+		Example("urn:uuid:3978344f-8596-4c3a-a978-8fcaba3903c5")
+	})
+	Field(3, "key", String, "Cache key of the credential subject. For duplicate credentials it is the key of the previous import.", func() {
+		Example("585a999a-f36d-419d-bed3-8ebfa5bb79c9")
+	})
+	Field(4, "status", String, "Status of the import of the credential.", func() {
+		Enum("imported", "duplicate", "failed")
+	})
+	Field(5, "error", String, "Error message if the import of the credential failed.")
+	Field(6, "id", String, "Identifier of the import record of the credential, which is used to look up or delete the imported data.", func() {
+		Example("d7a3c2e1-5b0f-4f57-9a43-2f1c8e6b9d10")
+	})
+	Required("index", "key", "status")
+})
+
+var ImportRecordRequest = Type("ImportRecordRequest", func() {
+	Field(1, "id", String, "Identifier of the import record.", func() {
+		Example("d7a3c2e1-5b0f-4f57-9a43-2f1c8e6b9d10")
+	})
+	Required("id")
+})
+
+var ImportRecordsRequest = Type("ImportRecordsRequest", func() {
+	Field(1, "issuer", String, "Issuer of the imported credentials.", func() {
+		Example("did:web:issuer.example.com")
+	})
+	Field(2, "holder", String, "Holder of the imported presentations.", func() {
+		Example("did:web:holder.example.com")
+	})
+	Field(3, "subjectId", String, "Identifier of the subjects of the imported credentials.", func() {
+		Example("did:web:subject.example.com")
+	})
+	Field(4, "limit", Int, "Maximum number of returned records.", func() {
+		Minimum(1)
+		Maximum(1000)
+		Default(100)
+	})
+})
+
+var ImportRecord = Type("ImportRecord", func() {
+	Field(1, "id", String, "Identifier of the import record.", func() {
+		Example("d7a3c2e1-5b0f-4f57-9a43-2f1c8e6b9d10")
+	})
+	Field(2, "key", String, "Cache key of the imported credential subject.", func() {
+		Example("585a999a-f36d-419d-bed3-8ebfa5bb79c9")
+	})
+	Field(3, "namespace", String, "Cache namespace of the imported credential subject.")
+	Field(4, "scope", String, "Cache scope of the imported credential subject.")
+	Field(5, "credentialId", String, "Identifier of the imported credential.", func() {
+		Example("urn:uuid:3978344f-8596-4c3a-a978-8fcaba3903c5")
+	})
+	Field(6, "issuer", String, "Issuer of the imported credential.", func() {
+		Example("did:web:issuer.example.com")
+	})
+	Field(7, "holder", String, "Holder of the imported presentation.", func() {
+		Example("did:web:holder.example.com")
+	})
+	Field(8, "subjectId", String, "Identifier of the credential subject.", func() {
+		Example("did:web:subject.example.com")
+	})
+	Field(9, "hash", String, "SHA-256 hash of the canonical form of the credential.")
+	Field(10, "importer", String, "Client which imported the credential.", func() {
+		Example("client-a")
+	})
+	Field(11, "importedAt", String, "Time of the import.", func() {
+		Format(FormatDateTime)
+	})
+	Required("id", "key", "hash", "importedAt")
+})
+
+var ChallengeResult = Type("ChallengeResult", func() {
+	Field(1, "challenge", String, "Challenge which must be given in the proof of the imported presentation.", func() {
+		Example("z2mpXo4mHrbYk0HtJ7_d9iLnxBQOGn3UFmUxbPCJmto")
+	})
+	Field(2, "domain", String, "Domain which must be given in the proof of the imported presentation.", func() {
+		Example("infohub.example.com")
+	})
+	Field(3, "expiresAt", String, "Time when the challenge expires.", func() {
+		Format(FormatDateTime)
+	})
+	Required("challenge", "expiresAt")
 })
 
 var HealthResponse = Type("HealthResponse", func() {
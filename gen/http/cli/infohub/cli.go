@@ -23,14 +23,18 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `infohub (export|import)
+	return `infohub (export|get-export-job|list-exports|create-export|get-export|update-export|delete-export|import|import-challenge|list-imports|get-import|delete-import)
 health (liveness|readiness)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
-	return os.Args[0] + ` infohub export --export-name "testexport"` + "\n" +
+	return os.Args[0] + ` infohub export --body '{
+      "participantId": "did:web:participant.example.com"
+   }' --export-name "testexport" --query '{
+      "participantId": "did:web:participant.example.com"
+   }' --accept "application/vp+jwt"` + "\n" +
 		os.Args[0] + ` health liveness` + "\n" +
 		""
 }
@@ -48,10 +52,52 @@ func ParseEndpoint(
 		infohubFlags = flag.NewFlagSet("infohub", flag.ContinueOnError)
 
 		infohubExportFlags          = flag.NewFlagSet("export", flag.ExitOnError)
+		infohubExportBodyFlag       = infohubExportFlags.String("body", "REQUIRED", "")
 		infohubExportExportNameFlag = infohubExportFlags.String("export-name", "REQUIRED", "Name of export to be performed.")
+		infohubExportQueryFlag      = infohubExportFlags.String("query", "", "")
+		infohubExportAcceptFlag     = infohubExportFlags.String("accept", "", "")
 
-		infohubImportFlags    = flag.NewFlagSet("import", flag.ExitOnError)
-		infohubImportBodyFlag = infohubImportFlags.String("body", "REQUIRED", "")
+		infohubGetExportJobFlags          = flag.NewFlagSet("get-export-job", flag.ExitOnError)
+		infohubGetExportJobExportNameFlag = infohubGetExportJobFlags.String("export-name", "REQUIRED", "Name of the export.")
+		infohubGetExportJobIDFlag         = infohubGetExportJobFlags.String("id", "REQUIRED", "Unique identifier of the export job.")
+
+		infohubListExportsFlags = flag.NewFlagSet("list-exports", flag.ExitOnError)
+
+		infohubCreateExportFlags    = flag.NewFlagSet("create-export", flag.ExitOnError)
+		infohubCreateExportBodyFlag = infohubCreateExportFlags.String("body", "REQUIRED", "")
+
+		infohubGetExportFlags          = flag.NewFlagSet("get-export", flag.ExitOnError)
+		infohubGetExportExportNameFlag = infohubGetExportFlags.String("export-name", "REQUIRED", "Name of the export configuration.")
+
+		infohubUpdateExportFlags          = flag.NewFlagSet("update-export", flag.ExitOnError)
+		infohubUpdateExportBodyFlag       = infohubUpdateExportFlags.String("body", "REQUIRED", "")
+		infohubUpdateExportExportNameFlag = infohubUpdateExportFlags.String("export-name", "REQUIRED", "Unique name of the export.")
+
+		infohubDeleteExportFlags          = flag.NewFlagSet("delete-export", flag.ExitOnError)
+		infohubDeleteExportExportNameFlag = infohubDeleteExportFlags.String("export-name", "REQUIRED", "Name of the export configuration.")
+
+		infohubImportFlags              = flag.NewFlagSet("import", flag.ExitOnError)
+		infohubImportBodyFlag           = infohubImportFlags.String("body", "REQUIRED", "")
+		infohubImportNamespaceFlag      = infohubImportFlags.String("namespace", "", "")
+		infohubImportScopeFlag          = infohubImportFlags.String("scope", "", "")
+		infohubImportKeyFlag            = infohubImportFlags.String("key", "", "")
+		infohubImportKeyFromFlag        = infohubImportFlags.String("key-from", "random", "")
+		infohubImportAtomicFlag         = infohubImportFlags.String("atomic", "", "")
+		infohubImportIdempotencyKeyFlag = infohubImportFlags.String("idempotency-key", "", "")
+
+		infohubImportChallengeFlags = flag.NewFlagSet("import-challenge", flag.ExitOnError)
+
+		infohubListImportsFlags         = flag.NewFlagSet("list-imports", flag.ExitOnError)
+		infohubListImportsIssuerFlag    = infohubListImportsFlags.String("issuer", "", "")
+		infohubListImportsHolderFlag    = infohubListImportsFlags.String("holder", "", "")
+		infohubListImportsSubjectIDFlag = infohubListImportsFlags.String("subject-id", "", "")
+		infohubListImportsLimitFlag     = infohubListImportsFlags.String("limit", "100", "")
+
+		infohubGetImportFlags  = flag.NewFlagSet("get-import", flag.ExitOnError)
+		infohubGetImportIDFlag = infohubGetImportFlags.String("id", "REQUIRED", "Identifier of the import record.")
+
+		infohubDeleteImportFlags  = flag.NewFlagSet("delete-import", flag.ExitOnError)
+		infohubDeleteImportIDFlag = infohubDeleteImportFlags.String("id", "REQUIRED", "Identifier of the import record.")
 
 		healthFlags = flag.NewFlagSet("health", flag.ContinueOnError)
 
@@ -61,7 +107,17 @@ func ParseEndpoint(
 	)
 	infohubFlags.Usage = infohubUsage
 	infohubExportFlags.Usage = infohubExportUsage
+	infohubGetExportJobFlags.Usage = infohubGetExportJobUsage
+	infohubListExportsFlags.Usage = infohubListExportsUsage
+	infohubCreateExportFlags.Usage = infohubCreateExportUsage
+	infohubGetExportFlags.Usage = infohubGetExportUsage
+	infohubUpdateExportFlags.Usage = infohubUpdateExportUsage
+	infohubDeleteExportFlags.Usage = infohubDeleteExportUsage
 	infohubImportFlags.Usage = infohubImportUsage
+	infohubImportChallengeFlags.Usage = infohubImportChallengeUsage
+	infohubListImportsFlags.Usage = infohubListImportsUsage
+	infohubGetImportFlags.Usage = infohubGetImportUsage
+	infohubDeleteImportFlags.Usage = infohubDeleteImportUsage
 
 	healthFlags.Usage = healthUsage
 	healthLivenessFlags.Usage = healthLivenessUsage
@@ -106,9 +162,39 @@ func ParseEndpoint(
 			case "export":
 				epf = infohubExportFlags
 
+			case "get-export-job":
+				epf = infohubGetExportJobFlags
+
+			case "list-exports":
+				epf = infohubListExportsFlags
+
+			case "create-export":
+				epf = infohubCreateExportFlags
+
+			case "get-export":
+				epf = infohubGetExportFlags
+
+			case "update-export":
+				epf = infohubUpdateExportFlags
+
+			case "delete-export":
+				epf = infohubDeleteExportFlags
+
 			case "import":
 				epf = infohubImportFlags
 
+			case "import-challenge":
+				epf = infohubImportChallengeFlags
+
+			case "list-imports":
+				epf = infohubListImportsFlags
+
+			case "get-import":
+				epf = infohubGetImportFlags
+
+			case "delete-import":
+				epf = infohubDeleteImportFlags
+
 			}
 
 		case "health":
@@ -146,10 +232,38 @@ func ParseEndpoint(
 			switch epn {
 			case "export":
 				endpoint = c.Export()
-				data, err = infohubc.BuildExportPayload(*infohubExportExportNameFlag)
+				data, err = infohubc.BuildExportPayload(*infohubExportBodyFlag, *infohubExportExportNameFlag, *infohubExportQueryFlag, *infohubExportAcceptFlag)
+			case "get-export-job":
+				endpoint = c.GetExportJob()
+				data, err = infohubc.BuildGetExportJobPayload(*infohubGetExportJobExportNameFlag, *infohubGetExportJobIDFlag)
+			case "list-exports":
+				endpoint = c.ListExports()
+			case "create-export":
+				endpoint = c.CreateExport()
+				data, err = infohubc.BuildCreateExportPayload(*infohubCreateExportBodyFlag)
+			case "get-export":
+				endpoint = c.GetExport()
+				data, err = infohubc.BuildGetExportPayload(*infohubGetExportExportNameFlag)
+			case "update-export":
+				endpoint = c.UpdateExport()
+				data, err = infohubc.BuildUpdateExportPayload(*infohubUpdateExportBodyFlag, *infohubUpdateExportExportNameFlag)
+			case "delete-export":
+				endpoint = c.DeleteExport()
+				data, err = infohubc.BuildDeleteExportPayload(*infohubDeleteExportExportNameFlag)
 			case "import":
 				endpoint = c.Import()
-				data, err = infohubc.BuildImportPayload(*infohubImportBodyFlag)
+				data, err = infohubc.BuildImportPayload(*infohubImportBodyFlag, *infohubImportNamespaceFlag, *infohubImportScopeFlag, *infohubImportKeyFlag, *infohubImportKeyFromFlag, *infohubImportAtomicFlag, *infohubImportIdempotencyKeyFlag)
+			case "import-challenge":
+				endpoint = c.ImportChallenge()
+			case "list-imports":
+				endpoint = c.ListImports()
+				data, err = infohubc.BuildListImportsPayload(*infohubListImportsIssuerFlag, *infohubListImportsHolderFlag, *infohubListImportsSubjectIDFlag, *infohubListImportsLimitFlag)
+			case "get-import":
+				endpoint = c.GetImport()
+				data, err = infohubc.BuildGetImportPayload(*infohubGetImportIDFlag)
+			case "delete-import":
+				endpoint = c.DeleteImport()
+				data, err = infohubc.BuildDeleteImportPayload(*infohubDeleteImportIDFlag)
 			}
 		case "health":
 			c := healthc.NewClient(scheme, host, doer, enc, dec, restore)
@@ -175,32 +289,287 @@ Usage:
     %[1]s [globalflags] infohub COMMAND [flags]
 
 COMMAND:
-    export: Export returns data signed as Verifiable Presentation.
+    export: Export returns data signed as Verifiable Presentation. The Accept header selects a JSON-LD presentation, a VC-JWT presentation (application/vp+jwt) or an SD-JWT VC (application/vc+sd-jwt).
+    get-export-job: GetExportJob returns the progress of an asynchronous export job.
+    list-exports: ListExports returns all export configurations.
+    create-export: CreateExport stores a new export configuration.
+    get-export: GetExport returns the export configuration with the given name.
+    update-export: UpdateExport replaces the export configuration with the given name.
+    delete-export: DeleteExport removes the export configuration with the given name.
     import: Import the given data wrapped as Verifiable Presentation into the Cache.
+    import-challenge: ImportChallenge issues a single-use challenge, which must be included in the proof of an imported Verifiable Presentation.
+    list-imports: ListImports returns the records of imported credentials, most recent imports first.
+    get-import: GetImport returns the record of an imported credential.
+    delete-import: DeleteImport removes an imported credential subject from the Cache together with its import record.
 
 Additional help:
     %[1]s infohub COMMAND --help
 `, os.Args[0])
 }
 func infohubExportUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub export -export-name STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub export -body JSON -export-name STRING -query JSON -accept STRING
 
-Export returns data signed as Verifiable Presentation.
+Export returns data signed as Verifiable Presentation. The Accept header selects a JSON-LD presentation, a VC-JWT presentation (application/vp+jwt) or an SD-JWT VC (application/vc+sd-jwt).
+    -body JSON: 
     -export-name STRING: Name of export to be performed.
+    -query JSON: 
+    -accept STRING: 
+
+Example:
+    %[1]s infohub export --body '{
+      "participantId": "did:web:participant.example.com"
+   }' --export-name "testexport" --query '{
+      "participantId": "did:web:participant.example.com"
+   }' --accept "application/vp+jwt"
+`, os.Args[0])
+}
+
+func infohubGetExportJobUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub get-export-job -export-name STRING -id STRING
+
+GetExportJob returns the progress of an asynchronous export job.
+    -export-name STRING: Name of the export.
+    -id STRING: Unique identifier of the export job.
+
+Example:
+    %[1]s infohub get-export-job --export-name "testexport" --id "585a999a-f36d-419d-bed3-8ebfa5bb79c9"
+`, os.Args[0])
+}
+
+func infohubListExportsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub list-exports
+
+ListExports returns all export configurations.
+
+Example:
+    %[1]s infohub list-exports
+`, os.Args[0])
+}
+
+func infohubCreateExportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub create-export -body JSON
+
+CreateExport stores a new export configuration.
+    -body JSON: 
+
+Example:
+    %[1]s infohub create-export --body '{
+      "authorization": {
+         "claims": {
+            "organization": "example"
+         },
+         "policy": "example/exportAuthorization/1.0",
+         "scopes": [
+            "export:participant-compliance"
+         ]
+      },
+      "cacheTTL": 3600,
+      "contexts": [
+         "https://www.w3.org/2018/credentials/examples/v1"
+      ],
+      "credentialSchema": {
+         "id": "https://example.com/schemas/compliance.json",
+         "type": "JsonSchema"
+      },
+      "credentialTypes": [
+         "ComplianceCredential"
+      ],
+      "exportName": "testexport",
+      "format": "jwt",
+      "issuer": "did:web:example.com",
+      "key": "key1",
+      "keyNamespace": "transit",
+      "layout": "credentialPerPolicy",
+      "parameters": [
+         {
+            "default": "Ut dolores deserunt qui.",
+            "description": "Ut et culpa id aut.",
+            "name": "participantId",
+            "pattern": "^did:web:.+$",
+            "required": false,
+            "type": "string"
+         },
+         {
+            "default": "Ut dolores deserunt qui.",
+            "description": "Ut et culpa id aut.",
+            "name": "participantId",
+            "pattern": "^did:web:.+$",
+            "required": false,
+            "type": "string"
+         }
+      ],
+      "policies": {
+         "example/example/1.0": {
+            "hello": "world"
+         }
+      },
+      "policyOrder": [
+         "example/example/1.0"
+      ],
+      "resultSchema": "https://schemas.example.com/compliance.json",
+      "schedule": "*/30 * * * *",
+      "selectiveDisclosure": [
+         "legalName",
+         "address.locality"
+      ],
+      "staleWhileRevalidate": false
+   }'
+`, os.Args[0])
+}
+
+func infohubGetExportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub get-export -export-name STRING
+
+GetExport returns the export configuration with the given name.
+    -export-name STRING: Name of the export configuration.
+
+Example:
+    %[1]s infohub get-export --export-name "testexport"
+`, os.Args[0])
+}
+
+func infohubUpdateExportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub update-export -body JSON -export-name STRING
+
+UpdateExport replaces the export configuration with the given name.
+    -body JSON: 
+    -export-name STRING: Unique name of the export.
 
 Example:
-    %[1]s infohub export --export-name "testexport"
+    %[1]s infohub update-export --body '{
+      "authorization": {
+         "claims": {
+            "organization": "example"
+         },
+         "policy": "example/exportAuthorization/1.0",
+         "scopes": [
+            "export:participant-compliance"
+         ]
+      },
+      "cacheTTL": 3600,
+      "contexts": [
+         "https://www.w3.org/2018/credentials/examples/v1"
+      ],
+      "credentialSchema": {
+         "id": "https://example.com/schemas/compliance.json",
+         "type": "JsonSchema"
+      },
+      "credentialTypes": [
+         "ComplianceCredential"
+      ],
+      "format": "jsonld",
+      "issuer": "did:web:example.com",
+      "key": "key1",
+      "keyNamespace": "transit",
+      "layout": "credentialPerPolicy",
+      "parameters": [
+         {
+            "default": "Ut dolores deserunt qui.",
+            "description": "Ut et culpa id aut.",
+            "name": "participantId",
+            "pattern": "^did:web:.+$",
+            "required": false,
+            "type": "string"
+         },
+         {
+            "default": "Ut dolores deserunt qui.",
+            "description": "Ut et culpa id aut.",
+            "name": "participantId",
+            "pattern": "^did:web:.+$",
+            "required": false,
+            "type": "string"
+         }
+      ],
+      "policies": {
+         "example/example/1.0": {
+            "hello": "world"
+         }
+      },
+      "policyOrder": [
+         "example/example/1.0"
+      ],
+      "resultSchema": "https://schemas.example.com/compliance.json",
+      "schedule": "*/30 * * * *",
+      "selectiveDisclosure": [
+         "legalName",
+         "address.locality"
+      ],
+      "staleWhileRevalidate": true
+   }' --export-name "testexport"
+`, os.Args[0])
+}
+
+func infohubDeleteExportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub delete-export -export-name STRING
+
+DeleteExport removes the export configuration with the given name.
+    -export-name STRING: Name of the export configuration.
+
+Example:
+    %[1]s infohub delete-export --export-name "testexport"
 `, os.Args[0])
 }
 
 func infohubImportUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub import -body STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub import -body STRING -namespace STRING -scope STRING -key STRING -key-from STRING -atomic BOOL -idempotency-key STRING
 
 Import the given data wrapped as Verifiable Presentation into the Cache.
     -body STRING: 
+    -namespace STRING: 
+    -scope STRING: 
+    -key STRING: 
+    -key-from STRING: 
+    -atomic BOOL: 
+    -idempotency-key STRING: 
+
+Example:
+    %[1]s infohub import --body "data" --namespace "participants" --scope "compliance" --key "participant-1" --key-from "credentialId" --atomic true --idempotency-key "c3b2a7c4-4c21-4d0e-9a64-6c0b2e4a7e51"
+`, os.Args[0])
+}
+
+func infohubImportChallengeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub import-challenge
+
+ImportChallenge issues a single-use challenge, which must be included in the proof of an imported Verifiable Presentation.
+
+Example:
+    %[1]s infohub import-challenge
+`, os.Args[0])
+}
+
+func infohubListImportsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub list-imports -issuer STRING -holder STRING -subject-id STRING -limit INT
+
+ListImports returns the records of imported credentials, most recent imports first.
+    -issuer STRING: 
+    -holder STRING: 
+    -subject-id STRING: 
+    -limit INT: 
+
+Example:
+    %[1]s infohub list-imports --issuer "did:web:issuer.example.com" --holder "did:web:holder.example.com" --subject-id "did:web:subject.example.com" --limit 980
+`, os.Args[0])
+}
+
+func infohubGetImportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub get-import -id STRING
+
+GetImport returns the record of an imported credential.
+    -id STRING: Identifier of the import record.
+
+Example:
+    %[1]s infohub get-import --id "d7a3c2e1-5b0f-4f57-9a43-2f1c8e6b9d10"
+`, os.Args[0])
+}
+
+func infohubDeleteImportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] infohub delete-import -id STRING
+
+DeleteImport removes an imported credential subject from the Cache together with its import record.
+    -id STRING: Identifier of the import record.
 
 Example:
-    %[1]s infohub import --body "data"
+    %[1]s infohub delete-import --id "d7a3c2e1-5b0f-4f57-9a43-2f1c8e6b9d10"
 `, os.Args[0])
 }
 
@@ -8,17 +8,381 @@
 package client
 
 import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
 	infohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildExportPayload builds the payload for the infohub Export endpoint from
 // CLI flags.
-func BuildExportPayload(infohubExportExportName string) (*infohub.ExportRequest, error) {
+func BuildExportPayload(infohubExportBody string, infohubExportExportName string, infohubExportQuery string, infohubExportAccept string) (*infohub.ExportRequest, error) {
+	var err error
+	var body map[string]any
+	{
+		err = json.Unmarshal([]byte(infohubExportBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"participantId\": \"did:web:participant.example.com\"\n   }'")
+		}
+	}
 	var exportName string
 	{
 		exportName = infohubExportExportName
 	}
-	v := &infohub.ExportRequest{}
+	var query map[string]string
+	{
+		if infohubExportQuery != "" {
+			err = json.Unmarshal([]byte(infohubExportQuery), &query)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for query, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"participantId\": \"did:web:participant.example.com\"\n   }'")
+			}
+		}
+	}
+	var accept *string
+	{
+		if infohubExportAccept != "" {
+			accept = &infohubExportAccept
+		}
+	}
+	v := make(map[string]any, len(body))
+	for key, val := range body {
+		tk := key
+		tv := val
+		v[tk] = tv
+	}
+	res := &infohub.ExportRequest{
+		Parameters: v,
+	}
+	res.ExportName = exportName
+	res.Query = query
+	res.Accept = accept
+
+	return res, nil
+}
+
+// BuildGetExportJobPayload builds the payload for the infohub GetExportJob
+// endpoint from CLI flags.
+func BuildGetExportJobPayload(infohubGetExportJobExportName string, infohubGetExportJobID string) (*infohub.ExportJobRequest, error) {
+	var exportName string
+	{
+		exportName = infohubGetExportJobExportName
+	}
+	var id string
+	{
+		id = infohubGetExportJobID
+	}
+	v := &infohub.ExportJobRequest{}
+	v.ExportName = exportName
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildCreateExportPayload builds the payload for the infohub CreateExport
+// endpoint from CLI flags.
+func BuildCreateExportPayload(infohubCreateExportBody string) (*infohub.ExportConfiguration, error) {
+	var err error
+	var body CreateExportRequestBody
+	{
+		err = json.Unmarshal([]byte(infohubCreateExportBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"authorization\": {\n         \"claims\": {\n            \"organization\": \"example\"\n         },\n         \"policy\": \"example/exportAuthorization/1.0\",\n         \"scopes\": [\n            \"export:participant-compliance\"\n         ]\n      },\n      \"cacheTTL\": 3600,\n      \"contexts\": [\n         \"https://www.w3.org/2018/credentials/examples/v1\"\n      ],\n      \"credentialSchema\": {\n         \"id\": \"https://example.com/schemas/compliance.json\",\n         \"type\": \"JsonSchema\"\n      },\n      \"credentialTypes\": [\n         \"ComplianceCredential\"\n      ],\n      \"exportName\": \"testexport\",\n      \"format\": \"jwt\",\n      \"issuer\": \"did:web:example.com\",\n      \"key\": \"key1\",\n      \"keyNamespace\": \"transit\",\n      \"layout\": \"credentialPerPolicy\",\n      \"parameters\": [\n         {\n            \"default\": \"Ut dolores deserunt qui.\",\n            \"description\": \"Ut et culpa id aut.\",\n            \"name\": \"participantId\",\n            \"pattern\": \"^did:web:.+$\",\n            \"required\": false,\n            \"type\": \"string\"\n         },\n         {\n            \"default\": \"Ut dolores deserunt qui.\",\n            \"description\": \"Ut et culpa id aut.\",\n            \"name\": \"participantId\",\n            \"pattern\": \"^did:web:.+$\",\n            \"required\": false,\n            \"type\": \"string\"\n         }\n      ],\n      \"policies\": {\n         \"example/example/1.0\": {\n            \"hello\": \"world\"\n         }\n      },\n      \"policyOrder\": [\n         \"example/example/1.0\"\n      ],\n      \"resultSchema\": \"https://schemas.example.com/compliance.json\",\n      \"schedule\": \"*/30 * * * *\",\n      \"selectiveDisclosure\": [\n         \"legalName\",\n         \"address.locality\"\n      ],\n      \"staleWhileRevalidate\": false\n   }'")
+		}
+		if body.Policies == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exportName", body.ExportName, "^[A-Za-z0-9._-]+$"))
+		if len(body.Policies) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+		}
+		for k, _ := range body.Policies {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+		}
+		if body.CacheTTL != nil {
+			if *body.CacheTTL < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+			}
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", body.Issuer, "^did:[a-z0-9]+:.+$"))
+		if utf8.RuneCountInString(body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", body.KeyNamespace, utf8.RuneCountInString(body.KeyNamespace), 1, true))
+		}
+		if utf8.RuneCountInString(body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", body.Key, utf8.RuneCountInString(body.Key), 1, true))
+		}
+		if !(body.Layout == "credentialPerPolicy" || body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+		if body.CredentialSchema != nil {
+			if err2 := ValidateCredentialSchemaRequestBody(body.CredentialSchema); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		for _, e := range body.Parameters {
+			if e != nil {
+				if err2 := ValidateExportParameterRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.Authorization != nil {
+			if err2 := ValidateExportAuthorizationRequestBody(body.Authorization); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if !(body.Format == "jsonld" || body.Format == "jwt" || body.Format == "sd-jwt" || body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &infohub.ExportConfiguration{
+		ExportName:           body.ExportName,
+		CacheTTL:             body.CacheTTL,
+		Issuer:               body.Issuer,
+		KeyNamespace:         body.KeyNamespace,
+		Key:                  body.Key,
+		Layout:               body.Layout,
+		StaleWhileRevalidate: body.StaleWhileRevalidate,
+		Schedule:             body.Schedule,
+		ResultSchema:         body.ResultSchema,
+		Format:               body.Format,
+	}
+	if body.Contexts != nil {
+		v.Contexts = make([]string, len(body.Contexts))
+		for i, val := range body.Contexts {
+			v.Contexts[i] = val
+		}
+	}
+	if body.Policies != nil {
+		v.Policies = make(map[string]any, len(body.Policies))
+		for key, val := range body.Policies {
+			tk := key
+			tv := val
+			v.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if v.Layout == zero {
+			v.Layout = "credentialPerPolicy"
+		}
+	}
+	if body.CredentialTypes != nil {
+		v.CredentialTypes = make([]string, len(body.CredentialTypes))
+		for i, val := range body.CredentialTypes {
+			v.CredentialTypes[i] = val
+		}
+	}
+	if body.CredentialSchema != nil {
+		v.CredentialSchema = marshalCredentialSchemaRequestBodyToInfohubCredentialSchema(body.CredentialSchema)
+	}
+	{
+		var zero bool
+		if v.StaleWhileRevalidate == zero {
+			v.StaleWhileRevalidate = false
+		}
+	}
+	if body.Parameters != nil {
+		v.Parameters = make([]*infohub.ExportParameter, len(body.Parameters))
+		for i, val := range body.Parameters {
+			v.Parameters[i] = marshalExportParameterRequestBodyToInfohubExportParameter(val)
+		}
+	}
+	if body.Authorization != nil {
+		v.Authorization = marshalExportAuthorizationRequestBodyToInfohubExportAuthorization(body.Authorization)
+	}
+	{
+		var zero string
+		if v.Format == zero {
+			v.Format = "jsonld"
+		}
+	}
+	if body.SelectiveDisclosure != nil {
+		v.SelectiveDisclosure = make([]string, len(body.SelectiveDisclosure))
+		for i, val := range body.SelectiveDisclosure {
+			v.SelectiveDisclosure[i] = val
+		}
+	}
+	if body.PolicyOrder != nil {
+		v.PolicyOrder = make([]string, len(body.PolicyOrder))
+		for i, val := range body.PolicyOrder {
+			v.PolicyOrder[i] = val
+		}
+	}
+
+	return v, nil
+}
+
+// BuildGetExportPayload builds the payload for the infohub GetExport endpoint
+// from CLI flags.
+func BuildGetExportPayload(infohubGetExportExportName string) (*infohub.ExportConfigurationRequest, error) {
+	var exportName string
+	{
+		exportName = infohubGetExportExportName
+	}
+	v := &infohub.ExportConfigurationRequest{}
+	v.ExportName = exportName
+
+	return v, nil
+}
+
+// BuildUpdateExportPayload builds the payload for the infohub UpdateExport
+// endpoint from CLI flags.
+func BuildUpdateExportPayload(infohubUpdateExportBody string, infohubUpdateExportExportName string) (*infohub.ExportConfiguration, error) {
+	var err error
+	var body UpdateExportRequestBody
+	{
+		err = json.Unmarshal([]byte(infohubUpdateExportBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"authorization\": {\n         \"claims\": {\n            \"organization\": \"example\"\n         },\n         \"policy\": \"example/exportAuthorization/1.0\",\n         \"scopes\": [\n            \"export:participant-compliance\"\n         ]\n      },\n      \"cacheTTL\": 3600,\n      \"contexts\": [\n         \"https://www.w3.org/2018/credentials/examples/v1\"\n      ],\n      \"credentialSchema\": {\n         \"id\": \"https://example.com/schemas/compliance.json\",\n         \"type\": \"JsonSchema\"\n      },\n      \"credentialTypes\": [\n         \"ComplianceCredential\"\n      ],\n      \"format\": \"jsonld\",\n      \"issuer\": \"did:web:example.com\",\n      \"key\": \"key1\",\n      \"keyNamespace\": \"transit\",\n      \"layout\": \"credentialPerPolicy\",\n      \"parameters\": [\n         {\n            \"default\": \"Ut dolores deserunt qui.\",\n            \"description\": \"Ut et culpa id aut.\",\n            \"name\": \"participantId\",\n            \"pattern\": \"^did:web:.+$\",\n            \"required\": false,\n            \"type\": \"string\"\n         },\n         {\n            \"default\": \"Ut dolores deserunt qui.\",\n            \"description\": \"Ut et culpa id aut.\",\n            \"name\": \"participantId\",\n            \"pattern\": \"^did:web:.+$\",\n            \"required\": false,\n            \"type\": \"string\"\n         }\n      ],\n      \"policies\": {\n         \"example/example/1.0\": {\n            \"hello\": \"world\"\n         }\n      },\n      \"policyOrder\": [\n         \"example/example/1.0\"\n      ],\n      \"resultSchema\": \"https://schemas.example.com/compliance.json\",\n      \"schedule\": \"*/30 * * * *\",\n      \"selectiveDisclosure\": [\n         \"legalName\",\n         \"address.locality\"\n      ],\n      \"staleWhileRevalidate\": true\n   }'")
+		}
+		if body.Policies == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+		}
+		if len(body.Policies) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+		}
+		for k, _ := range body.Policies {
+			err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+		}
+		if body.CacheTTL != nil {
+			if *body.CacheTTL < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+			}
+		}
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", body.Issuer, "^did:[a-z0-9]+:.+$"))
+		if utf8.RuneCountInString(body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", body.KeyNamespace, utf8.RuneCountInString(body.KeyNamespace), 1, true))
+		}
+		if utf8.RuneCountInString(body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", body.Key, utf8.RuneCountInString(body.Key), 1, true))
+		}
+		if !(body.Layout == "credentialPerPolicy" || body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+		if body.CredentialSchema != nil {
+			if err2 := ValidateCredentialSchemaRequestBody(body.CredentialSchema); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		for _, e := range body.Parameters {
+			if e != nil {
+				if err2 := ValidateExportParameterRequestBody(e); err2 != nil {
+					err = goa.MergeErrors(err, err2)
+				}
+			}
+		}
+		if body.Authorization != nil {
+			if err2 := ValidateExportAuthorizationRequestBody(body.Authorization); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if !(body.Format == "jsonld" || body.Format == "jwt" || body.Format == "sd-jwt" || body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var exportName string
+	{
+		exportName = infohubUpdateExportExportName
+		err = goa.MergeErrors(err, goa.ValidatePattern("exportName", exportName, "^[A-Za-z0-9._-]+$"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	v := &infohub.ExportConfiguration{
+		CacheTTL:             body.CacheTTL,
+		Issuer:               body.Issuer,
+		KeyNamespace:         body.KeyNamespace,
+		Key:                  body.Key,
+		Layout:               body.Layout,
+		StaleWhileRevalidate: body.StaleWhileRevalidate,
+		Schedule:             body.Schedule,
+		ResultSchema:         body.ResultSchema,
+		Format:               body.Format,
+	}
+	if body.Contexts != nil {
+		v.Contexts = make([]string, len(body.Contexts))
+		for i, val := range body.Contexts {
+			v.Contexts[i] = val
+		}
+	}
+	if body.Policies != nil {
+		v.Policies = make(map[string]any, len(body.Policies))
+		for key, val := range body.Policies {
+			tk := key
+			tv := val
+			v.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if v.Layout == zero {
+			v.Layout = "credentialPerPolicy"
+		}
+	}
+	if body.CredentialTypes != nil {
+		v.CredentialTypes = make([]string, len(body.CredentialTypes))
+		for i, val := range body.CredentialTypes {
+			v.CredentialTypes[i] = val
+		}
+	}
+	if body.CredentialSchema != nil {
+		v.CredentialSchema = marshalCredentialSchemaRequestBodyToInfohubCredentialSchema(body.CredentialSchema)
+	}
+	{
+		var zero bool
+		if v.StaleWhileRevalidate == zero {
+			v.StaleWhileRevalidate = false
+		}
+	}
+	if body.Parameters != nil {
+		v.Parameters = make([]*infohub.ExportParameter, len(body.Parameters))
+		for i, val := range body.Parameters {
+			v.Parameters[i] = marshalExportParameterRequestBodyToInfohubExportParameter(val)
+		}
+	}
+	if body.Authorization != nil {
+		v.Authorization = marshalExportAuthorizationRequestBodyToInfohubExportAuthorization(body.Authorization)
+	}
+	{
+		var zero string
+		if v.Format == zero {
+			v.Format = "jsonld"
+		}
+	}
+	if body.SelectiveDisclosure != nil {
+		v.SelectiveDisclosure = make([]string, len(body.SelectiveDisclosure))
+		for i, val := range body.SelectiveDisclosure {
+			v.SelectiveDisclosure[i] = val
+		}
+	}
+	if body.PolicyOrder != nil {
+		v.PolicyOrder = make([]string, len(body.PolicyOrder))
+		for i, val := range body.PolicyOrder {
+			v.PolicyOrder[i] = val
+		}
+	}
+	v.ExportName = exportName
+
+	return v, nil
+}
+
+// BuildDeleteExportPayload builds the payload for the infohub DeleteExport
+// endpoint from CLI flags.
+func BuildDeleteExportPayload(infohubDeleteExportExportName string) (*infohub.ExportConfigurationRequest, error) {
+	var exportName string
+	{
+		exportName = infohubDeleteExportExportName
+	}
+	v := &infohub.ExportConfigurationRequest{}
 	v.ExportName = exportName
 
 	return v, nil
@@ -26,15 +390,144 @@ func BuildExportPayload(infohubExportExportName string) (*infohub.ExportRequest,
 
 // BuildImportPayload builds the payload for the infohub Import endpoint from
 // CLI flags.
-func BuildImportPayload(infohubImportBody string) (*infohub.ImportRequest, error) {
+func BuildImportPayload(infohubImportBody string, infohubImportNamespace string, infohubImportScope string, infohubImportKey string, infohubImportKeyFrom string, infohubImportAtomic string, infohubImportIdempotencyKey string) (*infohub.ImportRequest, error) {
+	var err error
 	var body []byte
 	{
 		body = []byte(infohubImportBody)
 	}
+	var namespace *string
+	{
+		if infohubImportNamespace != "" {
+			namespace = &infohubImportNamespace
+		}
+	}
+	var scope *string
+	{
+		if infohubImportScope != "" {
+			scope = &infohubImportScope
+		}
+	}
+	var key *string
+	{
+		if infohubImportKey != "" {
+			key = &infohubImportKey
+		}
+	}
+	var keyFrom string
+	{
+		if infohubImportKeyFrom != "" {
+			keyFrom = infohubImportKeyFrom
+			if !(keyFrom == "random" || keyFrom == "credentialId" || keyFrom == "subjectId") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("keyFrom", keyFrom, []any{"random", "credentialId", "subjectId"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var atomic bool
+	{
+		if infohubImportAtomic != "" {
+			atomic, err = strconv.ParseBool(infohubImportAtomic)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for atomic, must be BOOL")
+			}
+		}
+	}
+	var idempotencyKey *string
+	{
+		if infohubImportIdempotencyKey != "" {
+			idempotencyKey = &infohubImportIdempotencyKey
+		}
+	}
 	v := body
 	res := &infohub.ImportRequest{
 		Data: v,
 	}
+	res.Namespace = namespace
+	res.Scope = scope
+	res.Key = key
+	res.KeyFrom = keyFrom
+	res.Atomic = atomic
+	res.IdempotencyKey = idempotencyKey
 
 	return res, nil
 }
+
+// BuildListImportsPayload builds the payload for the infohub ListImports
+// endpoint from CLI flags.
+func BuildListImportsPayload(infohubListImportsIssuer string, infohubListImportsHolder string, infohubListImportsSubjectID string, infohubListImportsLimit string) (*infohub.ImportRecordsRequest, error) {
+	var err error
+	var issuer *string
+	{
+		if infohubListImportsIssuer != "" {
+			issuer = &infohubListImportsIssuer
+		}
+	}
+	var holder *string
+	{
+		if infohubListImportsHolder != "" {
+			holder = &infohubListImportsHolder
+		}
+	}
+	var subjectID *string
+	{
+		if infohubListImportsSubjectID != "" {
+			subjectID = &infohubListImportsSubjectID
+		}
+	}
+	var limit int
+	{
+		if infohubListImportsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(infohubListImportsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 1000 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	v := &infohub.ImportRecordsRequest{}
+	v.Issuer = issuer
+	v.Holder = holder
+	v.SubjectID = subjectID
+	v.Limit = limit
+
+	return v, nil
+}
+
+// BuildGetImportPayload builds the payload for the infohub GetImport endpoint
+// from CLI flags.
+func BuildGetImportPayload(infohubGetImportID string) (*infohub.ImportRecordRequest, error) {
+	var id string
+	{
+		id = infohubGetImportID
+	}
+	v := &infohub.ImportRecordRequest{}
+	v.ID = id
+
+	return v, nil
+}
+
+// BuildDeleteImportPayload builds the payload for the infohub DeleteImport
+// endpoint from CLI flags.
+func BuildDeleteImportPayload(infohubDeleteImportID string) (*infohub.ImportRecordRequest, error) {
+	var id string
+	{
+		id = infohubDeleteImportID
+	}
+	v := &infohub.ImportRecordRequest{}
+	v.ID = id
+
+	return v, nil
+}
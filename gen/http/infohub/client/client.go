@@ -20,9 +20,49 @@ type Client struct {
 	// Export Doer is the HTTP client used to make requests to the Export endpoint.
 	ExportDoer goahttp.Doer
 
+	// GetExportJob Doer is the HTTP client used to make requests to the
+	// GetExportJob endpoint.
+	GetExportJobDoer goahttp.Doer
+
+	// ListExports Doer is the HTTP client used to make requests to the ListExports
+	// endpoint.
+	ListExportsDoer goahttp.Doer
+
+	// CreateExport Doer is the HTTP client used to make requests to the
+	// CreateExport endpoint.
+	CreateExportDoer goahttp.Doer
+
+	// GetExport Doer is the HTTP client used to make requests to the GetExport
+	// endpoint.
+	GetExportDoer goahttp.Doer
+
+	// UpdateExport Doer is the HTTP client used to make requests to the
+	// UpdateExport endpoint.
+	UpdateExportDoer goahttp.Doer
+
+	// DeleteExport Doer is the HTTP client used to make requests to the
+	// DeleteExport endpoint.
+	DeleteExportDoer goahttp.Doer
+
 	// Import Doer is the HTTP client used to make requests to the Import endpoint.
 	ImportDoer goahttp.Doer
 
+	// ImportChallenge Doer is the HTTP client used to make requests to the
+	// ImportChallenge endpoint.
+	ImportChallengeDoer goahttp.Doer
+
+	// ListImports Doer is the HTTP client used to make requests to the ListImports
+	// endpoint.
+	ListImportsDoer goahttp.Doer
+
+	// GetImport Doer is the HTTP client used to make requests to the GetImport
+	// endpoint.
+	GetImportDoer goahttp.Doer
+
+	// DeleteImport Doer is the HTTP client used to make requests to the
+	// DeleteImport endpoint.
+	DeleteImportDoer goahttp.Doer
+
 	// RestoreResponseBody controls whether the response bodies are reset after
 	// decoding so they can be read again.
 	RestoreResponseBody bool
@@ -44,7 +84,17 @@ func NewClient(
 ) *Client {
 	return &Client{
 		ExportDoer:          doer,
+		GetExportJobDoer:    doer,
+		ListExportsDoer:     doer,
+		CreateExportDoer:    doer,
+		GetExportDoer:       doer,
+		UpdateExportDoer:    doer,
+		DeleteExportDoer:    doer,
 		ImportDoer:          doer,
+		ImportChallengeDoer: doer,
+		ListImportsDoer:     doer,
+		GetImportDoer:       doer,
+		DeleteImportDoer:    doer,
 		RestoreResponseBody: restoreBody,
 		scheme:              scheme,
 		host:                host,
@@ -57,6 +107,7 @@ func NewClient(
 // Export server.
 func (c *Client) Export() goa.Endpoint {
 	var (
+		encodeRequest  = EncodeExportRequest(c.encoder)
 		decodeResponse = DecodeExportResponse(c.decoder, c.RestoreResponseBody)
 	)
 	return func(ctx context.Context, v any) (any, error) {
@@ -64,6 +115,10 @@ func (c *Client) Export() goa.Endpoint {
 		if err != nil {
 			return nil, err
 		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
 		resp, err := c.ExportDoer.Do(req)
 		if err != nil {
 			return nil, goahttp.ErrRequestError("infohub", "Export", err)
@@ -72,6 +127,130 @@ func (c *Client) Export() goa.Endpoint {
 	}
 }
 
+// GetExportJob returns an endpoint that makes HTTP requests to the infohub
+// service GetExportJob server.
+func (c *Client) GetExportJob() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetExportJobResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetExportJobRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetExportJobDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "GetExportJob", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListExports returns an endpoint that makes HTTP requests to the infohub
+// service ListExports server.
+func (c *Client) ListExports() goa.Endpoint {
+	var (
+		decodeResponse = DecodeListExportsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListExportsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListExportsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "ListExports", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateExport returns an endpoint that makes HTTP requests to the infohub
+// service CreateExport server.
+func (c *Client) CreateExport() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateExportRequest(c.encoder)
+		decodeResponse = DecodeCreateExportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateExportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateExportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "CreateExport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetExport returns an endpoint that makes HTTP requests to the infohub
+// service GetExport server.
+func (c *Client) GetExport() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetExportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetExportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetExportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "GetExport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateExport returns an endpoint that makes HTTP requests to the infohub
+// service UpdateExport server.
+func (c *Client) UpdateExport() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateExportRequest(c.encoder)
+		decodeResponse = DecodeUpdateExportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateExportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateExportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "UpdateExport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteExport returns an endpoint that makes HTTP requests to the infohub
+// service DeleteExport server.
+func (c *Client) DeleteExport() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteExportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteExportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteExportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "DeleteExport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // Import returns an endpoint that makes HTTP requests to the infohub service
 // Import server.
 func (c *Client) Import() goa.Endpoint {
@@ -95,3 +274,84 @@ func (c *Client) Import() goa.Endpoint {
 		return decodeResponse(resp)
 	}
 }
+
+// ImportChallenge returns an endpoint that makes HTTP requests to the infohub
+// service ImportChallenge server.
+func (c *Client) ImportChallenge() goa.Endpoint {
+	var (
+		decodeResponse = DecodeImportChallengeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportChallengeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportChallengeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "ImportChallenge", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListImports returns an endpoint that makes HTTP requests to the infohub
+// service ListImports server.
+func (c *Client) ListImports() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListImportsRequest(c.encoder)
+		decodeResponse = DecodeListImportsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListImportsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListImportsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "ListImports", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetImport returns an endpoint that makes HTTP requests to the infohub
+// service GetImport server.
+func (c *Client) GetImport() goa.Endpoint {
+	var (
+		decodeResponse = DecodeGetImportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetImportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetImportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "GetImport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteImport returns an endpoint that makes HTTP requests to the infohub
+// service DeleteImport server.
+func (c *Client) DeleteImport() goa.Endpoint {
+	var (
+		decodeResponse = DecodeDeleteImportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteImportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteImportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("infohub", "DeleteImport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
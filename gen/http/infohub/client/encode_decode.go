@@ -10,12 +10,15 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	infohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
 	goahttp "goa.design/goa/v3/http"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // BuildExportRequest instantiates a HTTP request object with method and path
@@ -43,6 +46,33 @@ func (c *Client) BuildExportRequest(ctx context.Context, v any) (*http.Request,
 	return req, nil
 }
 
+// EncodeExportRequest returns an encoder for requests sent to the infohub
+// Export server.
+func EncodeExportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*infohub.ExportRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("infohub", "Export", "*infohub.ExportRequest", v)
+		}
+		if p.Accept != nil {
+			head := *p.Accept
+			req.Header.Set("Accept", head)
+		}
+		values := req.URL.Query()
+		for key, value := range p.Query {
+			keyStr := key
+			valueStr := value
+			values.Add(keyStr, valueStr)
+		}
+		req.URL.RawQuery = values.Encode()
+		body := p.Parameters
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("infohub", "Export", err)
+		}
+		return nil
+	}
+}
+
 // DecodeExportResponse returns a decoder for responses returned by the infohub
 // Export endpoint. restoreBody controls whether the response body should be
 // restored after having been read.
@@ -61,6 +91,25 @@ func DecodeExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body any
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "Export", err)
+			}
+			var (
+				location *string
+			)
+			locationRaw := resp.Header.Get("Location")
+			if locationRaw != "" {
+				location = &locationRaw
+			}
+			res := NewExportResultAccepted(body, location)
+			res.Status = "accepted"
+			return res, nil
 		case http.StatusOK:
 			var (
 				body any
@@ -70,7 +119,51 @@ func DecodeExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("infohub", "Export", err)
 			}
-			return body, nil
+			var (
+				contentType *string
+				location    *string
+				stale       *bool
+				age         *int
+			)
+			contentTypeRaw := resp.Header.Get("Content-Type")
+			if contentTypeRaw != "" {
+				contentType = &contentTypeRaw
+			}
+			if contentType != nil {
+				if !(*contentType == "application/json" || *contentType == "application/vp+jwt" || *contentType == "application/vc+sd-jwt") {
+					err = goa.MergeErrors(err, goa.InvalidEnumValueError("contentType", *contentType, []any{"application/json", "application/vp+jwt", "application/vc+sd-jwt"}))
+				}
+			}
+			locationRaw := resp.Header.Get("Location")
+			if locationRaw != "" {
+				location = &locationRaw
+			}
+			{
+				staleRaw := resp.Header.Get("X-Export-Stale")
+				if staleRaw != "" {
+					v, err2 := strconv.ParseBool(staleRaw)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("stale", staleRaw, "boolean"))
+					}
+					stale = &v
+				}
+			}
+			{
+				ageRaw := resp.Header.Get("Age")
+				if ageRaw != "" {
+					v, err2 := strconv.ParseInt(ageRaw, 10, strconv.IntSize)
+					if err2 != nil {
+						err = goa.MergeErrors(err, goa.InvalidFieldTypeError("age", ageRaw, "integer"))
+					}
+					pv := int(v)
+					age = &pv
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "Export", err)
+			}
+			res := NewExportResultOK(body, contentType, location, stale, age)
+			return res, nil
 		default:
 			body, _ := io.ReadAll(resp.Body)
 			return nil, goahttp.ErrInvalidResponse("infohub", "Export", resp.StatusCode, string(body))
@@ -78,13 +171,141 @@ func DecodeExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 	}
 }
 
-// BuildImportRequest instantiates a HTTP request object with method and path
-// set to call the "infohub" service "Import" endpoint
-func (c *Client) BuildImportRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportInfohubPath()}
+// BuildGetExportJobRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "GetExportJob" endpoint
+func (c *Client) BuildGetExportJobRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exportName string
+		id         string
+	)
+	{
+		p, ok := v.(*infohub.ExportJobRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("infohub", "GetExportJob", "*infohub.ExportJobRequest", v)
+		}
+		exportName = p.ExportName
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetExportJobInfohubPath(exportName, id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "GetExportJob", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetExportJobResponse returns a decoder for responses returned by the
+// infohub GetExportJob endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeGetExportJobResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetExportJobResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "GetExportJob", err)
+			}
+			err = ValidateGetExportJobResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "GetExportJob", err)
+			}
+			res := NewGetExportJobExportJobOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "GetExportJob", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListExportsRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "ListExports" endpoint
+func (c *Client) BuildListExportsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListExportsInfohubPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "ListExports", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeListExportsResponse returns a decoder for responses returned by the
+// infohub ListExports endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeListExportsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListExportsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "ListExports", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateExportConfigurationResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "ListExports", err)
+			}
+			res := NewListExportsExportConfigurationOK(body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "ListExports", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateExportRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "CreateExport" endpoint
+func (c *Client) BuildCreateExportRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateExportInfohubPath()}
 	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("infohub", "Import", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("infohub", "CreateExport", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -93,26 +314,91 @@ func (c *Client) BuildImportRequest(ctx context.Context, v any) (*http.Request,
 	return req, nil
 }
 
-// EncodeImportRequest returns an encoder for requests sent to the infohub
-// Import server.
-func EncodeImportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCreateExportRequest returns an encoder for requests sent to the
+// infohub CreateExport server.
+func EncodeCreateExportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*infohub.ImportRequest)
+		p, ok := v.(*infohub.ExportConfiguration)
 		if !ok {
-			return goahttp.ErrInvalidType("infohub", "Import", "*infohub.ImportRequest", v)
+			return goahttp.ErrInvalidType("infohub", "CreateExport", "*infohub.ExportConfiguration", v)
 		}
-		body := p.Data
+		body := NewCreateExportRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("infohub", "Import", err)
+			return goahttp.ErrEncodingError("infohub", "CreateExport", err)
 		}
 		return nil
 	}
 }
 
-// DecodeImportResponse returns a decoder for responses returned by the infohub
-// Import endpoint. restoreBody controls whether the response body should be
-// restored after having been read.
-func DecodeImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+// DecodeCreateExportResponse returns a decoder for responses returned by the
+// infohub CreateExport endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeCreateExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateExportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "CreateExport", err)
+			}
+			err = ValidateCreateExportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "CreateExport", err)
+			}
+			res := NewCreateExportExportConfigurationCreated(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "CreateExport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetExportRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "GetExport" endpoint
+func (c *Client) BuildGetExportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exportName string
+	)
+	{
+		p, ok := v.(*infohub.ExportConfigurationRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("infohub", "GetExport", "*infohub.ExportConfigurationRequest", v)
+		}
+		exportName = p.ExportName
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetExportInfohubPath(exportName)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "GetExport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetExportResponse returns a decoder for responses returned by the
+// infohub GetExport endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeGetExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -129,22 +415,917 @@ func DecodeImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreB
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body ImportResponseBody
+				body GetExportResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("infohub", "Import", err)
+				return nil, goahttp.ErrDecodingError("infohub", "GetExport", err)
 			}
-			err = ValidateImportResponseBody(&body)
+			err = ValidateGetExportResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("infohub", "Import", err)
+				return nil, goahttp.ErrValidationError("infohub", "GetExport", err)
 			}
-			res := NewImportResultOK(&body)
+			res := NewGetExportExportConfigurationOK(&body)
 			return res, nil
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("infohub", "Import", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("infohub", "GetExport", resp.StatusCode, string(body))
 		}
 	}
 }
+
+// BuildUpdateExportRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "UpdateExport" endpoint
+func (c *Client) BuildUpdateExportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exportName string
+	)
+	{
+		p, ok := v.(*infohub.ExportConfiguration)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("infohub", "UpdateExport", "*infohub.ExportConfiguration", v)
+		}
+		exportName = p.ExportName
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateExportInfohubPath(exportName)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "UpdateExport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateExportRequest returns an encoder for requests sent to the
+// infohub UpdateExport server.
+func EncodeUpdateExportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*infohub.ExportConfiguration)
+		if !ok {
+			return goahttp.ErrInvalidType("infohub", "UpdateExport", "*infohub.ExportConfiguration", v)
+		}
+		body := NewUpdateExportRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("infohub", "UpdateExport", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateExportResponse returns a decoder for responses returned by the
+// infohub UpdateExport endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeUpdateExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateExportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "UpdateExport", err)
+			}
+			err = ValidateUpdateExportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "UpdateExport", err)
+			}
+			res := NewUpdateExportExportConfigurationOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "UpdateExport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteExportRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "DeleteExport" endpoint
+func (c *Client) BuildDeleteExportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exportName string
+	)
+	{
+		p, ok := v.(*infohub.ExportConfigurationRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("infohub", "DeleteExport", "*infohub.ExportConfigurationRequest", v)
+		}
+		exportName = p.ExportName
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteExportInfohubPath(exportName)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "DeleteExport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteExportResponse returns a decoder for responses returned by the
+// infohub DeleteExport endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeDeleteExportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "DeleteExport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportRequest instantiates a HTTP request object with method and path
+// set to call the "infohub" service "Import" endpoint
+func (c *Client) BuildImportRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportInfohubPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "Import", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeImportRequest returns an encoder for requests sent to the infohub
+// Import server.
+func EncodeImportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*infohub.ImportRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("infohub", "Import", "*infohub.ImportRequest", v)
+		}
+		if p.IdempotencyKey != nil {
+			head := *p.IdempotencyKey
+			req.Header.Set("Idempotency-Key", head)
+		}
+		values := req.URL.Query()
+		if p.Namespace != nil {
+			values.Add("namespace", *p.Namespace)
+		}
+		if p.Scope != nil {
+			values.Add("scope", *p.Scope)
+		}
+		if p.Key != nil {
+			values.Add("key", *p.Key)
+		}
+		values.Add("keyFrom", p.KeyFrom)
+		values.Add("atomic", fmt.Sprintf("%v", p.Atomic))
+		req.URL.RawQuery = values.Encode()
+		body := p.Data
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("infohub", "Import", err)
+		}
+		return nil
+	}
+}
+
+// DecodeImportResponse returns a decoder for responses returned by the infohub
+// Import endpoint. restoreBody controls whether the response body should be
+// restored after having been read.
+func DecodeImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "Import", err)
+			}
+			err = ValidateImportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "Import", err)
+			}
+			res := NewImportResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "Import", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildImportChallengeRequest instantiates a HTTP request object with method
+// and path set to call the "infohub" service "ImportChallenge" endpoint
+func (c *Client) BuildImportChallengeRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportChallengeInfohubPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "ImportChallenge", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeImportChallengeResponse returns a decoder for responses returned by
+// the infohub ImportChallenge endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+func DecodeImportChallengeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ImportChallengeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "ImportChallenge", err)
+			}
+			err = ValidateImportChallengeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "ImportChallenge", err)
+			}
+			res := NewImportChallengeChallengeResultOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "ImportChallenge", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListImportsRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "ListImports" endpoint
+func (c *Client) BuildListImportsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListImportsInfohubPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "ListImports", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListImportsRequest returns an encoder for requests sent to the infohub
+// ListImports server.
+func EncodeListImportsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*infohub.ImportRecordsRequest)
+		if !ok {
+			return goahttp.ErrInvalidType("infohub", "ListImports", "*infohub.ImportRecordsRequest", v)
+		}
+		values := req.URL.Query()
+		if p.Issuer != nil {
+			values.Add("issuer", *p.Issuer)
+		}
+		if p.Holder != nil {
+			values.Add("holder", *p.Holder)
+		}
+		if p.SubjectID != nil {
+			values.Add("subjectId", *p.SubjectID)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListImportsResponse returns a decoder for responses returned by the
+// infohub ListImports endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeListImportsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListImportsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "ListImports", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateImportRecordResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "ListImports", err)
+			}
+			res := NewListImportsImportRecordOK(body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "ListImports", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetImportRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "GetImport" endpoint
+func (c *Client) BuildGetImportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*infohub.ImportRecordRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("infohub", "GetImport", "*infohub.ImportRecordRequest", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetImportInfohubPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "GetImport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeGetImportResponse returns a decoder for responses returned by the
+// infohub GetImport endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+func DecodeGetImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetImportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("infohub", "GetImport", err)
+			}
+			err = ValidateGetImportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("infohub", "GetImport", err)
+			}
+			res := NewGetImportImportRecordOK(&body)
+			return res, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "GetImport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteImportRequest instantiates a HTTP request object with method and
+// path set to call the "infohub" service "DeleteImport" endpoint
+func (c *Client) BuildDeleteImportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id string
+	)
+	{
+		p, ok := v.(*infohub.ImportRecordRequest)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("infohub", "DeleteImport", "*infohub.ImportRecordRequest", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteImportInfohubPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("infohub", "DeleteImport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// DecodeDeleteImportResponse returns a decoder for responses returned by the
+// infohub DeleteImport endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+func DecodeDeleteImportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusNoContent:
+			return nil, nil
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("infohub", "DeleteImport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// unmarshalExportJobPolicyResponseBodyToInfohubExportJobPolicy builds a value
+// of type *infohub.ExportJobPolicy from a value of type
+// *ExportJobPolicyResponseBody.
+func unmarshalExportJobPolicyResponseBodyToInfohubExportJobPolicy(v *ExportJobPolicyResponseBody) *infohub.ExportJobPolicy {
+	res := &infohub.ExportJobPolicy{
+		Policy: *v.Policy,
+		Status: *v.Status,
+		Error:  v.Error,
+	}
+
+	return res
+}
+
+// unmarshalExportConfigurationResponseToInfohubExportConfiguration builds a
+// value of type *infohub.ExportConfiguration from a value of type
+// *ExportConfigurationResponse.
+func unmarshalExportConfigurationResponseToInfohubExportConfiguration(v *ExportConfigurationResponse) *infohub.ExportConfiguration {
+	res := &infohub.ExportConfiguration{
+		ExportName:   *v.ExportName,
+		CacheTTL:     v.CacheTTL,
+		Issuer:       *v.Issuer,
+		KeyNamespace: *v.KeyNamespace,
+		Key:          *v.Key,
+		Schedule:     v.Schedule,
+		ResultSchema: v.ResultSchema,
+	}
+	if v.Layout != nil {
+		res.Layout = *v.Layout
+	}
+	if v.StaleWhileRevalidate != nil {
+		res.StaleWhileRevalidate = *v.StaleWhileRevalidate
+	}
+	if v.Format != nil {
+		res.Format = *v.Format
+	}
+	if v.Contexts != nil {
+		res.Contexts = make([]string, len(v.Contexts))
+		for i, val := range v.Contexts {
+			res.Contexts[i] = val
+		}
+	}
+	res.Policies = make(map[string]any, len(v.Policies))
+	for key, val := range v.Policies {
+		tk := key
+		tv := val
+		res.Policies[tk] = tv
+	}
+	if v.Layout == nil {
+		res.Layout = "credentialPerPolicy"
+	}
+	if v.CredentialTypes != nil {
+		res.CredentialTypes = make([]string, len(v.CredentialTypes))
+		for i, val := range v.CredentialTypes {
+			res.CredentialTypes[i] = val
+		}
+	}
+	if v.CredentialSchema != nil {
+		res.CredentialSchema = unmarshalCredentialSchemaResponseToInfohubCredentialSchema(v.CredentialSchema)
+	}
+	if v.StaleWhileRevalidate == nil {
+		res.StaleWhileRevalidate = false
+	}
+	if v.Parameters != nil {
+		res.Parameters = make([]*infohub.ExportParameter, len(v.Parameters))
+		for i, val := range v.Parameters {
+			res.Parameters[i] = unmarshalExportParameterResponseToInfohubExportParameter(val)
+		}
+	}
+	if v.Authorization != nil {
+		res.Authorization = unmarshalExportAuthorizationResponseToInfohubExportAuthorization(v.Authorization)
+	}
+	if v.Format == nil {
+		res.Format = "jsonld"
+	}
+	if v.SelectiveDisclosure != nil {
+		res.SelectiveDisclosure = make([]string, len(v.SelectiveDisclosure))
+		for i, val := range v.SelectiveDisclosure {
+			res.SelectiveDisclosure[i] = val
+		}
+	}
+	if v.PolicyOrder != nil {
+		res.PolicyOrder = make([]string, len(v.PolicyOrder))
+		for i, val := range v.PolicyOrder {
+			res.PolicyOrder[i] = val
+		}
+	}
+
+	return res
+}
+
+// unmarshalCredentialSchemaResponseToInfohubCredentialSchema builds a value of
+// type *infohub.CredentialSchema from a value of type
+// *CredentialSchemaResponse.
+func unmarshalCredentialSchemaResponseToInfohubCredentialSchema(v *CredentialSchemaResponse) *infohub.CredentialSchema {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.CredentialSchema{
+		ID:   *v.ID,
+		Type: *v.Type,
+	}
+
+	return res
+}
+
+// unmarshalExportParameterResponseToInfohubExportParameter builds a value of
+// type *infohub.ExportParameter from a value of type *ExportParameterResponse.
+func unmarshalExportParameterResponseToInfohubExportParameter(v *ExportParameterResponse) *infohub.ExportParameter {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportParameter{
+		Name:        *v.Name,
+		Pattern:     v.Pattern,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+	if v.Type != nil {
+		res.Type = *v.Type
+	}
+	if v.Required != nil {
+		res.Required = *v.Required
+	}
+	if v.Type == nil {
+		res.Type = "string"
+	}
+	if v.Required == nil {
+		res.Required = false
+	}
+
+	return res
+}
+
+// unmarshalExportAuthorizationResponseToInfohubExportAuthorization builds a
+// value of type *infohub.ExportAuthorization from a value of type
+// *ExportAuthorizationResponse.
+func unmarshalExportAuthorizationResponseToInfohubExportAuthorization(v *ExportAuthorizationResponse) *infohub.ExportAuthorization {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportAuthorization{
+		Policy: v.Policy,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalInfohubCredentialSchemaToCredentialSchemaRequestBody builds a value
+// of type *CredentialSchemaRequestBody from a value of type
+// *infohub.CredentialSchema.
+func marshalInfohubCredentialSchemaToCredentialSchemaRequestBody(v *infohub.CredentialSchema) *CredentialSchemaRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &CredentialSchemaRequestBody{
+		ID:   v.ID,
+		Type: v.Type,
+	}
+
+	return res
+}
+
+// marshalInfohubExportParameterToExportParameterRequestBody builds a value of
+// type *ExportParameterRequestBody from a value of type
+// *infohub.ExportParameter.
+func marshalInfohubExportParameterToExportParameterRequestBody(v *infohub.ExportParameter) *ExportParameterRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ExportParameterRequestBody{
+		Name:        v.Name,
+		Type:        v.Type,
+		Required:    v.Required,
+		Pattern:     v.Pattern,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+	{
+		var zero string
+		if res.Type == zero {
+			res.Type = "string"
+		}
+	}
+	{
+		var zero bool
+		if res.Required == zero {
+			res.Required = false
+		}
+	}
+
+	return res
+}
+
+// marshalInfohubExportAuthorizationToExportAuthorizationRequestBody builds a
+// value of type *ExportAuthorizationRequestBody from a value of type
+// *infohub.ExportAuthorization.
+func marshalInfohubExportAuthorizationToExportAuthorizationRequestBody(v *infohub.ExportAuthorization) *ExportAuthorizationRequestBody {
+	if v == nil {
+		return nil
+	}
+	res := &ExportAuthorizationRequestBody{
+		Policy: v.Policy,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalCredentialSchemaRequestBodyToInfohubCredentialSchema builds a value
+// of type *infohub.CredentialSchema from a value of type
+// *CredentialSchemaRequestBody.
+func marshalCredentialSchemaRequestBodyToInfohubCredentialSchema(v *CredentialSchemaRequestBody) *infohub.CredentialSchema {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.CredentialSchema{
+		ID:   v.ID,
+		Type: v.Type,
+	}
+
+	return res
+}
+
+// marshalExportParameterRequestBodyToInfohubExportParameter builds a value of
+// type *infohub.ExportParameter from a value of type
+// *ExportParameterRequestBody.
+func marshalExportParameterRequestBodyToInfohubExportParameter(v *ExportParameterRequestBody) *infohub.ExportParameter {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportParameter{
+		Name:        v.Name,
+		Type:        v.Type,
+		Required:    v.Required,
+		Pattern:     v.Pattern,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+	{
+		var zero string
+		if res.Type == zero {
+			res.Type = "string"
+		}
+	}
+	{
+		var zero bool
+		if res.Required == zero {
+			res.Required = false
+		}
+	}
+
+	return res
+}
+
+// marshalExportAuthorizationRequestBodyToInfohubExportAuthorization builds a
+// value of type *infohub.ExportAuthorization from a value of type
+// *ExportAuthorizationRequestBody.
+func marshalExportAuthorizationRequestBodyToInfohubExportAuthorization(v *ExportAuthorizationRequestBody) *infohub.ExportAuthorization {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportAuthorization{
+		Policy: v.Policy,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalCredentialSchemaResponseBodyToInfohubCredentialSchema builds a
+// value of type *infohub.CredentialSchema from a value of type
+// *CredentialSchemaResponseBody.
+func unmarshalCredentialSchemaResponseBodyToInfohubCredentialSchema(v *CredentialSchemaResponseBody) *infohub.CredentialSchema {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.CredentialSchema{
+		ID:   *v.ID,
+		Type: *v.Type,
+	}
+
+	return res
+}
+
+// unmarshalExportParameterResponseBodyToInfohubExportParameter builds a value
+// of type *infohub.ExportParameter from a value of type
+// *ExportParameterResponseBody.
+func unmarshalExportParameterResponseBodyToInfohubExportParameter(v *ExportParameterResponseBody) *infohub.ExportParameter {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportParameter{
+		Name:        *v.Name,
+		Pattern:     v.Pattern,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+	if v.Type != nil {
+		res.Type = *v.Type
+	}
+	if v.Required != nil {
+		res.Required = *v.Required
+	}
+	if v.Type == nil {
+		res.Type = "string"
+	}
+	if v.Required == nil {
+		res.Required = false
+	}
+
+	return res
+}
+
+// unmarshalExportAuthorizationResponseBodyToInfohubExportAuthorization builds
+// a value of type *infohub.ExportAuthorization from a value of type
+// *ExportAuthorizationResponseBody.
+func unmarshalExportAuthorizationResponseBodyToInfohubExportAuthorization(v *ExportAuthorizationResponseBody) *infohub.ExportAuthorization {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportAuthorization{
+		Policy: v.Policy,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalImportedCredentialResponseBodyToInfohubImportedCredential builds a
+// value of type *infohub.ImportedCredential from a value of type
+// *ImportedCredentialResponseBody.
+func unmarshalImportedCredentialResponseBodyToInfohubImportedCredential(v *ImportedCredentialResponseBody) *infohub.ImportedCredential {
+	res := &infohub.ImportedCredential{
+		Index:        *v.Index,
+		CredentialID: v.CredentialID,
+		Key:          *v.Key,
+		Status:       *v.Status,
+		Error:        v.Error,
+		ID:           v.ID,
+	}
+
+	return res
+}
+
+// unmarshalImportRecordResponseToInfohubImportRecord builds a value of type
+// *infohub.ImportRecord from a value of type *ImportRecordResponse.
+func unmarshalImportRecordResponseToInfohubImportRecord(v *ImportRecordResponse) *infohub.ImportRecord {
+	res := &infohub.ImportRecord{
+		ID:           *v.ID,
+		Key:          *v.Key,
+		Namespace:    v.Namespace,
+		Scope:        v.Scope,
+		CredentialID: v.CredentialID,
+		Issuer:       v.Issuer,
+		Holder:       v.Holder,
+		SubjectID:    v.SubjectID,
+		Hash:         *v.Hash,
+		Importer:     v.Importer,
+		ImportedAt:   *v.ImportedAt,
+	}
+
+	return res
+}
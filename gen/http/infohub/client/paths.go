@@ -16,7 +16,62 @@ func ExportInfohubPath(exportName string) string {
 	return fmt.Sprintf("/v1/export/%v", exportName)
 }
 
+// ExportInfohubPath2 returns the URL path to the infohub service Export HTTP endpoint.
+func ExportInfohubPath2(exportName string) string {
+	return fmt.Sprintf("/v1/export/%v", exportName)
+}
+
+// GetExportJobInfohubPath returns the URL path to the infohub service GetExportJob HTTP endpoint.
+func GetExportJobInfohubPath(exportName string, id string) string {
+	return fmt.Sprintf("/v1/export/%v/jobs/%v", exportName, id)
+}
+
+// ListExportsInfohubPath returns the URL path to the infohub service ListExports HTTP endpoint.
+func ListExportsInfohubPath() string {
+	return "/v1/exports"
+}
+
+// CreateExportInfohubPath returns the URL path to the infohub service CreateExport HTTP endpoint.
+func CreateExportInfohubPath() string {
+	return "/v1/exports"
+}
+
+// GetExportInfohubPath returns the URL path to the infohub service GetExport HTTP endpoint.
+func GetExportInfohubPath(exportName string) string {
+	return fmt.Sprintf("/v1/exports/%v", exportName)
+}
+
+// UpdateExportInfohubPath returns the URL path to the infohub service UpdateExport HTTP endpoint.
+func UpdateExportInfohubPath(exportName string) string {
+	return fmt.Sprintf("/v1/exports/%v", exportName)
+}
+
+// DeleteExportInfohubPath returns the URL path to the infohub service DeleteExport HTTP endpoint.
+func DeleteExportInfohubPath(exportName string) string {
+	return fmt.Sprintf("/v1/exports/%v", exportName)
+}
+
 // ImportInfohubPath returns the URL path to the infohub service Import HTTP endpoint.
 func ImportInfohubPath() string {
 	return "/v1/import"
 }
+
+// ImportChallengeInfohubPath returns the URL path to the infohub service ImportChallenge HTTP endpoint.
+func ImportChallengeInfohubPath() string {
+	return "/v1/import/challenge"
+}
+
+// ListImportsInfohubPath returns the URL path to the infohub service ListImports HTTP endpoint.
+func ListImportsInfohubPath() string {
+	return "/v1/imports"
+}
+
+// GetImportInfohubPath returns the URL path to the infohub service GetImport HTTP endpoint.
+func GetImportInfohubPath(id string) string {
+	return fmt.Sprintf("/v1/import/%v", id)
+}
+
+// DeleteImportInfohubPath returns the URL path to the infohub service DeleteImport HTTP endpoint.
+func DeleteImportInfohubPath(id string) string {
+	return fmt.Sprintf("/v1/import/%v", id)
+}
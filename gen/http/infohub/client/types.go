@@ -8,16 +8,1019 @@
 package client
 
 import (
+	"unicode/utf8"
+
 	infohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
 	goa "goa.design/goa/v3/pkg"
 )
 
+// CreateExportRequestBody is the type of the "infohub" service "CreateExport"
+// endpoint HTTP request body.
+type CreateExportRequestBody struct {
+	// Unique name of the export.
+	ExportName string `form:"exportName" json:"exportName" xml:"exportName"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies" json:"policies" xml:"policies"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer string `form:"issuer" json:"issuer" xml:"issuer"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace string `form:"keyNamespace" json:"keyNamespace" xml:"keyNamespace"`
+	// Name of the key used for signing the export.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout string `form:"layout" json:"layout" xml:"layout"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaRequestBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate bool `form:"staleWhileRevalidate" json:"staleWhileRevalidate" xml:"staleWhileRevalidate"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterRequestBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationRequestBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format string `form:"format" json:"format" xml:"format"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// UpdateExportRequestBody is the type of the "infohub" service "UpdateExport"
+// endpoint HTTP request body.
+type UpdateExportRequestBody struct {
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies" json:"policies" xml:"policies"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer string `form:"issuer" json:"issuer" xml:"issuer"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace string `form:"keyNamespace" json:"keyNamespace" xml:"keyNamespace"`
+	// Name of the key used for signing the export.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout string `form:"layout" json:"layout" xml:"layout"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaRequestBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate bool `form:"staleWhileRevalidate" json:"staleWhileRevalidate" xml:"staleWhileRevalidate"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterRequestBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationRequestBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format string `form:"format" json:"format" xml:"format"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// GetExportJobResponseBody is the type of the "infohub" service "GetExportJob"
+// endpoint HTTP response body.
+type GetExportJobResponseBody struct {
+	// Unique identifier of the export job.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Name of the export.
+	ExportName *string `form:"exportName,omitempty" json:"exportName,omitempty" xml:"exportName,omitempty"`
+	// Status of the export job.
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Progress of the policy evaluations performed by the job.
+	Policies []*ExportJobPolicyResponseBody `form:"policies,omitempty" json:"policies,omitempty" xml:"policies,omitempty"`
+	// Time when the job was created.
+	CreatedAt *string `form:"createdAt,omitempty" json:"createdAt,omitempty" xml:"createdAt,omitempty"`
+	// Time when the job was last updated.
+	UpdatedAt *string `form:"updatedAt,omitempty" json:"updatedAt,omitempty" xml:"updatedAt,omitempty"`
+	// Parameter values of the export request.
+	Parameters map[string]any `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+}
+
+// ListExportsResponseBody is the type of the "infohub" service "ListExports"
+// endpoint HTTP response body.
+type ListExportsResponseBody []*ExportConfigurationResponse
+
+// CreateExportResponseBody is the type of the "infohub" service "CreateExport"
+// endpoint HTTP response body.
+type CreateExportResponseBody struct {
+	// Unique name of the export.
+	ExportName *string `form:"exportName,omitempty" json:"exportName,omitempty" xml:"exportName,omitempty"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies,omitempty" json:"policies,omitempty" xml:"policies,omitempty"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace *string `form:"keyNamespace,omitempty" json:"keyNamespace,omitempty" xml:"keyNamespace,omitempty"`
+	// Name of the key used for signing the export.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout *string `form:"layout,omitempty" json:"layout,omitempty" xml:"layout,omitempty"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponseBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate *bool `form:"staleWhileRevalidate,omitempty" json:"staleWhileRevalidate,omitempty" xml:"staleWhileRevalidate,omitempty"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponseBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponseBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// GetExportResponseBody is the type of the "infohub" service "GetExport"
+// endpoint HTTP response body.
+type GetExportResponseBody struct {
+	// Unique name of the export.
+	ExportName *string `form:"exportName,omitempty" json:"exportName,omitempty" xml:"exportName,omitempty"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies,omitempty" json:"policies,omitempty" xml:"policies,omitempty"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace *string `form:"keyNamespace,omitempty" json:"keyNamespace,omitempty" xml:"keyNamespace,omitempty"`
+	// Name of the key used for signing the export.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout *string `form:"layout,omitempty" json:"layout,omitempty" xml:"layout,omitempty"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponseBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate *bool `form:"staleWhileRevalidate,omitempty" json:"staleWhileRevalidate,omitempty" xml:"staleWhileRevalidate,omitempty"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponseBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponseBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// UpdateExportResponseBody is the type of the "infohub" service "UpdateExport"
+// endpoint HTTP response body.
+type UpdateExportResponseBody struct {
+	// Unique name of the export.
+	ExportName *string `form:"exportName,omitempty" json:"exportName,omitempty" xml:"exportName,omitempty"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies,omitempty" json:"policies,omitempty" xml:"policies,omitempty"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace *string `form:"keyNamespace,omitempty" json:"keyNamespace,omitempty" xml:"keyNamespace,omitempty"`
+	// Name of the key used for signing the export.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout *string `form:"layout,omitempty" json:"layout,omitempty" xml:"layout,omitempty"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponseBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate *bool `form:"staleWhileRevalidate,omitempty" json:"staleWhileRevalidate,omitempty" xml:"staleWhileRevalidate,omitempty"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponseBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponseBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
 // ImportResponseBody is the type of the "infohub" service "Import" endpoint
 // HTTP response body.
 type ImportResponseBody struct {
 	// importIds is an array of unique identifiers used as Cache keys to retrieve
 	// the imported data entries later.
 	ImportIds []string `form:"importIds,omitempty" json:"importIds,omitempty" xml:"importIds,omitempty"`
+	// Result of the import of each credential of the presentation.
+	Credentials []*ImportedCredentialResponseBody `form:"credentials,omitempty" json:"credentials,omitempty" xml:"credentials,omitempty"`
+}
+
+// ImportChallengeResponseBody is the type of the "infohub" service
+// "ImportChallenge" endpoint HTTP response body.
+type ImportChallengeResponseBody struct {
+	// Challenge which must be given in the proof of the imported presentation.
+	Challenge *string `form:"challenge,omitempty" json:"challenge,omitempty" xml:"challenge,omitempty"`
+	// Domain which must be given in the proof of the imported presentation.
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
+	// Time when the challenge expires.
+	ExpiresAt *string `form:"expiresAt,omitempty" json:"expiresAt,omitempty" xml:"expiresAt,omitempty"`
+}
+
+// ListImportsResponseBody is the type of the "infohub" service "ListImports"
+// endpoint HTTP response body.
+type ListImportsResponseBody []*ImportRecordResponse
+
+// GetImportResponseBody is the type of the "infohub" service "GetImport"
+// endpoint HTTP response body.
+type GetImportResponseBody struct {
+	// Identifier of the import record.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Cache key of the imported credential subject.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache namespace of the imported credential subject.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache scope of the imported credential subject.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Identifier of the imported credential.
+	CredentialID *string `form:"credentialId,omitempty" json:"credentialId,omitempty" xml:"credentialId,omitempty"`
+	// Issuer of the imported credential.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Holder of the imported presentation.
+	Holder *string `form:"holder,omitempty" json:"holder,omitempty" xml:"holder,omitempty"`
+	// Identifier of the credential subject.
+	SubjectID *string `form:"subjectId,omitempty" json:"subjectId,omitempty" xml:"subjectId,omitempty"`
+	// SHA-256 hash of the canonical form of the credential.
+	Hash *string `form:"hash,omitempty" json:"hash,omitempty" xml:"hash,omitempty"`
+	// Client which imported the credential.
+	Importer *string `form:"importer,omitempty" json:"importer,omitempty" xml:"importer,omitempty"`
+	// Time of the import.
+	ImportedAt *string `form:"importedAt,omitempty" json:"importedAt,omitempty" xml:"importedAt,omitempty"`
+}
+
+// ExportJobPolicyResponseBody is used to define fields on response body types.
+type ExportJobPolicyResponseBody struct {
+	// Name of the policy formatted as 'group/policy/version'.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+	// Status of the policy evaluation.
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Error message if the policy evaluation failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// ExportConfigurationResponse is used to define fields on response body types.
+type ExportConfigurationResponse struct {
+	// Unique name of the export.
+	ExportName *string `form:"exportName,omitempty" json:"exportName,omitempty" xml:"exportName,omitempty"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies,omitempty" json:"policies,omitempty" xml:"policies,omitempty"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace *string `form:"keyNamespace,omitempty" json:"keyNamespace,omitempty" xml:"keyNamespace,omitempty"`
+	// Name of the key used for signing the export.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout *string `form:"layout,omitempty" json:"layout,omitempty" xml:"layout,omitempty"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponse `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate *bool `form:"staleWhileRevalidate,omitempty" json:"staleWhileRevalidate,omitempty" xml:"staleWhileRevalidate,omitempty"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponse `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponse `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// CredentialSchemaResponse is used to define fields on response body types.
+type CredentialSchemaResponse struct {
+	// URL of the schema.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Type of the schema.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+}
+
+// ExportParameterResponse is used to define fields on response body types.
+type ExportParameterResponse struct {
+	// Name of the parameter.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of the parameter value.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether the parameter must be given when requesting the export.
+	Required *bool `form:"required,omitempty" json:"required,omitempty" xml:"required,omitempty"`
+	// Regular expression which string values must match.
+	Pattern *string `form:"pattern,omitempty" json:"pattern,omitempty" xml:"pattern,omitempty"`
+	// Value of the parameter when it's not given.
+	Default any `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the parameter.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ExportAuthorizationResponse is used to define fields on response body types.
+type ExportAuthorizationResponse struct {
+	// Scopes which the token must grant.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Claims which the token must have with the given values.
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+	// Policy evaluated with the token claims as input. It must return allow set to
+	// true.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+}
+
+// CredentialSchemaRequestBody is used to define fields on request body types.
+type CredentialSchemaRequestBody struct {
+	// URL of the schema.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Type of the schema.
+	Type string `form:"type" json:"type" xml:"type"`
+}
+
+// ExportParameterRequestBody is used to define fields on request body types.
+type ExportParameterRequestBody struct {
+	// Name of the parameter.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of the parameter value.
+	Type string `form:"type" json:"type" xml:"type"`
+	// Whether the parameter must be given when requesting the export.
+	Required bool `form:"required" json:"required" xml:"required"`
+	// Regular expression which string values must match.
+	Pattern *string `form:"pattern,omitempty" json:"pattern,omitempty" xml:"pattern,omitempty"`
+	// Value of the parameter when it's not given.
+	Default any `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the parameter.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ExportAuthorizationRequestBody is used to define fields on request body
+// types.
+type ExportAuthorizationRequestBody struct {
+	// Scopes which the token must grant.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Claims which the token must have with the given values.
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+	// Policy evaluated with the token claims as input. It must return allow set to
+	// true.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+}
+
+// CredentialSchemaResponseBody is used to define fields on response body types.
+type CredentialSchemaResponseBody struct {
+	// URL of the schema.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Type of the schema.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+}
+
+// ExportParameterResponseBody is used to define fields on response body types.
+type ExportParameterResponseBody struct {
+	// Name of the parameter.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of the parameter value.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether the parameter must be given when requesting the export.
+	Required *bool `form:"required,omitempty" json:"required,omitempty" xml:"required,omitempty"`
+	// Regular expression which string values must match.
+	Pattern *string `form:"pattern,omitempty" json:"pattern,omitempty" xml:"pattern,omitempty"`
+	// Value of the parameter when it's not given.
+	Default any `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the parameter.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ExportAuthorizationResponseBody is used to define fields on response body
+// types.
+type ExportAuthorizationResponseBody struct {
+	// Scopes which the token must grant.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Claims which the token must have with the given values.
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+	// Policy evaluated with the token claims as input. It must return allow set to
+	// true.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+}
+
+// ImportedCredentialResponseBody is used to define fields on response body
+// types.
+type ImportedCredentialResponseBody struct {
+	// Position of the credential in the presentation.
+	Index *int `form:"index,omitempty" json:"index,omitempty" xml:"index,omitempty"`
+	// Identifier of the credential, if it has one.
+	CredentialID *string `form:"credentialId,omitempty" json:"credentialId,omitempty" xml:"credentialId,omitempty"`
+	// Cache key of the credential subject. For duplicate credentials it is the key
+	// of the previous import.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Status of the import of the credential.
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Error message if the import of the credential failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Identifier of the import record of the credential, which is used to look up
+	// or delete the imported data.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// ImportRecordResponse is used to define fields on response body types.
+type ImportRecordResponse struct {
+	// Identifier of the import record.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Cache key of the imported credential subject.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Cache namespace of the imported credential subject.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache scope of the imported credential subject.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Identifier of the imported credential.
+	CredentialID *string `form:"credentialId,omitempty" json:"credentialId,omitempty" xml:"credentialId,omitempty"`
+	// Issuer of the imported credential.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Holder of the imported presentation.
+	Holder *string `form:"holder,omitempty" json:"holder,omitempty" xml:"holder,omitempty"`
+	// Identifier of the credential subject.
+	SubjectID *string `form:"subjectId,omitempty" json:"subjectId,omitempty" xml:"subjectId,omitempty"`
+	// SHA-256 hash of the canonical form of the credential.
+	Hash *string `form:"hash,omitempty" json:"hash,omitempty" xml:"hash,omitempty"`
+	// Client which imported the credential.
+	Importer *string `form:"importer,omitempty" json:"importer,omitempty" xml:"importer,omitempty"`
+	// Time of the import.
+	ImportedAt *string `form:"importedAt,omitempty" json:"importedAt,omitempty" xml:"importedAt,omitempty"`
+}
+
+// NewCreateExportRequestBody builds the HTTP request body from the payload of
+// the "CreateExport" endpoint of the "infohub" service.
+func NewCreateExportRequestBody(p *infohub.ExportConfiguration) *CreateExportRequestBody {
+	body := &CreateExportRequestBody{
+		ExportName:           p.ExportName,
+		CacheTTL:             p.CacheTTL,
+		Issuer:               p.Issuer,
+		KeyNamespace:         p.KeyNamespace,
+		Key:                  p.Key,
+		Layout:               p.Layout,
+		StaleWhileRevalidate: p.StaleWhileRevalidate,
+		Schedule:             p.Schedule,
+		ResultSchema:         p.ResultSchema,
+		Format:               p.Format,
+	}
+	if p.Contexts != nil {
+		body.Contexts = make([]string, len(p.Contexts))
+		for i, val := range p.Contexts {
+			body.Contexts[i] = val
+		}
+	}
+	if p.Policies != nil {
+		body.Policies = make(map[string]any, len(p.Policies))
+		for key, val := range p.Policies {
+			tk := key
+			tv := val
+			body.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if body.Layout == zero {
+			body.Layout = "credentialPerPolicy"
+		}
+	}
+	if p.CredentialTypes != nil {
+		body.CredentialTypes = make([]string, len(p.CredentialTypes))
+		for i, val := range p.CredentialTypes {
+			body.CredentialTypes[i] = val
+		}
+	}
+	if p.CredentialSchema != nil {
+		body.CredentialSchema = marshalInfohubCredentialSchemaToCredentialSchemaRequestBody(p.CredentialSchema)
+	}
+	{
+		var zero bool
+		if body.StaleWhileRevalidate == zero {
+			body.StaleWhileRevalidate = false
+		}
+	}
+	if p.Parameters != nil {
+		body.Parameters = make([]*ExportParameterRequestBody, len(p.Parameters))
+		for i, val := range p.Parameters {
+			body.Parameters[i] = marshalInfohubExportParameterToExportParameterRequestBody(val)
+		}
+	}
+	if p.Authorization != nil {
+		body.Authorization = marshalInfohubExportAuthorizationToExportAuthorizationRequestBody(p.Authorization)
+	}
+	{
+		var zero string
+		if body.Format == zero {
+			body.Format = "jsonld"
+		}
+	}
+	if p.SelectiveDisclosure != nil {
+		body.SelectiveDisclosure = make([]string, len(p.SelectiveDisclosure))
+		for i, val := range p.SelectiveDisclosure {
+			body.SelectiveDisclosure[i] = val
+		}
+	}
+	if p.PolicyOrder != nil {
+		body.PolicyOrder = make([]string, len(p.PolicyOrder))
+		for i, val := range p.PolicyOrder {
+			body.PolicyOrder[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateExportRequestBody builds the HTTP request body from the payload of
+// the "UpdateExport" endpoint of the "infohub" service.
+func NewUpdateExportRequestBody(p *infohub.ExportConfiguration) *UpdateExportRequestBody {
+	body := &UpdateExportRequestBody{
+		CacheTTL:             p.CacheTTL,
+		Issuer:               p.Issuer,
+		KeyNamespace:         p.KeyNamespace,
+		Key:                  p.Key,
+		Layout:               p.Layout,
+		StaleWhileRevalidate: p.StaleWhileRevalidate,
+		Schedule:             p.Schedule,
+		ResultSchema:         p.ResultSchema,
+		Format:               p.Format,
+	}
+	if p.Contexts != nil {
+		body.Contexts = make([]string, len(p.Contexts))
+		for i, val := range p.Contexts {
+			body.Contexts[i] = val
+		}
+	}
+	if p.Policies != nil {
+		body.Policies = make(map[string]any, len(p.Policies))
+		for key, val := range p.Policies {
+			tk := key
+			tv := val
+			body.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if body.Layout == zero {
+			body.Layout = "credentialPerPolicy"
+		}
+	}
+	if p.CredentialTypes != nil {
+		body.CredentialTypes = make([]string, len(p.CredentialTypes))
+		for i, val := range p.CredentialTypes {
+			body.CredentialTypes[i] = val
+		}
+	}
+	if p.CredentialSchema != nil {
+		body.CredentialSchema = marshalInfohubCredentialSchemaToCredentialSchemaRequestBody(p.CredentialSchema)
+	}
+	{
+		var zero bool
+		if body.StaleWhileRevalidate == zero {
+			body.StaleWhileRevalidate = false
+		}
+	}
+	if p.Parameters != nil {
+		body.Parameters = make([]*ExportParameterRequestBody, len(p.Parameters))
+		for i, val := range p.Parameters {
+			body.Parameters[i] = marshalInfohubExportParameterToExportParameterRequestBody(val)
+		}
+	}
+	if p.Authorization != nil {
+		body.Authorization = marshalInfohubExportAuthorizationToExportAuthorizationRequestBody(p.Authorization)
+	}
+	{
+		var zero string
+		if body.Format == zero {
+			body.Format = "jsonld"
+		}
+	}
+	if p.SelectiveDisclosure != nil {
+		body.SelectiveDisclosure = make([]string, len(p.SelectiveDisclosure))
+		for i, val := range p.SelectiveDisclosure {
+			body.SelectiveDisclosure[i] = val
+		}
+	}
+	if p.PolicyOrder != nil {
+		body.PolicyOrder = make([]string, len(p.PolicyOrder))
+		for i, val := range p.PolicyOrder {
+			body.PolicyOrder[i] = val
+		}
+	}
+	return body
+}
+
+// NewExportResultAccepted builds a "infohub" service "Export" endpoint result
+// from a HTTP "Accepted" response.
+func NewExportResultAccepted(body any, location *string) *infohub.ExportResult {
+	v := body
+	res := &infohub.ExportResult{
+		Result: v,
+	}
+	res.Location = location
+
+	return res
+}
+
+// NewExportResultOK builds a "infohub" service "Export" endpoint result from a
+// HTTP "OK" response.
+func NewExportResultOK(body any, contentType *string, location *string, stale *bool, age *int) *infohub.ExportResult {
+	v := body
+	res := &infohub.ExportResult{
+		Result: v,
+	}
+	res.ContentType = contentType
+	res.Location = location
+	res.Stale = stale
+	res.Age = age
+
+	return res
+}
+
+// NewGetExportJobExportJobOK builds a "infohub" service "GetExportJob"
+// endpoint result from a HTTP "OK" response.
+func NewGetExportJobExportJobOK(body *GetExportJobResponseBody) *infohub.ExportJob {
+	v := &infohub.ExportJob{
+		ID:         *body.ID,
+		ExportName: *body.ExportName,
+		Status:     *body.Status,
+		CreatedAt:  *body.CreatedAt,
+		UpdatedAt:  *body.UpdatedAt,
+	}
+	v.Policies = make([]*infohub.ExportJobPolicy, len(body.Policies))
+	for i, val := range body.Policies {
+		v.Policies[i] = unmarshalExportJobPolicyResponseBodyToInfohubExportJobPolicy(val)
+	}
+	if body.Parameters != nil {
+		v.Parameters = make(map[string]any, len(body.Parameters))
+		for key, val := range body.Parameters {
+			tk := key
+			tv := val
+			v.Parameters[tk] = tv
+		}
+	}
+
+	return v
+}
+
+// NewListExportsExportConfigurationOK builds a "infohub" service "ListExports"
+// endpoint result from a HTTP "OK" response.
+func NewListExportsExportConfigurationOK(body []*ExportConfigurationResponse) []*infohub.ExportConfiguration {
+	v := make([]*infohub.ExportConfiguration, len(body))
+	for i, val := range body {
+		v[i] = unmarshalExportConfigurationResponseToInfohubExportConfiguration(val)
+	}
+
+	return v
+}
+
+// NewCreateExportExportConfigurationCreated builds a "infohub" service
+// "CreateExport" endpoint result from a HTTP "Created" response.
+func NewCreateExportExportConfigurationCreated(body *CreateExportResponseBody) *infohub.ExportConfiguration {
+	v := &infohub.ExportConfiguration{
+		ExportName:   *body.ExportName,
+		CacheTTL:     body.CacheTTL,
+		Issuer:       *body.Issuer,
+		KeyNamespace: *body.KeyNamespace,
+		Key:          *body.Key,
+		Schedule:     body.Schedule,
+		ResultSchema: body.ResultSchema,
+	}
+	if body.Layout != nil {
+		v.Layout = *body.Layout
+	}
+	if body.StaleWhileRevalidate != nil {
+		v.StaleWhileRevalidate = *body.StaleWhileRevalidate
+	}
+	if body.Format != nil {
+		v.Format = *body.Format
+	}
+	if body.Contexts != nil {
+		v.Contexts = make([]string, len(body.Contexts))
+		for i, val := range body.Contexts {
+			v.Contexts[i] = val
+		}
+	}
+	v.Policies = make(map[string]any, len(body.Policies))
+	for key, val := range body.Policies {
+		tk := key
+		tv := val
+		v.Policies[tk] = tv
+	}
+	if body.Layout == nil {
+		v.Layout = "credentialPerPolicy"
+	}
+	if body.CredentialTypes != nil {
+		v.CredentialTypes = make([]string, len(body.CredentialTypes))
+		for i, val := range body.CredentialTypes {
+			v.CredentialTypes[i] = val
+		}
+	}
+	if body.CredentialSchema != nil {
+		v.CredentialSchema = unmarshalCredentialSchemaResponseBodyToInfohubCredentialSchema(body.CredentialSchema)
+	}
+	if body.StaleWhileRevalidate == nil {
+		v.StaleWhileRevalidate = false
+	}
+	if body.Parameters != nil {
+		v.Parameters = make([]*infohub.ExportParameter, len(body.Parameters))
+		for i, val := range body.Parameters {
+			v.Parameters[i] = unmarshalExportParameterResponseBodyToInfohubExportParameter(val)
+		}
+	}
+	if body.Authorization != nil {
+		v.Authorization = unmarshalExportAuthorizationResponseBodyToInfohubExportAuthorization(body.Authorization)
+	}
+	if body.Format == nil {
+		v.Format = "jsonld"
+	}
+	if body.SelectiveDisclosure != nil {
+		v.SelectiveDisclosure = make([]string, len(body.SelectiveDisclosure))
+		for i, val := range body.SelectiveDisclosure {
+			v.SelectiveDisclosure[i] = val
+		}
+	}
+	if body.PolicyOrder != nil {
+		v.PolicyOrder = make([]string, len(body.PolicyOrder))
+		for i, val := range body.PolicyOrder {
+			v.PolicyOrder[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetExportExportConfigurationOK builds a "infohub" service "GetExport"
+// endpoint result from a HTTP "OK" response.
+func NewGetExportExportConfigurationOK(body *GetExportResponseBody) *infohub.ExportConfiguration {
+	v := &infohub.ExportConfiguration{
+		ExportName:   *body.ExportName,
+		CacheTTL:     body.CacheTTL,
+		Issuer:       *body.Issuer,
+		KeyNamespace: *body.KeyNamespace,
+		Key:          *body.Key,
+		Schedule:     body.Schedule,
+		ResultSchema: body.ResultSchema,
+	}
+	if body.Layout != nil {
+		v.Layout = *body.Layout
+	}
+	if body.StaleWhileRevalidate != nil {
+		v.StaleWhileRevalidate = *body.StaleWhileRevalidate
+	}
+	if body.Format != nil {
+		v.Format = *body.Format
+	}
+	if body.Contexts != nil {
+		v.Contexts = make([]string, len(body.Contexts))
+		for i, val := range body.Contexts {
+			v.Contexts[i] = val
+		}
+	}
+	v.Policies = make(map[string]any, len(body.Policies))
+	for key, val := range body.Policies {
+		tk := key
+		tv := val
+		v.Policies[tk] = tv
+	}
+	if body.Layout == nil {
+		v.Layout = "credentialPerPolicy"
+	}
+	if body.CredentialTypes != nil {
+		v.CredentialTypes = make([]string, len(body.CredentialTypes))
+		for i, val := range body.CredentialTypes {
+			v.CredentialTypes[i] = val
+		}
+	}
+	if body.CredentialSchema != nil {
+		v.CredentialSchema = unmarshalCredentialSchemaResponseBodyToInfohubCredentialSchema(body.CredentialSchema)
+	}
+	if body.StaleWhileRevalidate == nil {
+		v.StaleWhileRevalidate = false
+	}
+	if body.Parameters != nil {
+		v.Parameters = make([]*infohub.ExportParameter, len(body.Parameters))
+		for i, val := range body.Parameters {
+			v.Parameters[i] = unmarshalExportParameterResponseBodyToInfohubExportParameter(val)
+		}
+	}
+	if body.Authorization != nil {
+		v.Authorization = unmarshalExportAuthorizationResponseBodyToInfohubExportAuthorization(body.Authorization)
+	}
+	if body.Format == nil {
+		v.Format = "jsonld"
+	}
+	if body.SelectiveDisclosure != nil {
+		v.SelectiveDisclosure = make([]string, len(body.SelectiveDisclosure))
+		for i, val := range body.SelectiveDisclosure {
+			v.SelectiveDisclosure[i] = val
+		}
+	}
+	if body.PolicyOrder != nil {
+		v.PolicyOrder = make([]string, len(body.PolicyOrder))
+		for i, val := range body.PolicyOrder {
+			v.PolicyOrder[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewUpdateExportExportConfigurationOK builds a "infohub" service
+// "UpdateExport" endpoint result from a HTTP "OK" response.
+func NewUpdateExportExportConfigurationOK(body *UpdateExportResponseBody) *infohub.ExportConfiguration {
+	v := &infohub.ExportConfiguration{
+		ExportName:   *body.ExportName,
+		CacheTTL:     body.CacheTTL,
+		Issuer:       *body.Issuer,
+		KeyNamespace: *body.KeyNamespace,
+		Key:          *body.Key,
+		Schedule:     body.Schedule,
+		ResultSchema: body.ResultSchema,
+	}
+	if body.Layout != nil {
+		v.Layout = *body.Layout
+	}
+	if body.StaleWhileRevalidate != nil {
+		v.StaleWhileRevalidate = *body.StaleWhileRevalidate
+	}
+	if body.Format != nil {
+		v.Format = *body.Format
+	}
+	if body.Contexts != nil {
+		v.Contexts = make([]string, len(body.Contexts))
+		for i, val := range body.Contexts {
+			v.Contexts[i] = val
+		}
+	}
+	v.Policies = make(map[string]any, len(body.Policies))
+	for key, val := range body.Policies {
+		tk := key
+		tv := val
+		v.Policies[tk] = tv
+	}
+	if body.Layout == nil {
+		v.Layout = "credentialPerPolicy"
+	}
+	if body.CredentialTypes != nil {
+		v.CredentialTypes = make([]string, len(body.CredentialTypes))
+		for i, val := range body.CredentialTypes {
+			v.CredentialTypes[i] = val
+		}
+	}
+	if body.CredentialSchema != nil {
+		v.CredentialSchema = unmarshalCredentialSchemaResponseBodyToInfohubCredentialSchema(body.CredentialSchema)
+	}
+	if body.StaleWhileRevalidate == nil {
+		v.StaleWhileRevalidate = false
+	}
+	if body.Parameters != nil {
+		v.Parameters = make([]*infohub.ExportParameter, len(body.Parameters))
+		for i, val := range body.Parameters {
+			v.Parameters[i] = unmarshalExportParameterResponseBodyToInfohubExportParameter(val)
+		}
+	}
+	if body.Authorization != nil {
+		v.Authorization = unmarshalExportAuthorizationResponseBodyToInfohubExportAuthorization(body.Authorization)
+	}
+	if body.Format == nil {
+		v.Format = "jsonld"
+	}
+	if body.SelectiveDisclosure != nil {
+		v.SelectiveDisclosure = make([]string, len(body.SelectiveDisclosure))
+		for i, val := range body.SelectiveDisclosure {
+			v.SelectiveDisclosure[i] = val
+		}
+	}
+	if body.PolicyOrder != nil {
+		v.PolicyOrder = make([]string, len(body.PolicyOrder))
+		for i, val := range body.PolicyOrder {
+			v.PolicyOrder[i] = val
+		}
+	}
+
+	return v
 }
 
 // NewImportResultOK builds a "infohub" service "Import" endpoint result from a
@@ -28,14 +1031,615 @@ func NewImportResultOK(body *ImportResponseBody) *infohub.ImportResult {
 	for i, val := range body.ImportIds {
 		v.ImportIds[i] = val
 	}
+	v.Credentials = make([]*infohub.ImportedCredential, len(body.Credentials))
+	for i, val := range body.Credentials {
+		v.Credentials[i] = unmarshalImportedCredentialResponseBodyToInfohubImportedCredential(val)
+	}
 
 	return v
 }
 
+// NewImportChallengeChallengeResultOK builds a "infohub" service
+// "ImportChallenge" endpoint result from a HTTP "OK" response.
+func NewImportChallengeChallengeResultOK(body *ImportChallengeResponseBody) *infohub.ChallengeResult {
+	v := &infohub.ChallengeResult{
+		Challenge: *body.Challenge,
+		Domain:    body.Domain,
+		ExpiresAt: *body.ExpiresAt,
+	}
+
+	return v
+}
+
+// NewListImportsImportRecordOK builds a "infohub" service "ListImports"
+// endpoint result from a HTTP "OK" response.
+func NewListImportsImportRecordOK(body []*ImportRecordResponse) []*infohub.ImportRecord {
+	v := make([]*infohub.ImportRecord, len(body))
+	for i, val := range body {
+		v[i] = unmarshalImportRecordResponseToInfohubImportRecord(val)
+	}
+
+	return v
+}
+
+// NewGetImportImportRecordOK builds a "infohub" service "GetImport" endpoint
+// result from a HTTP "OK" response.
+func NewGetImportImportRecordOK(body *GetImportResponseBody) *infohub.ImportRecord {
+	v := &infohub.ImportRecord{
+		ID:           *body.ID,
+		Key:          *body.Key,
+		Namespace:    body.Namespace,
+		Scope:        body.Scope,
+		CredentialID: body.CredentialID,
+		Issuer:       body.Issuer,
+		Holder:       body.Holder,
+		SubjectID:    body.SubjectID,
+		Hash:         *body.Hash,
+		Importer:     body.Importer,
+		ImportedAt:   *body.ImportedAt,
+	}
+
+	return v
+}
+
+// ValidateGetExportJobResponseBody runs the validations defined on
+// GetExportJobResponseBody
+func ValidateGetExportJobResponseBody(body *GetExportJobResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ExportName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exportName", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Policies == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("createdAt", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updatedAt", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "running" || *body.Status == "completed" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "running", "completed", "failed"}))
+		}
+	}
+	for _, e := range body.Policies {
+		if e != nil {
+			if err2 := ValidateExportJobPolicyResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.CreatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.createdAt", *body.CreatedAt, goa.FormatDateTime))
+	}
+	if body.UpdatedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.updatedAt", *body.UpdatedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateCreateExportResponseBody runs the validations defined on
+// CreateExportResponseBody
+func ValidateCreateExportResponseBody(body *CreateExportResponseBody) (err error) {
+	if body.ExportName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exportName", "body"))
+	}
+	if body.Policies == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+	}
+	if body.Issuer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("issuer", "body"))
+	}
+	if body.KeyNamespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keyNamespace", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.ExportName != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exportName", *body.ExportName, "^[A-Za-z0-9._-]+$"))
+	}
+	if len(body.Policies) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+	}
+	for k, _ := range body.Policies {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	if body.CacheTTL != nil {
+		if *body.CacheTTL < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+		}
+	}
+	if body.Issuer != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", *body.Issuer, "^did:[a-z0-9]+:.+$"))
+	}
+	if body.KeyNamespace != nil {
+		if utf8.RuneCountInString(*body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", *body.KeyNamespace, utf8.RuneCountInString(*body.KeyNamespace), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Layout != nil {
+		if !(*body.Layout == "credentialPerPolicy" || *body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", *body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+	}
+	if body.CredentialSchema != nil {
+		if err2 := ValidateCredentialSchemaResponseBody(body.CredentialSchema); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Parameters {
+		if e != nil {
+			if err2 := ValidateExportParameterResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Authorization != nil {
+		if err2 := ValidateExportAuthorizationResponseBody(body.Authorization); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "jsonld" || *body.Format == "jwt" || *body.Format == "sd-jwt" || *body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+	}
+	return
+}
+
+// ValidateGetExportResponseBody runs the validations defined on
+// GetExportResponseBody
+func ValidateGetExportResponseBody(body *GetExportResponseBody) (err error) {
+	if body.ExportName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exportName", "body"))
+	}
+	if body.Policies == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+	}
+	if body.Issuer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("issuer", "body"))
+	}
+	if body.KeyNamespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keyNamespace", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.ExportName != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exportName", *body.ExportName, "^[A-Za-z0-9._-]+$"))
+	}
+	if len(body.Policies) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+	}
+	for k, _ := range body.Policies {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	if body.CacheTTL != nil {
+		if *body.CacheTTL < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+		}
+	}
+	if body.Issuer != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", *body.Issuer, "^did:[a-z0-9]+:.+$"))
+	}
+	if body.KeyNamespace != nil {
+		if utf8.RuneCountInString(*body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", *body.KeyNamespace, utf8.RuneCountInString(*body.KeyNamespace), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Layout != nil {
+		if !(*body.Layout == "credentialPerPolicy" || *body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", *body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+	}
+	if body.CredentialSchema != nil {
+		if err2 := ValidateCredentialSchemaResponseBody(body.CredentialSchema); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Parameters {
+		if e != nil {
+			if err2 := ValidateExportParameterResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Authorization != nil {
+		if err2 := ValidateExportAuthorizationResponseBody(body.Authorization); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "jsonld" || *body.Format == "jwt" || *body.Format == "sd-jwt" || *body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateExportResponseBody runs the validations defined on
+// UpdateExportResponseBody
+func ValidateUpdateExportResponseBody(body *UpdateExportResponseBody) (err error) {
+	if body.ExportName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exportName", "body"))
+	}
+	if body.Policies == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+	}
+	if body.Issuer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("issuer", "body"))
+	}
+	if body.KeyNamespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keyNamespace", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.ExportName != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exportName", *body.ExportName, "^[A-Za-z0-9._-]+$"))
+	}
+	if len(body.Policies) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+	}
+	for k, _ := range body.Policies {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	if body.CacheTTL != nil {
+		if *body.CacheTTL < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+		}
+	}
+	if body.Issuer != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", *body.Issuer, "^did:[a-z0-9]+:.+$"))
+	}
+	if body.KeyNamespace != nil {
+		if utf8.RuneCountInString(*body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", *body.KeyNamespace, utf8.RuneCountInString(*body.KeyNamespace), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Layout != nil {
+		if !(*body.Layout == "credentialPerPolicy" || *body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", *body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+	}
+	if body.CredentialSchema != nil {
+		if err2 := ValidateCredentialSchemaResponseBody(body.CredentialSchema); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Parameters {
+		if e != nil {
+			if err2 := ValidateExportParameterResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Authorization != nil {
+		if err2 := ValidateExportAuthorizationResponseBody(body.Authorization); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "jsonld" || *body.Format == "jwt" || *body.Format == "sd-jwt" || *body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+	}
+	return
+}
+
 // ValidateImportResponseBody runs the validations defined on ImportResponseBody
 func ValidateImportResponseBody(body *ImportResponseBody) (err error) {
 	if body.ImportIds == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("importIds", "body"))
 	}
+	if body.Credentials == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("credentials", "body"))
+	}
+	for _, e := range body.Credentials {
+		if e != nil {
+			if err2 := ValidateImportedCredentialResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportChallengeResponseBody runs the validations defined on
+// ImportChallengeResponseBody
+func ValidateImportChallengeResponseBody(body *ImportChallengeResponseBody) (err error) {
+	if body.Challenge == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("challenge", "body"))
+	}
+	if body.ExpiresAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expiresAt", "body"))
+	}
+	if body.ExpiresAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.expiresAt", *body.ExpiresAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateGetImportResponseBody runs the validations defined on
+// GetImportResponseBody
+func ValidateGetImportResponseBody(body *GetImportResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Hash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hash", "body"))
+	}
+	if body.ImportedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("importedAt", "body"))
+	}
+	if body.ImportedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.importedAt", *body.ImportedAt, goa.FormatDateTime))
+	}
+	return
+}
+
+// ValidateExportJobPolicyResponseBody runs the validations defined on
+// ExportJobPolicyResponseBody
+func ValidateExportJobPolicyResponseBody(body *ExportJobPolicyResponseBody) (err error) {
+	if body.Policy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policy", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "evaluated" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "evaluated", "failed"}))
+		}
+	}
+	return
+}
+
+// ValidateExportConfigurationResponse runs the validations defined on
+// ExportConfigurationResponse
+func ValidateExportConfigurationResponse(body *ExportConfigurationResponse) (err error) {
+	if body.ExportName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exportName", "body"))
+	}
+	if body.Policies == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+	}
+	if body.Issuer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("issuer", "body"))
+	}
+	if body.KeyNamespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keyNamespace", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.ExportName != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exportName", *body.ExportName, "^[A-Za-z0-9._-]+$"))
+	}
+	if len(body.Policies) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+	}
+	for k, _ := range body.Policies {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	if body.CacheTTL != nil {
+		if *body.CacheTTL < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+		}
+	}
+	if body.Issuer != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", *body.Issuer, "^did:[a-z0-9]+:.+$"))
+	}
+	if body.KeyNamespace != nil {
+		if utf8.RuneCountInString(*body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", *body.KeyNamespace, utf8.RuneCountInString(*body.KeyNamespace), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Layout != nil {
+		if !(*body.Layout == "credentialPerPolicy" || *body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", *body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+	}
+	if body.CredentialSchema != nil {
+		if err2 := ValidateCredentialSchemaResponse(body.CredentialSchema); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Parameters {
+		if e != nil {
+			if err2 := ValidateExportParameterResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Authorization != nil {
+		if err2 := ValidateExportAuthorizationResponse(body.Authorization); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "jsonld" || *body.Format == "jwt" || *body.Format == "sd-jwt" || *body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+	}
+	return
+}
+
+// ValidateCredentialSchemaResponse runs the validations defined on
+// CredentialSchemaResponse
+func ValidateCredentialSchemaResponse(body *CredentialSchemaResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatURI))
+	}
+	return
+}
+
+// ValidateExportParameterResponse runs the validations defined on
+// ExportParameterResponse
+func ValidateExportParameterResponse(body *ExportParameterResponse) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "^[A-Za-z_][A-Za-z0-9_]*$"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "number" || *body.Type == "integer" || *body.Type == "boolean") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "number", "integer", "boolean"}))
+		}
+	}
+	return
+}
+
+// ValidateExportAuthorizationResponse runs the validations defined on
+// ExportAuthorizationResponse
+func ValidateExportAuthorizationResponse(body *ExportAuthorizationResponse) (err error) {
+	if body.Policy != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policy", *body.Policy, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	return
+}
+
+// ValidateCredentialSchemaRequestBody runs the validations defined on
+// CredentialSchemaRequestBody
+func ValidateCredentialSchemaRequestBody(body *CredentialSchemaRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidateFormat("body.id", body.ID, goa.FormatURI))
+	return
+}
+
+// ValidateExportParameterRequestBody runs the validations defined on
+// ExportParameterRequestBody
+func ValidateExportParameterRequestBody(body *ExportParameterRequestBody) (err error) {
+	err = goa.MergeErrors(err, goa.ValidatePattern("body.name", body.Name, "^[A-Za-z_][A-Za-z0-9_]*$"))
+	if !(body.Type == "string" || body.Type == "number" || body.Type == "integer" || body.Type == "boolean") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", body.Type, []any{"string", "number", "integer", "boolean"}))
+	}
+	return
+}
+
+// ValidateExportAuthorizationRequestBody runs the validations defined on
+// ExportAuthorizationRequestBody
+func ValidateExportAuthorizationRequestBody(body *ExportAuthorizationRequestBody) (err error) {
+	if body.Policy != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policy", *body.Policy, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	return
+}
+
+// ValidateCredentialSchemaResponseBody runs the validations defined on
+// CredentialSchemaResponseBody
+func ValidateCredentialSchemaResponseBody(body *CredentialSchemaResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatURI))
+	}
+	return
+}
+
+// ValidateExportParameterResponseBody runs the validations defined on
+// ExportParameterResponseBody
+func ValidateExportParameterResponseBody(body *ExportParameterResponseBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "^[A-Za-z_][A-Za-z0-9_]*$"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "number" || *body.Type == "integer" || *body.Type == "boolean") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "number", "integer", "boolean"}))
+		}
+	}
+	return
+}
+
+// ValidateExportAuthorizationResponseBody runs the validations defined on
+// ExportAuthorizationResponseBody
+func ValidateExportAuthorizationResponseBody(body *ExportAuthorizationResponseBody) (err error) {
+	if body.Policy != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policy", *body.Policy, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	return
+}
+
+// ValidateImportedCredentialResponseBody runs the validations defined on
+// ImportedCredentialResponseBody
+func ValidateImportedCredentialResponseBody(body *ImportedCredentialResponseBody) (err error) {
+	if body.Index == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("index", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "imported" || *body.Status == "duplicate" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"imported", "duplicate", "failed"}))
+		}
+	}
+	return
+}
+
+// ValidateImportRecordResponse runs the validations defined on
+// ImportRecordResponse
+func ValidateImportRecordResponse(body *ImportRecordResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.Hash == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hash", "body"))
+	}
+	if body.ImportedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("importedAt", "body"))
+	}
+	if body.ImportedAt != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.importedAt", *body.ImportedAt, goa.FormatDateTime))
+	}
 	return
 }
@@ -12,6 +12,7 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"strconv"
 
 	infohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
 	goahttp "goa.design/goa/v3/http"
@@ -22,9 +23,32 @@ import (
 // infohub Export endpoint.
 func EncodeExportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(any)
+		res, _ := v.(*infohub.ExportResult)
+		if res.Status == "accepted" {
+			enc := encoder(ctx, w)
+			body := res.Result
+			w.Header().Set("Location", *res.Location)
+			w.WriteHeader(http.StatusAccepted)
+			return enc.Encode(body)
+		}
 		enc := encoder(ctx, w)
-		body := res
+		body := res.Result
+		if res.ContentType != nil {
+			w.Header().Set("Content-Type", *res.ContentType)
+		}
+		if res.Location != nil {
+			w.Header().Set("Location", *res.Location)
+		}
+		if res.Stale != nil {
+			val := res.Stale
+			stales := strconv.FormatBool(*val)
+			w.Header().Set("X-Export-Stale", stales)
+		}
+		if res.Age != nil {
+			val := res.Age
+			ages := strconv.Itoa(*val)
+			w.Header().Set("Age", ages)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -33,6 +57,153 @@ func EncodeExportResponse(encoder func(context.Context, http.ResponseWriter) goa
 // DecodeExportRequest returns a decoder for requests sent to the infohub
 // Export endpoint.
 func DecodeExportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body map[string]any
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				err = nil
+			} else {
+				var gerr *goa.ServiceError
+				if errors.As(err, &gerr) {
+					return nil, gerr
+				}
+				return nil, goa.DecodePayloadError(err.Error())
+			}
+		}
+
+		var (
+			exportName string
+			query      map[string]string
+			accept     *string
+
+			params = mux.Vars(r)
+		)
+		exportName = params["exportName"]
+		{
+			queryRaw := r.URL.Query()
+			if len(queryRaw) != 0 {
+				if query == nil {
+					query = make(map[string]string)
+				}
+				for keyRaw, valRaw := range queryRaw {
+					var key string
+					key = keyRaw
+					query[key] = valRaw[0]
+				}
+			}
+		}
+		acceptRaw := r.Header.Get("Accept")
+		if acceptRaw != "" {
+			accept = &acceptRaw
+		}
+		payload := NewExportRequest(body, exportName, query, accept)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetExportJobResponse returns an encoder for responses returned by the
+// infohub GetExportJob endpoint.
+func EncodeGetExportJobResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*infohub.ExportJob)
+		enc := encoder(ctx, w)
+		body := NewGetExportJobResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetExportJobRequest returns a decoder for requests sent to the infohub
+// GetExportJob endpoint.
+func DecodeGetExportJobRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exportName string
+			id         string
+
+			params = mux.Vars(r)
+		)
+		exportName = params["exportName"]
+		id = params["id"]
+		payload := NewGetExportJobExportJobRequest(exportName, id)
+
+		return payload, nil
+	}
+}
+
+// EncodeListExportsResponse returns an encoder for responses returned by the
+// infohub ListExports endpoint.
+func EncodeListExportsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*infohub.ExportConfiguration)
+		enc := encoder(ctx, w)
+		body := NewListExportsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeCreateExportResponse returns an encoder for responses returned by the
+// infohub CreateExport endpoint.
+func EncodeCreateExportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*infohub.ExportConfiguration)
+		enc := encoder(ctx, w)
+		body := NewCreateExportResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateExportRequest returns a decoder for requests sent to the infohub
+// CreateExport endpoint.
+func DecodeCreateExportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateExportRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateExportRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateExportExportConfiguration(&body)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetExportResponse returns an encoder for responses returned by the
+// infohub GetExport endpoint.
+func EncodeGetExportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*infohub.ExportConfiguration)
+		enc := encoder(ctx, w)
+		body := NewGetExportResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetExportRequest returns a decoder for requests sent to the infohub
+// GetExport endpoint.
+func DecodeGetExportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
 			exportName string
@@ -40,7 +211,84 @@ func DecodeExportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.
 			params = mux.Vars(r)
 		)
 		exportName = params["exportName"]
-		payload := NewExportRequest(exportName)
+		payload := NewGetExportExportConfigurationRequest(exportName)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateExportResponse returns an encoder for responses returned by the
+// infohub UpdateExport endpoint.
+func EncodeUpdateExportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*infohub.ExportConfiguration)
+		enc := encoder(ctx, w)
+		body := NewUpdateExportResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateExportRequest returns a decoder for requests sent to the infohub
+// UpdateExport endpoint.
+func DecodeUpdateExportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateExportRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateExportRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			exportName string
+
+			params = mux.Vars(r)
+		)
+		exportName = params["exportName"]
+		err = goa.MergeErrors(err, goa.ValidatePattern("exportName", exportName, "^[A-Za-z0-9._-]+$"))
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateExportExportConfiguration(&body, exportName)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteExportResponse returns an encoder for responses returned by the
+// infohub DeleteExport endpoint.
+func EncodeDeleteExportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteExportRequest returns a decoder for requests sent to the infohub
+// DeleteExport endpoint.
+func DecodeDeleteExportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exportName string
+
+			params = mux.Vars(r)
+		)
+		exportName = params["exportName"]
+		payload := NewDeleteExportExportConfigurationRequest(exportName)
 
 		return payload, nil
 	}
@@ -77,8 +325,534 @@ func DecodeImportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
-		payload := NewImportRequest(body)
+
+		var (
+			namespace      *string
+			scope          *string
+			key            *string
+			keyFrom        string
+			atomic         bool
+			idempotencyKey *string
+		)
+		qp := r.URL.Query()
+		namespaceRaw := qp.Get("namespace")
+		if namespaceRaw != "" {
+			namespace = &namespaceRaw
+		}
+		scopeRaw := qp.Get("scope")
+		if scopeRaw != "" {
+			scope = &scopeRaw
+		}
+		keyRaw := qp.Get("key")
+		if keyRaw != "" {
+			key = &keyRaw
+		}
+		keyFromRaw := qp.Get("keyFrom")
+		if keyFromRaw != "" {
+			keyFrom = keyFromRaw
+		} else {
+			keyFrom = "random"
+		}
+		if !(keyFrom == "random" || keyFrom == "credentialId" || keyFrom == "subjectId") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("keyFrom", keyFrom, []any{"random", "credentialId", "subjectId"}))
+		}
+		{
+			atomicRaw := qp.Get("atomic")
+			if atomicRaw != "" {
+				v, err2 := strconv.ParseBool(atomicRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("atomic", atomicRaw, "boolean"))
+				}
+				atomic = v
+			}
+		}
+		idempotencyKeyRaw := r.Header.Get("Idempotency-Key")
+		if idempotencyKeyRaw != "" {
+			idempotencyKey = &idempotencyKeyRaw
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewImportRequest(body, namespace, scope, key, keyFrom, atomic, idempotencyKey)
+
+		return payload, nil
+	}
+}
+
+// EncodeImportChallengeResponse returns an encoder for responses returned by
+// the infohub ImportChallenge endpoint.
+func EncodeImportChallengeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*infohub.ChallengeResult)
+		enc := encoder(ctx, w)
+		body := NewImportChallengeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// EncodeListImportsResponse returns an encoder for responses returned by the
+// infohub ListImports endpoint.
+func EncodeListImportsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*infohub.ImportRecord)
+		enc := encoder(ctx, w)
+		body := NewListImportsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListImportsRequest returns a decoder for requests sent to the infohub
+// ListImports endpoint.
+func DecodeListImportsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			issuer    *string
+			holder    *string
+			subjectID *string
+			limit     int
+			err       error
+		)
+		qp := r.URL.Query()
+		issuerRaw := qp.Get("issuer")
+		if issuerRaw != "" {
+			issuer = &issuerRaw
+		}
+		holderRaw := qp.Get("holder")
+		if holderRaw != "" {
+			holder = &holderRaw
+		}
+		subjectIDRaw := qp.Get("subjectId")
+		if subjectIDRaw != "" {
+			subjectID = &subjectIDRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 100
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 1000 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1000, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListImportsImportRecordsRequest(issuer, holder, subjectID, limit)
 
 		return payload, nil
 	}
 }
+
+// EncodeGetImportResponse returns an encoder for responses returned by the
+// infohub GetImport endpoint.
+func EncodeGetImportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*infohub.ImportRecord)
+		enc := encoder(ctx, w)
+		body := NewGetImportResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetImportRequest returns a decoder for requests sent to the infohub
+// GetImport endpoint.
+func DecodeGetImportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewGetImportImportRecordRequest(id)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteImportResponse returns an encoder for responses returned by the
+// infohub DeleteImport endpoint.
+func EncodeDeleteImportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	}
+}
+
+// DecodeDeleteImportRequest returns a decoder for requests sent to the infohub
+// DeleteImport endpoint.
+func DecodeDeleteImportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id string
+
+			params = mux.Vars(r)
+		)
+		id = params["id"]
+		payload := NewDeleteImportImportRecordRequest(id)
+
+		return payload, nil
+	}
+}
+
+// marshalInfohubExportJobPolicyToExportJobPolicyResponseBody builds a value of
+// type *ExportJobPolicyResponseBody from a value of type
+// *infohub.ExportJobPolicy.
+func marshalInfohubExportJobPolicyToExportJobPolicyResponseBody(v *infohub.ExportJobPolicy) *ExportJobPolicyResponseBody {
+	res := &ExportJobPolicyResponseBody{
+		Policy: v.Policy,
+		Status: v.Status,
+		Error:  v.Error,
+	}
+
+	return res
+}
+
+// marshalInfohubExportConfigurationToExportConfigurationResponse builds a
+// value of type *ExportConfigurationResponse from a value of type
+// *infohub.ExportConfiguration.
+func marshalInfohubExportConfigurationToExportConfigurationResponse(v *infohub.ExportConfiguration) *ExportConfigurationResponse {
+	res := &ExportConfigurationResponse{
+		ExportName:           v.ExportName,
+		CacheTTL:             v.CacheTTL,
+		Issuer:               v.Issuer,
+		KeyNamespace:         v.KeyNamespace,
+		Key:                  v.Key,
+		Layout:               v.Layout,
+		StaleWhileRevalidate: v.StaleWhileRevalidate,
+		Schedule:             v.Schedule,
+		ResultSchema:         v.ResultSchema,
+		Format:               v.Format,
+	}
+	if v.Contexts != nil {
+		res.Contexts = make([]string, len(v.Contexts))
+		for i, val := range v.Contexts {
+			res.Contexts[i] = val
+		}
+	}
+	if v.Policies != nil {
+		res.Policies = make(map[string]any, len(v.Policies))
+		for key, val := range v.Policies {
+			tk := key
+			tv := val
+			res.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if res.Layout == zero {
+			res.Layout = "credentialPerPolicy"
+		}
+	}
+	if v.CredentialTypes != nil {
+		res.CredentialTypes = make([]string, len(v.CredentialTypes))
+		for i, val := range v.CredentialTypes {
+			res.CredentialTypes[i] = val
+		}
+	}
+	if v.CredentialSchema != nil {
+		res.CredentialSchema = marshalInfohubCredentialSchemaToCredentialSchemaResponse(v.CredentialSchema)
+	}
+	{
+		var zero bool
+		if res.StaleWhileRevalidate == zero {
+			res.StaleWhileRevalidate = false
+		}
+	}
+	if v.Parameters != nil {
+		res.Parameters = make([]*ExportParameterResponse, len(v.Parameters))
+		for i, val := range v.Parameters {
+			res.Parameters[i] = marshalInfohubExportParameterToExportParameterResponse(val)
+		}
+	}
+	if v.Authorization != nil {
+		res.Authorization = marshalInfohubExportAuthorizationToExportAuthorizationResponse(v.Authorization)
+	}
+	{
+		var zero string
+		if res.Format == zero {
+			res.Format = "jsonld"
+		}
+	}
+	if v.SelectiveDisclosure != nil {
+		res.SelectiveDisclosure = make([]string, len(v.SelectiveDisclosure))
+		for i, val := range v.SelectiveDisclosure {
+			res.SelectiveDisclosure[i] = val
+		}
+	}
+	if v.PolicyOrder != nil {
+		res.PolicyOrder = make([]string, len(v.PolicyOrder))
+		for i, val := range v.PolicyOrder {
+			res.PolicyOrder[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalInfohubCredentialSchemaToCredentialSchemaResponse builds a value of
+// type *CredentialSchemaResponse from a value of type
+// *infohub.CredentialSchema.
+func marshalInfohubCredentialSchemaToCredentialSchemaResponse(v *infohub.CredentialSchema) *CredentialSchemaResponse {
+	if v == nil {
+		return nil
+	}
+	res := &CredentialSchemaResponse{
+		ID:   v.ID,
+		Type: v.Type,
+	}
+
+	return res
+}
+
+// marshalInfohubExportParameterToExportParameterResponse builds a value of
+// type *ExportParameterResponse from a value of type *infohub.ExportParameter.
+func marshalInfohubExportParameterToExportParameterResponse(v *infohub.ExportParameter) *ExportParameterResponse {
+	if v == nil {
+		return nil
+	}
+	res := &ExportParameterResponse{
+		Name:        v.Name,
+		Type:        v.Type,
+		Required:    v.Required,
+		Pattern:     v.Pattern,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+	{
+		var zero string
+		if res.Type == zero {
+			res.Type = "string"
+		}
+	}
+	{
+		var zero bool
+		if res.Required == zero {
+			res.Required = false
+		}
+	}
+
+	return res
+}
+
+// marshalInfohubExportAuthorizationToExportAuthorizationResponse builds a
+// value of type *ExportAuthorizationResponse from a value of type
+// *infohub.ExportAuthorization.
+func marshalInfohubExportAuthorizationToExportAuthorizationResponse(v *infohub.ExportAuthorization) *ExportAuthorizationResponse {
+	if v == nil {
+		return nil
+	}
+	res := &ExportAuthorizationResponse{
+		Policy: v.Policy,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// unmarshalCredentialSchemaRequestBodyToInfohubCredentialSchema builds a value
+// of type *infohub.CredentialSchema from a value of type
+// *CredentialSchemaRequestBody.
+func unmarshalCredentialSchemaRequestBodyToInfohubCredentialSchema(v *CredentialSchemaRequestBody) *infohub.CredentialSchema {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.CredentialSchema{
+		ID:   *v.ID,
+		Type: *v.Type,
+	}
+
+	return res
+}
+
+// unmarshalExportParameterRequestBodyToInfohubExportParameter builds a value
+// of type *infohub.ExportParameter from a value of type
+// *ExportParameterRequestBody.
+func unmarshalExportParameterRequestBodyToInfohubExportParameter(v *ExportParameterRequestBody) *infohub.ExportParameter {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportParameter{
+		Name:        *v.Name,
+		Pattern:     v.Pattern,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+	if v.Type != nil {
+		res.Type = *v.Type
+	}
+	if v.Required != nil {
+		res.Required = *v.Required
+	}
+	if v.Type == nil {
+		res.Type = "string"
+	}
+	if v.Required == nil {
+		res.Required = false
+	}
+
+	return res
+}
+
+// unmarshalExportAuthorizationRequestBodyToInfohubExportAuthorization builds a
+// value of type *infohub.ExportAuthorization from a value of type
+// *ExportAuthorizationRequestBody.
+func unmarshalExportAuthorizationRequestBodyToInfohubExportAuthorization(v *ExportAuthorizationRequestBody) *infohub.ExportAuthorization {
+	if v == nil {
+		return nil
+	}
+	res := &infohub.ExportAuthorization{
+		Policy: v.Policy,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalInfohubCredentialSchemaToCredentialSchemaResponseBody builds a value
+// of type *CredentialSchemaResponseBody from a value of type
+// *infohub.CredentialSchema.
+func marshalInfohubCredentialSchemaToCredentialSchemaResponseBody(v *infohub.CredentialSchema) *CredentialSchemaResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &CredentialSchemaResponseBody{
+		ID:   v.ID,
+		Type: v.Type,
+	}
+
+	return res
+}
+
+// marshalInfohubExportParameterToExportParameterResponseBody builds a value of
+// type *ExportParameterResponseBody from a value of type
+// *infohub.ExportParameter.
+func marshalInfohubExportParameterToExportParameterResponseBody(v *infohub.ExportParameter) *ExportParameterResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ExportParameterResponseBody{
+		Name:        v.Name,
+		Type:        v.Type,
+		Required:    v.Required,
+		Pattern:     v.Pattern,
+		Default:     v.Default,
+		Description: v.Description,
+	}
+	{
+		var zero string
+		if res.Type == zero {
+			res.Type = "string"
+		}
+	}
+	{
+		var zero bool
+		if res.Required == zero {
+			res.Required = false
+		}
+	}
+
+	return res
+}
+
+// marshalInfohubExportAuthorizationToExportAuthorizationResponseBody builds a
+// value of type *ExportAuthorizationResponseBody from a value of type
+// *infohub.ExportAuthorization.
+func marshalInfohubExportAuthorizationToExportAuthorizationResponseBody(v *infohub.ExportAuthorization) *ExportAuthorizationResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &ExportAuthorizationResponseBody{
+		Policy: v.Policy,
+	}
+	if v.Scopes != nil {
+		res.Scopes = make([]string, len(v.Scopes))
+		for i, val := range v.Scopes {
+			res.Scopes[i] = val
+		}
+	}
+	if v.Claims != nil {
+		res.Claims = make(map[string]string, len(v.Claims))
+		for key, val := range v.Claims {
+			tk := key
+			tv := val
+			res.Claims[tk] = tv
+		}
+	}
+
+	return res
+}
+
+// marshalInfohubImportedCredentialToImportedCredentialResponseBody builds a
+// value of type *ImportedCredentialResponseBody from a value of type
+// *infohub.ImportedCredential.
+func marshalInfohubImportedCredentialToImportedCredentialResponseBody(v *infohub.ImportedCredential) *ImportedCredentialResponseBody {
+	res := &ImportedCredentialResponseBody{
+		Index:        v.Index,
+		CredentialID: v.CredentialID,
+		Key:          v.Key,
+		Status:       v.Status,
+		Error:        v.Error,
+		ID:           v.ID,
+	}
+
+	return res
+}
+
+// marshalInfohubImportRecordToImportRecordResponse builds a value of type
+// *ImportRecordResponse from a value of type *infohub.ImportRecord.
+func marshalInfohubImportRecordToImportRecordResponse(v *infohub.ImportRecord) *ImportRecordResponse {
+	res := &ImportRecordResponse{
+		ID:           v.ID,
+		Key:          v.Key,
+		Namespace:    v.Namespace,
+		Scope:        v.Scope,
+		CredentialID: v.CredentialID,
+		Issuer:       v.Issuer,
+		Holder:       v.Holder,
+		SubjectID:    v.SubjectID,
+		Hash:         v.Hash,
+		Importer:     v.Importer,
+		ImportedAt:   v.ImportedAt,
+	}
+
+	return res
+}
@@ -18,9 +18,19 @@ import (
 
 // Server lists the infohub service endpoint HTTP handlers.
 type Server struct {
-	Mounts []*MountPoint
-	Export http.Handler
-	Import http.Handler
+	Mounts          []*MountPoint
+	Export          http.Handler
+	GetExportJob    http.Handler
+	ListExports     http.Handler
+	CreateExport    http.Handler
+	GetExport       http.Handler
+	UpdateExport    http.Handler
+	DeleteExport    http.Handler
+	Import          http.Handler
+	ImportChallenge http.Handler
+	ListImports     http.Handler
+	GetImport       http.Handler
+	DeleteImport    http.Handler
 }
 
 // MountPoint holds information about the mounted endpoints.
@@ -51,10 +61,31 @@ func New(
 	return &Server{
 		Mounts: []*MountPoint{
 			{"Export", "GET", "/v1/export/{exportName}"},
+			{"Export", "POST", "/v1/export/{exportName}"},
+			{"GetExportJob", "GET", "/v1/export/{exportName}/jobs/{id}"},
+			{"ListExports", "GET", "/v1/exports"},
+			{"CreateExport", "POST", "/v1/exports"},
+			{"GetExport", "GET", "/v1/exports/{exportName}"},
+			{"UpdateExport", "PUT", "/v1/exports/{exportName}"},
+			{"DeleteExport", "DELETE", "/v1/exports/{exportName}"},
 			{"Import", "POST", "/v1/import"},
+			{"ImportChallenge", "GET", "/v1/import/challenge"},
+			{"ListImports", "GET", "/v1/imports"},
+			{"GetImport", "GET", "/v1/import/{id}"},
+			{"DeleteImport", "DELETE", "/v1/import/{id}"},
 		},
-		Export: NewExportHandler(e.Export, mux, decoder, encoder, errhandler, formatter),
-		Import: NewImportHandler(e.Import, mux, decoder, encoder, errhandler, formatter),
+		Export:          NewExportHandler(e.Export, mux, decoder, encoder, errhandler, formatter),
+		GetExportJob:    NewGetExportJobHandler(e.GetExportJob, mux, decoder, encoder, errhandler, formatter),
+		ListExports:     NewListExportsHandler(e.ListExports, mux, decoder, encoder, errhandler, formatter),
+		CreateExport:    NewCreateExportHandler(e.CreateExport, mux, decoder, encoder, errhandler, formatter),
+		GetExport:       NewGetExportHandler(e.GetExport, mux, decoder, encoder, errhandler, formatter),
+		UpdateExport:    NewUpdateExportHandler(e.UpdateExport, mux, decoder, encoder, errhandler, formatter),
+		DeleteExport:    NewDeleteExportHandler(e.DeleteExport, mux, decoder, encoder, errhandler, formatter),
+		Import:          NewImportHandler(e.Import, mux, decoder, encoder, errhandler, formatter),
+		ImportChallenge: NewImportChallengeHandler(e.ImportChallenge, mux, decoder, encoder, errhandler, formatter),
+		ListImports:     NewListImportsHandler(e.ListImports, mux, decoder, encoder, errhandler, formatter),
+		GetImport:       NewGetImportHandler(e.GetImport, mux, decoder, encoder, errhandler, formatter),
+		DeleteImport:    NewDeleteImportHandler(e.DeleteImport, mux, decoder, encoder, errhandler, formatter),
 	}
 }
 
@@ -64,7 +95,17 @@ func (s *Server) Service() string { return "infohub" }
 // Use wraps the server handlers with the given middleware.
 func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.Export = m(s.Export)
+	s.GetExportJob = m(s.GetExportJob)
+	s.ListExports = m(s.ListExports)
+	s.CreateExport = m(s.CreateExport)
+	s.GetExport = m(s.GetExport)
+	s.UpdateExport = m(s.UpdateExport)
+	s.DeleteExport = m(s.DeleteExport)
 	s.Import = m(s.Import)
+	s.ImportChallenge = m(s.ImportChallenge)
+	s.ListImports = m(s.ListImports)
+	s.GetImport = m(s.GetImport)
+	s.DeleteImport = m(s.DeleteImport)
 }
 
 // MethodNames returns the methods served.
@@ -73,7 +114,17 @@ func (s *Server) MethodNames() []string { return infohub.MethodNames[:] }
 // Mount configures the mux to serve the infohub endpoints.
 func Mount(mux goahttp.Muxer, h *Server) {
 	MountExportHandler(mux, h.Export)
+	MountGetExportJobHandler(mux, h.GetExportJob)
+	MountListExportsHandler(mux, h.ListExports)
+	MountCreateExportHandler(mux, h.CreateExport)
+	MountGetExportHandler(mux, h.GetExport)
+	MountUpdateExportHandler(mux, h.UpdateExport)
+	MountDeleteExportHandler(mux, h.DeleteExport)
 	MountImportHandler(mux, h.Import)
+	MountImportChallengeHandler(mux, h.ImportChallenge)
+	MountListImportsHandler(mux, h.ListImports)
+	MountGetImportHandler(mux, h.GetImport)
+	MountDeleteImportHandler(mux, h.DeleteImport)
 }
 
 // Mount configures the mux to serve the infohub endpoints.
@@ -91,6 +142,7 @@ func MountExportHandler(mux goahttp.Muxer, h http.Handler) {
 		}
 	}
 	mux.Handle("GET", "/v1/export/{exportName}", f)
+	mux.Handle("POST", "/v1/export/{exportName}", f)
 }
 
 // NewExportHandler creates a HTTP handler which loads the HTTP request and
@@ -132,6 +184,305 @@ func NewExportHandler(
 	})
 }
 
+// MountGetExportJobHandler configures the mux to serve the "infohub" service
+// "GetExportJob" endpoint.
+func MountGetExportJobHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/export/{exportName}/jobs/{id}", f)
+}
+
+// NewGetExportJobHandler creates a HTTP handler which loads the HTTP request
+// and calls the "infohub" service "GetExportJob" endpoint.
+func NewGetExportJobHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetExportJobRequest(mux, decoder)
+		encodeResponse = EncodeGetExportJobResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetExportJob")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListExportsHandler configures the mux to serve the "infohub" service
+// "ListExports" endpoint.
+func MountListExportsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/exports", f)
+}
+
+// NewListExportsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "infohub" service "ListExports" endpoint.
+func NewListExportsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeListExportsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListExports")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateExportHandler configures the mux to serve the "infohub" service
+// "CreateExport" endpoint.
+func MountCreateExportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/v1/exports", f)
+}
+
+// NewCreateExportHandler creates a HTTP handler which loads the HTTP request
+// and calls the "infohub" service "CreateExport" endpoint.
+func NewCreateExportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateExportRequest(mux, decoder)
+		encodeResponse = EncodeCreateExportResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CreateExport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetExportHandler configures the mux to serve the "infohub" service
+// "GetExport" endpoint.
+func MountGetExportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/exports/{exportName}", f)
+}
+
+// NewGetExportHandler creates a HTTP handler which loads the HTTP request and
+// calls the "infohub" service "GetExport" endpoint.
+func NewGetExportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetExportRequest(mux, decoder)
+		encodeResponse = EncodeGetExportResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetExport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateExportHandler configures the mux to serve the "infohub" service
+// "UpdateExport" endpoint.
+func MountUpdateExportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/v1/exports/{exportName}", f)
+}
+
+// NewUpdateExportHandler creates a HTTP handler which loads the HTTP request
+// and calls the "infohub" service "UpdateExport" endpoint.
+func NewUpdateExportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateExportRequest(mux, decoder)
+		encodeResponse = EncodeUpdateExportResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "UpdateExport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteExportHandler configures the mux to serve the "infohub" service
+// "DeleteExport" endpoint.
+func MountDeleteExportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/exports/{exportName}", f)
+}
+
+// NewDeleteExportHandler creates a HTTP handler which loads the HTTP request
+// and calls the "infohub" service "DeleteExport" endpoint.
+func NewDeleteExportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteExportRequest(mux, decoder)
+		encodeResponse = EncodeDeleteExportResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteExport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountImportHandler configures the mux to serve the "infohub" service
 // "Import" endpoint.
 func MountImportHandler(mux goahttp.Muxer, h http.Handler) {
@@ -182,3 +533,200 @@ func NewImportHandler(
 		}
 	})
 }
+
+// MountImportChallengeHandler configures the mux to serve the "infohub"
+// service "ImportChallenge" endpoint.
+func MountImportChallengeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/import/challenge", f)
+}
+
+// NewImportChallengeHandler creates a HTTP handler which loads the HTTP
+// request and calls the "infohub" service "ImportChallenge" endpoint.
+func NewImportChallengeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		encodeResponse = EncodeImportChallengeResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ImportChallenge")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		var err error
+		res, err := endpoint(ctx, nil)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListImportsHandler configures the mux to serve the "infohub" service
+// "ListImports" endpoint.
+func MountListImportsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/imports", f)
+}
+
+// NewListImportsHandler creates a HTTP handler which loads the HTTP request
+// and calls the "infohub" service "ListImports" endpoint.
+func NewListImportsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListImportsRequest(mux, decoder)
+		encodeResponse = EncodeListImportsResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListImports")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetImportHandler configures the mux to serve the "infohub" service
+// "GetImport" endpoint.
+func MountGetImportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/v1/import/{id}", f)
+}
+
+// NewGetImportHandler creates a HTTP handler which loads the HTTP request and
+// calls the "infohub" service "GetImport" endpoint.
+func NewGetImportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetImportRequest(mux, decoder)
+		encodeResponse = EncodeGetImportResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetImport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteImportHandler configures the mux to serve the "infohub" service
+// "DeleteImport" endpoint.
+func MountDeleteImportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/v1/import/{id}", f)
+}
+
+// NewDeleteImportHandler creates a HTTP handler which loads the HTTP request
+// and calls the "infohub" service "DeleteImport" endpoint.
+func NewDeleteImportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteImportRequest(mux, decoder)
+		encodeResponse = EncodeDeleteImportResponse(encoder)
+		encodeError    = goahttp.ErrorEncoder(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteImport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "infohub")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
@@ -8,15 +8,847 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	infohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	goa "goa.design/goa/v3/pkg"
 )
 
+// CreateExportRequestBody is the type of the "infohub" service "CreateExport"
+// endpoint HTTP request body.
+type CreateExportRequestBody struct {
+	// Unique name of the export.
+	ExportName *string `form:"exportName,omitempty" json:"exportName,omitempty" xml:"exportName,omitempty"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies,omitempty" json:"policies,omitempty" xml:"policies,omitempty"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace *string `form:"keyNamespace,omitempty" json:"keyNamespace,omitempty" xml:"keyNamespace,omitempty"`
+	// Name of the key used for signing the export.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout *string `form:"layout,omitempty" json:"layout,omitempty" xml:"layout,omitempty"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaRequestBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate *bool `form:"staleWhileRevalidate,omitempty" json:"staleWhileRevalidate,omitempty" xml:"staleWhileRevalidate,omitempty"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterRequestBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationRequestBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// UpdateExportRequestBody is the type of the "infohub" service "UpdateExport"
+// endpoint HTTP request body.
+type UpdateExportRequestBody struct {
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies,omitempty" json:"policies,omitempty" xml:"policies,omitempty"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace *string `form:"keyNamespace,omitempty" json:"keyNamespace,omitempty" xml:"keyNamespace,omitempty"`
+	// Name of the key used for signing the export.
+	Key *string `form:"key,omitempty" json:"key,omitempty" xml:"key,omitempty"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout *string `form:"layout,omitempty" json:"layout,omitempty" xml:"layout,omitempty"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaRequestBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate *bool `form:"staleWhileRevalidate,omitempty" json:"staleWhileRevalidate,omitempty" xml:"staleWhileRevalidate,omitempty"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterRequestBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationRequestBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// GetExportJobResponseBody is the type of the "infohub" service "GetExportJob"
+// endpoint HTTP response body.
+type GetExportJobResponseBody struct {
+	// Unique identifier of the export job.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Name of the export.
+	ExportName string `form:"exportName" json:"exportName" xml:"exportName"`
+	// Status of the export job.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Progress of the policy evaluations performed by the job.
+	Policies []*ExportJobPolicyResponseBody `form:"policies" json:"policies" xml:"policies"`
+	// Time when the job was created.
+	CreatedAt string `form:"createdAt" json:"createdAt" xml:"createdAt"`
+	// Time when the job was last updated.
+	UpdatedAt string `form:"updatedAt" json:"updatedAt" xml:"updatedAt"`
+	// Parameter values of the export request.
+	Parameters map[string]any `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+}
+
+// ListExportsResponseBody is the type of the "infohub" service "ListExports"
+// endpoint HTTP response body.
+type ListExportsResponseBody []*ExportConfigurationResponse
+
+// CreateExportResponseBody is the type of the "infohub" service "CreateExport"
+// endpoint HTTP response body.
+type CreateExportResponseBody struct {
+	// Unique name of the export.
+	ExportName string `form:"exportName" json:"exportName" xml:"exportName"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies" json:"policies" xml:"policies"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer string `form:"issuer" json:"issuer" xml:"issuer"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace string `form:"keyNamespace" json:"keyNamespace" xml:"keyNamespace"`
+	// Name of the key used for signing the export.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout string `form:"layout" json:"layout" xml:"layout"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponseBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate bool `form:"staleWhileRevalidate" json:"staleWhileRevalidate" xml:"staleWhileRevalidate"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponseBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponseBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format string `form:"format" json:"format" xml:"format"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// GetExportResponseBody is the type of the "infohub" service "GetExport"
+// endpoint HTTP response body.
+type GetExportResponseBody struct {
+	// Unique name of the export.
+	ExportName string `form:"exportName" json:"exportName" xml:"exportName"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies" json:"policies" xml:"policies"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer string `form:"issuer" json:"issuer" xml:"issuer"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace string `form:"keyNamespace" json:"keyNamespace" xml:"keyNamespace"`
+	// Name of the key used for signing the export.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout string `form:"layout" json:"layout" xml:"layout"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponseBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate bool `form:"staleWhileRevalidate" json:"staleWhileRevalidate" xml:"staleWhileRevalidate"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponseBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponseBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format string `form:"format" json:"format" xml:"format"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// UpdateExportResponseBody is the type of the "infohub" service "UpdateExport"
+// endpoint HTTP response body.
+type UpdateExportResponseBody struct {
+	// Unique name of the export.
+	ExportName string `form:"exportName" json:"exportName" xml:"exportName"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies" json:"policies" xml:"policies"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer string `form:"issuer" json:"issuer" xml:"issuer"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace string `form:"keyNamespace" json:"keyNamespace" xml:"keyNamespace"`
+	// Name of the key used for signing the export.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout string `form:"layout" json:"layout" xml:"layout"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponseBody `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate bool `form:"staleWhileRevalidate" json:"staleWhileRevalidate" xml:"staleWhileRevalidate"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponseBody `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponseBody `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format string `form:"format" json:"format" xml:"format"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
 // ImportResponseBody is the type of the "infohub" service "Import" endpoint
 // HTTP response body.
 type ImportResponseBody struct {
 	// importIds is an array of unique identifiers used as Cache keys to retrieve
 	// the imported data entries later.
 	ImportIds []string `form:"importIds" json:"importIds" xml:"importIds"`
+	// Result of the import of each credential of the presentation.
+	Credentials []*ImportedCredentialResponseBody `form:"credentials" json:"credentials" xml:"credentials"`
+}
+
+// ImportChallengeResponseBody is the type of the "infohub" service
+// "ImportChallenge" endpoint HTTP response body.
+type ImportChallengeResponseBody struct {
+	// Challenge which must be given in the proof of the imported presentation.
+	Challenge string `form:"challenge" json:"challenge" xml:"challenge"`
+	// Domain which must be given in the proof of the imported presentation.
+	Domain *string `form:"domain,omitempty" json:"domain,omitempty" xml:"domain,omitempty"`
+	// Time when the challenge expires.
+	ExpiresAt string `form:"expiresAt" json:"expiresAt" xml:"expiresAt"`
+}
+
+// ListImportsResponseBody is the type of the "infohub" service "ListImports"
+// endpoint HTTP response body.
+type ListImportsResponseBody []*ImportRecordResponse
+
+// GetImportResponseBody is the type of the "infohub" service "GetImport"
+// endpoint HTTP response body.
+type GetImportResponseBody struct {
+	// Identifier of the import record.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Cache key of the imported credential subject.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache namespace of the imported credential subject.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache scope of the imported credential subject.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Identifier of the imported credential.
+	CredentialID *string `form:"credentialId,omitempty" json:"credentialId,omitempty" xml:"credentialId,omitempty"`
+	// Issuer of the imported credential.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Holder of the imported presentation.
+	Holder *string `form:"holder,omitempty" json:"holder,omitempty" xml:"holder,omitempty"`
+	// Identifier of the credential subject.
+	SubjectID *string `form:"subjectId,omitempty" json:"subjectId,omitempty" xml:"subjectId,omitempty"`
+	// SHA-256 hash of the canonical form of the credential.
+	Hash string `form:"hash" json:"hash" xml:"hash"`
+	// Client which imported the credential.
+	Importer *string `form:"importer,omitempty" json:"importer,omitempty" xml:"importer,omitempty"`
+	// Time of the import.
+	ImportedAt string `form:"importedAt" json:"importedAt" xml:"importedAt"`
+}
+
+// ExportJobPolicyResponseBody is used to define fields on response body types.
+type ExportJobPolicyResponseBody struct {
+	// Name of the policy formatted as 'group/policy/version'.
+	Policy string `form:"policy" json:"policy" xml:"policy"`
+	// Status of the policy evaluation.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Error message if the policy evaluation failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+}
+
+// ExportConfigurationResponse is used to define fields on response body types.
+type ExportConfigurationResponse struct {
+	// Unique name of the export.
+	ExportName string `form:"exportName" json:"exportName" xml:"exportName"`
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string `form:"contexts,omitempty" json:"contexts,omitempty" xml:"contexts,omitempty"`
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any `form:"policies" json:"policies" xml:"policies"`
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int `form:"cacheTTL,omitempty" json:"cacheTTL,omitempty" xml:"cacheTTL,omitempty"`
+	// DID of the issuer of the exported credentials.
+	Issuer string `form:"issuer" json:"issuer" xml:"issuer"`
+	// Namespace of the key used for signing the export.
+	KeyNamespace string `form:"keyNamespace" json:"keyNamespace" xml:"keyNamespace"`
+	// Name of the key used for signing the export.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout string `form:"layout" json:"layout" xml:"layout"`
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string `form:"credentialTypes,omitempty" json:"credentialTypes,omitempty" xml:"credentialTypes,omitempty"`
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchemaResponse `form:"credentialSchema,omitempty" json:"credentialSchema,omitempty" xml:"credentialSchema,omitempty"`
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate bool `form:"staleWhileRevalidate" json:"staleWhileRevalidate" xml:"staleWhileRevalidate"`
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string `form:"schedule,omitempty" json:"schedule,omitempty" xml:"schedule,omitempty"`
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameterResponse `form:"parameters,omitempty" json:"parameters,omitempty" xml:"parameters,omitempty"`
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorizationResponse `form:"authorization,omitempty" json:"authorization,omitempty" xml:"authorization,omitempty"`
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string `form:"resultSchema,omitempty" json:"resultSchema,omitempty" xml:"resultSchema,omitempty"`
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format string `form:"format" json:"format" xml:"format"`
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string `form:"selectiveDisclosure,omitempty" json:"selectiveDisclosure,omitempty" xml:"selectiveDisclosure,omitempty"`
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string `form:"policyOrder,omitempty" json:"policyOrder,omitempty" xml:"policyOrder,omitempty"`
+}
+
+// CredentialSchemaResponse is used to define fields on response body types.
+type CredentialSchemaResponse struct {
+	// URL of the schema.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Type of the schema.
+	Type string `form:"type" json:"type" xml:"type"`
+}
+
+// ExportParameterResponse is used to define fields on response body types.
+type ExportParameterResponse struct {
+	// Name of the parameter.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of the parameter value.
+	Type string `form:"type" json:"type" xml:"type"`
+	// Whether the parameter must be given when requesting the export.
+	Required bool `form:"required" json:"required" xml:"required"`
+	// Regular expression which string values must match.
+	Pattern *string `form:"pattern,omitempty" json:"pattern,omitempty" xml:"pattern,omitempty"`
+	// Value of the parameter when it's not given.
+	Default any `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the parameter.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ExportAuthorizationResponse is used to define fields on response body types.
+type ExportAuthorizationResponse struct {
+	// Scopes which the token must grant.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Claims which the token must have with the given values.
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+	// Policy evaluated with the token claims as input. It must return allow set to
+	// true.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+}
+
+// CredentialSchemaResponseBody is used to define fields on response body types.
+type CredentialSchemaResponseBody struct {
+	// URL of the schema.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Type of the schema.
+	Type string `form:"type" json:"type" xml:"type"`
+}
+
+// ExportParameterResponseBody is used to define fields on response body types.
+type ExportParameterResponseBody struct {
+	// Name of the parameter.
+	Name string `form:"name" json:"name" xml:"name"`
+	// Type of the parameter value.
+	Type string `form:"type" json:"type" xml:"type"`
+	// Whether the parameter must be given when requesting the export.
+	Required bool `form:"required" json:"required" xml:"required"`
+	// Regular expression which string values must match.
+	Pattern *string `form:"pattern,omitempty" json:"pattern,omitempty" xml:"pattern,omitempty"`
+	// Value of the parameter when it's not given.
+	Default any `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the parameter.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ExportAuthorizationResponseBody is used to define fields on response body
+// types.
+type ExportAuthorizationResponseBody struct {
+	// Scopes which the token must grant.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Claims which the token must have with the given values.
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+	// Policy evaluated with the token claims as input. It must return allow set to
+	// true.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+}
+
+// ImportedCredentialResponseBody is used to define fields on response body
+// types.
+type ImportedCredentialResponseBody struct {
+	// Position of the credential in the presentation.
+	Index int `form:"index" json:"index" xml:"index"`
+	// Identifier of the credential, if it has one.
+	CredentialID *string `form:"credentialId,omitempty" json:"credentialId,omitempty" xml:"credentialId,omitempty"`
+	// Cache key of the credential subject. For duplicate credentials it is the key
+	// of the previous import.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Status of the import of the credential.
+	Status string `form:"status" json:"status" xml:"status"`
+	// Error message if the import of the credential failed.
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Identifier of the import record of the credential, which is used to look up
+	// or delete the imported data.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+}
+
+// ImportRecordResponse is used to define fields on response body types.
+type ImportRecordResponse struct {
+	// Identifier of the import record.
+	ID string `form:"id" json:"id" xml:"id"`
+	// Cache key of the imported credential subject.
+	Key string `form:"key" json:"key" xml:"key"`
+	// Cache namespace of the imported credential subject.
+	Namespace *string `form:"namespace,omitempty" json:"namespace,omitempty" xml:"namespace,omitempty"`
+	// Cache scope of the imported credential subject.
+	Scope *string `form:"scope,omitempty" json:"scope,omitempty" xml:"scope,omitempty"`
+	// Identifier of the imported credential.
+	CredentialID *string `form:"credentialId,omitempty" json:"credentialId,omitempty" xml:"credentialId,omitempty"`
+	// Issuer of the imported credential.
+	Issuer *string `form:"issuer,omitempty" json:"issuer,omitempty" xml:"issuer,omitempty"`
+	// Holder of the imported presentation.
+	Holder *string `form:"holder,omitempty" json:"holder,omitempty" xml:"holder,omitempty"`
+	// Identifier of the credential subject.
+	SubjectID *string `form:"subjectId,omitempty" json:"subjectId,omitempty" xml:"subjectId,omitempty"`
+	// SHA-256 hash of the canonical form of the credential.
+	Hash string `form:"hash" json:"hash" xml:"hash"`
+	// Client which imported the credential.
+	Importer *string `form:"importer,omitempty" json:"importer,omitempty" xml:"importer,omitempty"`
+	// Time of the import.
+	ImportedAt string `form:"importedAt" json:"importedAt" xml:"importedAt"`
+}
+
+// CredentialSchemaRequestBody is used to define fields on request body types.
+type CredentialSchemaRequestBody struct {
+	// URL of the schema.
+	ID *string `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Type of the schema.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+}
+
+// ExportParameterRequestBody is used to define fields on request body types.
+type ExportParameterRequestBody struct {
+	// Name of the parameter.
+	Name *string `form:"name,omitempty" json:"name,omitempty" xml:"name,omitempty"`
+	// Type of the parameter value.
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Whether the parameter must be given when requesting the export.
+	Required *bool `form:"required,omitempty" json:"required,omitempty" xml:"required,omitempty"`
+	// Regular expression which string values must match.
+	Pattern *string `form:"pattern,omitempty" json:"pattern,omitempty" xml:"pattern,omitempty"`
+	// Value of the parameter when it's not given.
+	Default any `form:"default,omitempty" json:"default,omitempty" xml:"default,omitempty"`
+	// Description of the parameter.
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+}
+
+// ExportAuthorizationRequestBody is used to define fields on request body
+// types.
+type ExportAuthorizationRequestBody struct {
+	// Scopes which the token must grant.
+	Scopes []string `form:"scopes,omitempty" json:"scopes,omitempty" xml:"scopes,omitempty"`
+	// Claims which the token must have with the given values.
+	Claims map[string]string `form:"claims,omitempty" json:"claims,omitempty" xml:"claims,omitempty"`
+	// Policy evaluated with the token claims as input. It must return allow set to
+	// true.
+	Policy *string `form:"policy,omitempty" json:"policy,omitempty" xml:"policy,omitempty"`
+}
+
+// NewGetExportJobResponseBody builds the HTTP response body from the result of
+// the "GetExportJob" endpoint of the "infohub" service.
+func NewGetExportJobResponseBody(res *infohub.ExportJob) *GetExportJobResponseBody {
+	body := &GetExportJobResponseBody{
+		ID:         res.ID,
+		ExportName: res.ExportName,
+		Status:     res.Status,
+		CreatedAt:  res.CreatedAt,
+		UpdatedAt:  res.UpdatedAt,
+	}
+	if res.Policies != nil {
+		body.Policies = make([]*ExportJobPolicyResponseBody, len(res.Policies))
+		for i, val := range res.Policies {
+			body.Policies[i] = marshalInfohubExportJobPolicyToExportJobPolicyResponseBody(val)
+		}
+	} else {
+		body.Policies = []*ExportJobPolicyResponseBody{}
+	}
+	if res.Parameters != nil {
+		body.Parameters = make(map[string]any, len(res.Parameters))
+		for key, val := range res.Parameters {
+			tk := key
+			tv := val
+			body.Parameters[tk] = tv
+		}
+	}
+	return body
+}
+
+// NewListExportsResponseBody builds the HTTP response body from the result of
+// the "ListExports" endpoint of the "infohub" service.
+func NewListExportsResponseBody(res []*infohub.ExportConfiguration) ListExportsResponseBody {
+	body := make([]*ExportConfigurationResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalInfohubExportConfigurationToExportConfigurationResponse(val)
+	}
+	return body
+}
+
+// NewCreateExportResponseBody builds the HTTP response body from the result of
+// the "CreateExport" endpoint of the "infohub" service.
+func NewCreateExportResponseBody(res *infohub.ExportConfiguration) *CreateExportResponseBody {
+	body := &CreateExportResponseBody{
+		ExportName:           res.ExportName,
+		CacheTTL:             res.CacheTTL,
+		Issuer:               res.Issuer,
+		KeyNamespace:         res.KeyNamespace,
+		Key:                  res.Key,
+		Layout:               res.Layout,
+		StaleWhileRevalidate: res.StaleWhileRevalidate,
+		Schedule:             res.Schedule,
+		ResultSchema:         res.ResultSchema,
+		Format:               res.Format,
+	}
+	if res.Contexts != nil {
+		body.Contexts = make([]string, len(res.Contexts))
+		for i, val := range res.Contexts {
+			body.Contexts[i] = val
+		}
+	}
+	if res.Policies != nil {
+		body.Policies = make(map[string]any, len(res.Policies))
+		for key, val := range res.Policies {
+			tk := key
+			tv := val
+			body.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if body.Layout == zero {
+			body.Layout = "credentialPerPolicy"
+		}
+	}
+	if res.CredentialTypes != nil {
+		body.CredentialTypes = make([]string, len(res.CredentialTypes))
+		for i, val := range res.CredentialTypes {
+			body.CredentialTypes[i] = val
+		}
+	}
+	if res.CredentialSchema != nil {
+		body.CredentialSchema = marshalInfohubCredentialSchemaToCredentialSchemaResponseBody(res.CredentialSchema)
+	}
+	{
+		var zero bool
+		if body.StaleWhileRevalidate == zero {
+			body.StaleWhileRevalidate = false
+		}
+	}
+	if res.Parameters != nil {
+		body.Parameters = make([]*ExportParameterResponseBody, len(res.Parameters))
+		for i, val := range res.Parameters {
+			body.Parameters[i] = marshalInfohubExportParameterToExportParameterResponseBody(val)
+		}
+	}
+	if res.Authorization != nil {
+		body.Authorization = marshalInfohubExportAuthorizationToExportAuthorizationResponseBody(res.Authorization)
+	}
+	{
+		var zero string
+		if body.Format == zero {
+			body.Format = "jsonld"
+		}
+	}
+	if res.SelectiveDisclosure != nil {
+		body.SelectiveDisclosure = make([]string, len(res.SelectiveDisclosure))
+		for i, val := range res.SelectiveDisclosure {
+			body.SelectiveDisclosure[i] = val
+		}
+	}
+	if res.PolicyOrder != nil {
+		body.PolicyOrder = make([]string, len(res.PolicyOrder))
+		for i, val := range res.PolicyOrder {
+			body.PolicyOrder[i] = val
+		}
+	}
+	return body
+}
+
+// NewGetExportResponseBody builds the HTTP response body from the result of
+// the "GetExport" endpoint of the "infohub" service.
+func NewGetExportResponseBody(res *infohub.ExportConfiguration) *GetExportResponseBody {
+	body := &GetExportResponseBody{
+		ExportName:           res.ExportName,
+		CacheTTL:             res.CacheTTL,
+		Issuer:               res.Issuer,
+		KeyNamespace:         res.KeyNamespace,
+		Key:                  res.Key,
+		Layout:               res.Layout,
+		StaleWhileRevalidate: res.StaleWhileRevalidate,
+		Schedule:             res.Schedule,
+		ResultSchema:         res.ResultSchema,
+		Format:               res.Format,
+	}
+	if res.Contexts != nil {
+		body.Contexts = make([]string, len(res.Contexts))
+		for i, val := range res.Contexts {
+			body.Contexts[i] = val
+		}
+	}
+	if res.Policies != nil {
+		body.Policies = make(map[string]any, len(res.Policies))
+		for key, val := range res.Policies {
+			tk := key
+			tv := val
+			body.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if body.Layout == zero {
+			body.Layout = "credentialPerPolicy"
+		}
+	}
+	if res.CredentialTypes != nil {
+		body.CredentialTypes = make([]string, len(res.CredentialTypes))
+		for i, val := range res.CredentialTypes {
+			body.CredentialTypes[i] = val
+		}
+	}
+	if res.CredentialSchema != nil {
+		body.CredentialSchema = marshalInfohubCredentialSchemaToCredentialSchemaResponseBody(res.CredentialSchema)
+	}
+	{
+		var zero bool
+		if body.StaleWhileRevalidate == zero {
+			body.StaleWhileRevalidate = false
+		}
+	}
+	if res.Parameters != nil {
+		body.Parameters = make([]*ExportParameterResponseBody, len(res.Parameters))
+		for i, val := range res.Parameters {
+			body.Parameters[i] = marshalInfohubExportParameterToExportParameterResponseBody(val)
+		}
+	}
+	if res.Authorization != nil {
+		body.Authorization = marshalInfohubExportAuthorizationToExportAuthorizationResponseBody(res.Authorization)
+	}
+	{
+		var zero string
+		if body.Format == zero {
+			body.Format = "jsonld"
+		}
+	}
+	if res.SelectiveDisclosure != nil {
+		body.SelectiveDisclosure = make([]string, len(res.SelectiveDisclosure))
+		for i, val := range res.SelectiveDisclosure {
+			body.SelectiveDisclosure[i] = val
+		}
+	}
+	if res.PolicyOrder != nil {
+		body.PolicyOrder = make([]string, len(res.PolicyOrder))
+		for i, val := range res.PolicyOrder {
+			body.PolicyOrder[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateExportResponseBody builds the HTTP response body from the result of
+// the "UpdateExport" endpoint of the "infohub" service.
+func NewUpdateExportResponseBody(res *infohub.ExportConfiguration) *UpdateExportResponseBody {
+	body := &UpdateExportResponseBody{
+		ExportName:           res.ExportName,
+		CacheTTL:             res.CacheTTL,
+		Issuer:               res.Issuer,
+		KeyNamespace:         res.KeyNamespace,
+		Key:                  res.Key,
+		Layout:               res.Layout,
+		StaleWhileRevalidate: res.StaleWhileRevalidate,
+		Schedule:             res.Schedule,
+		ResultSchema:         res.ResultSchema,
+		Format:               res.Format,
+	}
+	if res.Contexts != nil {
+		body.Contexts = make([]string, len(res.Contexts))
+		for i, val := range res.Contexts {
+			body.Contexts[i] = val
+		}
+	}
+	if res.Policies != nil {
+		body.Policies = make(map[string]any, len(res.Policies))
+		for key, val := range res.Policies {
+			tk := key
+			tv := val
+			body.Policies[tk] = tv
+		}
+	}
+	{
+		var zero string
+		if body.Layout == zero {
+			body.Layout = "credentialPerPolicy"
+		}
+	}
+	if res.CredentialTypes != nil {
+		body.CredentialTypes = make([]string, len(res.CredentialTypes))
+		for i, val := range res.CredentialTypes {
+			body.CredentialTypes[i] = val
+		}
+	}
+	if res.CredentialSchema != nil {
+		body.CredentialSchema = marshalInfohubCredentialSchemaToCredentialSchemaResponseBody(res.CredentialSchema)
+	}
+	{
+		var zero bool
+		if body.StaleWhileRevalidate == zero {
+			body.StaleWhileRevalidate = false
+		}
+	}
+	if res.Parameters != nil {
+		body.Parameters = make([]*ExportParameterResponseBody, len(res.Parameters))
+		for i, val := range res.Parameters {
+			body.Parameters[i] = marshalInfohubExportParameterToExportParameterResponseBody(val)
+		}
+	}
+	if res.Authorization != nil {
+		body.Authorization = marshalInfohubExportAuthorizationToExportAuthorizationResponseBody(res.Authorization)
+	}
+	{
+		var zero string
+		if body.Format == zero {
+			body.Format = "jsonld"
+		}
+	}
+	if res.SelectiveDisclosure != nil {
+		body.SelectiveDisclosure = make([]string, len(res.SelectiveDisclosure))
+		for i, val := range res.SelectiveDisclosure {
+			body.SelectiveDisclosure[i] = val
+		}
+	}
+	if res.PolicyOrder != nil {
+		body.PolicyOrder = make([]string, len(res.PolicyOrder))
+		for i, val := range res.PolicyOrder {
+			body.PolicyOrder[i] = val
+		}
+	}
+	return body
 }
 
 // NewImportResponseBody builds the HTTP response body from the result of the
@@ -31,23 +863,482 @@ func NewImportResponseBody(res *infohub.ImportResult) *ImportResponseBody {
 	} else {
 		body.ImportIds = []string{}
 	}
+	if res.Credentials != nil {
+		body.Credentials = make([]*ImportedCredentialResponseBody, len(res.Credentials))
+		for i, val := range res.Credentials {
+			body.Credentials[i] = marshalInfohubImportedCredentialToImportedCredentialResponseBody(val)
+		}
+	} else {
+		body.Credentials = []*ImportedCredentialResponseBody{}
+	}
+	return body
+}
+
+// NewImportChallengeResponseBody builds the HTTP response body from the result
+// of the "ImportChallenge" endpoint of the "infohub" service.
+func NewImportChallengeResponseBody(res *infohub.ChallengeResult) *ImportChallengeResponseBody {
+	body := &ImportChallengeResponseBody{
+		Challenge: res.Challenge,
+		Domain:    res.Domain,
+		ExpiresAt: res.ExpiresAt,
+	}
+	return body
+}
+
+// NewListImportsResponseBody builds the HTTP response body from the result of
+// the "ListImports" endpoint of the "infohub" service.
+func NewListImportsResponseBody(res []*infohub.ImportRecord) ListImportsResponseBody {
+	body := make([]*ImportRecordResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalInfohubImportRecordToImportRecordResponse(val)
+	}
+	return body
+}
+
+// NewGetImportResponseBody builds the HTTP response body from the result of
+// the "GetImport" endpoint of the "infohub" service.
+func NewGetImportResponseBody(res *infohub.ImportRecord) *GetImportResponseBody {
+	body := &GetImportResponseBody{
+		ID:           res.ID,
+		Key:          res.Key,
+		Namespace:    res.Namespace,
+		Scope:        res.Scope,
+		CredentialID: res.CredentialID,
+		Issuer:       res.Issuer,
+		Holder:       res.Holder,
+		SubjectID:    res.SubjectID,
+		Hash:         res.Hash,
+		Importer:     res.Importer,
+		ImportedAt:   res.ImportedAt,
+	}
 	return body
 }
 
 // NewExportRequest builds a infohub service Export endpoint payload.
-func NewExportRequest(exportName string) *infohub.ExportRequest {
-	v := &infohub.ExportRequest{}
+func NewExportRequest(body map[string]any, exportName string, query map[string]string, accept *string) *infohub.ExportRequest {
+	v := make(map[string]any, len(body))
+	for key, val := range body {
+		tk := key
+		tv := val
+		v[tk] = tv
+	}
+	res := &infohub.ExportRequest{
+		Parameters: v,
+	}
+	res.ExportName = exportName
+	res.Query = query
+	res.Accept = accept
+
+	return res
+}
+
+// NewGetExportJobExportJobRequest builds a infohub service GetExportJob
+// endpoint payload.
+func NewGetExportJobExportJobRequest(exportName string, id string) *infohub.ExportJobRequest {
+	v := &infohub.ExportJobRequest{}
+	v.ExportName = exportName
+	v.ID = id
+
+	return v
+}
+
+// NewCreateExportExportConfiguration builds a infohub service CreateExport
+// endpoint payload.
+func NewCreateExportExportConfiguration(body *CreateExportRequestBody) *infohub.ExportConfiguration {
+	v := &infohub.ExportConfiguration{
+		ExportName:   *body.ExportName,
+		CacheTTL:     body.CacheTTL,
+		Issuer:       *body.Issuer,
+		KeyNamespace: *body.KeyNamespace,
+		Key:          *body.Key,
+		Schedule:     body.Schedule,
+		ResultSchema: body.ResultSchema,
+	}
+	if body.Layout != nil {
+		v.Layout = *body.Layout
+	}
+	if body.StaleWhileRevalidate != nil {
+		v.StaleWhileRevalidate = *body.StaleWhileRevalidate
+	}
+	if body.Format != nil {
+		v.Format = *body.Format
+	}
+	if body.Contexts != nil {
+		v.Contexts = make([]string, len(body.Contexts))
+		for i, val := range body.Contexts {
+			v.Contexts[i] = val
+		}
+	}
+	v.Policies = make(map[string]any, len(body.Policies))
+	for key, val := range body.Policies {
+		tk := key
+		tv := val
+		v.Policies[tk] = tv
+	}
+	if body.Layout == nil {
+		v.Layout = "credentialPerPolicy"
+	}
+	if body.CredentialTypes != nil {
+		v.CredentialTypes = make([]string, len(body.CredentialTypes))
+		for i, val := range body.CredentialTypes {
+			v.CredentialTypes[i] = val
+		}
+	}
+	if body.CredentialSchema != nil {
+		v.CredentialSchema = unmarshalCredentialSchemaRequestBodyToInfohubCredentialSchema(body.CredentialSchema)
+	}
+	if body.StaleWhileRevalidate == nil {
+		v.StaleWhileRevalidate = false
+	}
+	if body.Parameters != nil {
+		v.Parameters = make([]*infohub.ExportParameter, len(body.Parameters))
+		for i, val := range body.Parameters {
+			v.Parameters[i] = unmarshalExportParameterRequestBodyToInfohubExportParameter(val)
+		}
+	}
+	if body.Authorization != nil {
+		v.Authorization = unmarshalExportAuthorizationRequestBodyToInfohubExportAuthorization(body.Authorization)
+	}
+	if body.Format == nil {
+		v.Format = "jsonld"
+	}
+	if body.SelectiveDisclosure != nil {
+		v.SelectiveDisclosure = make([]string, len(body.SelectiveDisclosure))
+		for i, val := range body.SelectiveDisclosure {
+			v.SelectiveDisclosure[i] = val
+		}
+	}
+	if body.PolicyOrder != nil {
+		v.PolicyOrder = make([]string, len(body.PolicyOrder))
+		for i, val := range body.PolicyOrder {
+			v.PolicyOrder[i] = val
+		}
+	}
+
+	return v
+}
+
+// NewGetExportExportConfigurationRequest builds a infohub service GetExport
+// endpoint payload.
+func NewGetExportExportConfigurationRequest(exportName string) *infohub.ExportConfigurationRequest {
+	v := &infohub.ExportConfigurationRequest{}
+	v.ExportName = exportName
+
+	return v
+}
+
+// NewUpdateExportExportConfiguration builds a infohub service UpdateExport
+// endpoint payload.
+func NewUpdateExportExportConfiguration(body *UpdateExportRequestBody, exportName string) *infohub.ExportConfiguration {
+	v := &infohub.ExportConfiguration{
+		CacheTTL:     body.CacheTTL,
+		Issuer:       *body.Issuer,
+		KeyNamespace: *body.KeyNamespace,
+		Key:          *body.Key,
+		Schedule:     body.Schedule,
+		ResultSchema: body.ResultSchema,
+	}
+	if body.Layout != nil {
+		v.Layout = *body.Layout
+	}
+	if body.StaleWhileRevalidate != nil {
+		v.StaleWhileRevalidate = *body.StaleWhileRevalidate
+	}
+	if body.Format != nil {
+		v.Format = *body.Format
+	}
+	if body.Contexts != nil {
+		v.Contexts = make([]string, len(body.Contexts))
+		for i, val := range body.Contexts {
+			v.Contexts[i] = val
+		}
+	}
+	v.Policies = make(map[string]any, len(body.Policies))
+	for key, val := range body.Policies {
+		tk := key
+		tv := val
+		v.Policies[tk] = tv
+	}
+	if body.Layout == nil {
+		v.Layout = "credentialPerPolicy"
+	}
+	if body.CredentialTypes != nil {
+		v.CredentialTypes = make([]string, len(body.CredentialTypes))
+		for i, val := range body.CredentialTypes {
+			v.CredentialTypes[i] = val
+		}
+	}
+	if body.CredentialSchema != nil {
+		v.CredentialSchema = unmarshalCredentialSchemaRequestBodyToInfohubCredentialSchema(body.CredentialSchema)
+	}
+	if body.StaleWhileRevalidate == nil {
+		v.StaleWhileRevalidate = false
+	}
+	if body.Parameters != nil {
+		v.Parameters = make([]*infohub.ExportParameter, len(body.Parameters))
+		for i, val := range body.Parameters {
+			v.Parameters[i] = unmarshalExportParameterRequestBodyToInfohubExportParameter(val)
+		}
+	}
+	if body.Authorization != nil {
+		v.Authorization = unmarshalExportAuthorizationRequestBodyToInfohubExportAuthorization(body.Authorization)
+	}
+	if body.Format == nil {
+		v.Format = "jsonld"
+	}
+	if body.SelectiveDisclosure != nil {
+		v.SelectiveDisclosure = make([]string, len(body.SelectiveDisclosure))
+		for i, val := range body.SelectiveDisclosure {
+			v.SelectiveDisclosure[i] = val
+		}
+	}
+	if body.PolicyOrder != nil {
+		v.PolicyOrder = make([]string, len(body.PolicyOrder))
+		for i, val := range body.PolicyOrder {
+			v.PolicyOrder[i] = val
+		}
+	}
+	v.ExportName = exportName
+
+	return v
+}
+
+// NewDeleteExportExportConfigurationRequest builds a infohub service
+// DeleteExport endpoint payload.
+func NewDeleteExportExportConfigurationRequest(exportName string) *infohub.ExportConfigurationRequest {
+	v := &infohub.ExportConfigurationRequest{}
 	v.ExportName = exportName
 
 	return v
 }
 
 // NewImportRequest builds a infohub service Import endpoint payload.
-func NewImportRequest(body []byte) *infohub.ImportRequest {
+func NewImportRequest(body []byte, namespace *string, scope *string, key *string, keyFrom string, atomic bool, idempotencyKey *string) *infohub.ImportRequest {
 	v := body
 	res := &infohub.ImportRequest{
 		Data: v,
 	}
+	res.Namespace = namespace
+	res.Scope = scope
+	res.Key = key
+	res.KeyFrom = keyFrom
+	res.Atomic = atomic
+	res.IdempotencyKey = idempotencyKey
 
 	return res
 }
+
+// NewListImportsImportRecordsRequest builds a infohub service ListImports
+// endpoint payload.
+func NewListImportsImportRecordsRequest(issuer *string, holder *string, subjectID *string, limit int) *infohub.ImportRecordsRequest {
+	v := &infohub.ImportRecordsRequest{}
+	v.Issuer = issuer
+	v.Holder = holder
+	v.SubjectID = subjectID
+	v.Limit = limit
+
+	return v
+}
+
+// NewGetImportImportRecordRequest builds a infohub service GetImport endpoint
+// payload.
+func NewGetImportImportRecordRequest(id string) *infohub.ImportRecordRequest {
+	v := &infohub.ImportRecordRequest{}
+	v.ID = id
+
+	return v
+}
+
+// NewDeleteImportImportRecordRequest builds a infohub service DeleteImport
+// endpoint payload.
+func NewDeleteImportImportRecordRequest(id string) *infohub.ImportRecordRequest {
+	v := &infohub.ImportRecordRequest{}
+	v.ID = id
+
+	return v
+}
+
+// ValidateCreateExportRequestBody runs the validations defined on
+// CreateExportRequestBody
+func ValidateCreateExportRequestBody(body *CreateExportRequestBody) (err error) {
+	if body.ExportName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exportName", "body"))
+	}
+	if body.Policies == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+	}
+	if body.Issuer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("issuer", "body"))
+	}
+	if body.KeyNamespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keyNamespace", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if body.ExportName != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.exportName", *body.ExportName, "^[A-Za-z0-9._-]+$"))
+	}
+	if len(body.Policies) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+	}
+	for k, _ := range body.Policies {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	if body.CacheTTL != nil {
+		if *body.CacheTTL < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+		}
+	}
+	if body.Issuer != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", *body.Issuer, "^did:[a-z0-9]+:.+$"))
+	}
+	if body.KeyNamespace != nil {
+		if utf8.RuneCountInString(*body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", *body.KeyNamespace, utf8.RuneCountInString(*body.KeyNamespace), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Layout != nil {
+		if !(*body.Layout == "credentialPerPolicy" || *body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", *body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+	}
+	if body.CredentialSchema != nil {
+		if err2 := ValidateCredentialSchemaRequestBody(body.CredentialSchema); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Parameters {
+		if e != nil {
+			if err2 := ValidateExportParameterRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Authorization != nil {
+		if err2 := ValidateExportAuthorizationRequestBody(body.Authorization); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "jsonld" || *body.Format == "jwt" || *body.Format == "sd-jwt" || *body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateExportRequestBody runs the validations defined on
+// UpdateExportRequestBody
+func ValidateUpdateExportRequestBody(body *UpdateExportRequestBody) (err error) {
+	if body.Policies == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("policies", "body"))
+	}
+	if body.Issuer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("issuer", "body"))
+	}
+	if body.KeyNamespace == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("keyNamespace", "body"))
+	}
+	if body.Key == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("key", "body"))
+	}
+	if len(body.Policies) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.policies", body.Policies, len(body.Policies), 1, true))
+	}
+	for k, _ := range body.Policies {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policies.key", k, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	if body.CacheTTL != nil {
+		if *body.CacheTTL < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cacheTTL", *body.CacheTTL, 1, true))
+		}
+	}
+	if body.Issuer != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.issuer", *body.Issuer, "^did:[a-z0-9]+:.+$"))
+	}
+	if body.KeyNamespace != nil {
+		if utf8.RuneCountInString(*body.KeyNamespace) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.keyNamespace", *body.KeyNamespace, utf8.RuneCountInString(*body.KeyNamespace), 1, true))
+		}
+	}
+	if body.Key != nil {
+		if utf8.RuneCountInString(*body.Key) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.key", *body.Key, utf8.RuneCountInString(*body.Key), 1, true))
+		}
+	}
+	if body.Layout != nil {
+		if !(*body.Layout == "credentialPerPolicy" || *body.Layout == "merged") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.layout", *body.Layout, []any{"credentialPerPolicy", "merged"}))
+		}
+	}
+	if body.CredentialSchema != nil {
+		if err2 := ValidateCredentialSchemaRequestBody(body.CredentialSchema); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Parameters {
+		if e != nil {
+			if err2 := ValidateExportParameterRequestBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Authorization != nil {
+		if err2 := ValidateExportAuthorizationRequestBody(body.Authorization); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Format != nil {
+		if !(*body.Format == "jsonld" || *body.Format == "jwt" || *body.Format == "sd-jwt" || *body.Format == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"jsonld", "jwt", "sd-jwt", "json"}))
+		}
+	}
+	return
+}
+
+// ValidateCredentialSchemaRequestBody runs the validations defined on
+// CredentialSchemaRequestBody
+func ValidateCredentialSchemaRequestBody(body *CredentialSchemaRequestBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.ID != nil {
+		err = goa.MergeErrors(err, goa.ValidateFormat("body.id", *body.ID, goa.FormatURI))
+	}
+	return
+}
+
+// ValidateExportParameterRequestBody runs the validations defined on
+// ExportParameterRequestBody
+func ValidateExportParameterRequestBody(body *ExportParameterRequestBody) (err error) {
+	if body.Name == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("name", "body"))
+	}
+	if body.Name != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.name", *body.Name, "^[A-Za-z_][A-Za-z0-9_]*$"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "string" || *body.Type == "number" || *body.Type == "integer" || *body.Type == "boolean") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"string", "number", "integer", "boolean"}))
+		}
+	}
+	return
+}
+
+// ValidateExportAuthorizationRequestBody runs the validations defined on
+// ExportAuthorizationRequestBody
+func ValidateExportAuthorizationRequestBody(body *ExportAuthorizationRequestBody) (err error) {
+	if body.Policy != nil {
+		err = goa.MergeErrors(err, goa.ValidatePattern("body.policy", *body.Policy, "^[^/\\s]+/[^/\\s]+/[^/\\s]+$"))
+	}
+	return
+}
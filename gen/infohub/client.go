@@ -15,26 +15,102 @@ import (
 
 // Client is the "infohub" service client.
 type Client struct {
-	ExportEndpoint goa.Endpoint
-	ImportEndpoint goa.Endpoint
+	ExportEndpoint          goa.Endpoint
+	GetExportJobEndpoint    goa.Endpoint
+	ListExportsEndpoint     goa.Endpoint
+	CreateExportEndpoint    goa.Endpoint
+	GetExportEndpoint       goa.Endpoint
+	UpdateExportEndpoint    goa.Endpoint
+	DeleteExportEndpoint    goa.Endpoint
+	ImportEndpoint          goa.Endpoint
+	ImportChallengeEndpoint goa.Endpoint
+	ListImportsEndpoint     goa.Endpoint
+	GetImportEndpoint       goa.Endpoint
+	DeleteImportEndpoint    goa.Endpoint
 }
 
 // NewClient initializes a "infohub" service client given the endpoints.
-func NewClient(export, import_ goa.Endpoint) *Client {
+func NewClient(export, getExportJob, listExports, createExport, getExport, updateExport, deleteExport, import_, importChallenge, listImports, getImport, deleteImport goa.Endpoint) *Client {
 	return &Client{
-		ExportEndpoint: export,
-		ImportEndpoint: import_,
+		ExportEndpoint:          export,
+		GetExportJobEndpoint:    getExportJob,
+		ListExportsEndpoint:     listExports,
+		CreateExportEndpoint:    createExport,
+		GetExportEndpoint:       getExport,
+		UpdateExportEndpoint:    updateExport,
+		DeleteExportEndpoint:    deleteExport,
+		ImportEndpoint:          import_,
+		ImportChallengeEndpoint: importChallenge,
+		ListImportsEndpoint:     listImports,
+		GetImportEndpoint:       getImport,
+		DeleteImportEndpoint:    deleteImport,
 	}
 }
 
 // Export calls the "Export" endpoint of the "infohub" service.
-func (c *Client) Export(ctx context.Context, p *ExportRequest) (res any, err error) {
+func (c *Client) Export(ctx context.Context, p *ExportRequest) (res *ExportResult, err error) {
 	var ires any
 	ires, err = c.ExportEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.(any), nil
+	return ires.(*ExportResult), nil
+}
+
+// GetExportJob calls the "GetExportJob" endpoint of the "infohub" service.
+func (c *Client) GetExportJob(ctx context.Context, p *ExportJobRequest) (res *ExportJob, err error) {
+	var ires any
+	ires, err = c.GetExportJobEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExportJob), nil
+}
+
+// ListExports calls the "ListExports" endpoint of the "infohub" service.
+func (c *Client) ListExports(ctx context.Context) (res []*ExportConfiguration, err error) {
+	var ires any
+	ires, err = c.ListExportsEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.([]*ExportConfiguration), nil
+}
+
+// CreateExport calls the "CreateExport" endpoint of the "infohub" service.
+func (c *Client) CreateExport(ctx context.Context, p *ExportConfiguration) (res *ExportConfiguration, err error) {
+	var ires any
+	ires, err = c.CreateExportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExportConfiguration), nil
+}
+
+// GetExport calls the "GetExport" endpoint of the "infohub" service.
+func (c *Client) GetExport(ctx context.Context, p *ExportConfigurationRequest) (res *ExportConfiguration, err error) {
+	var ires any
+	ires, err = c.GetExportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExportConfiguration), nil
+}
+
+// UpdateExport calls the "UpdateExport" endpoint of the "infohub" service.
+func (c *Client) UpdateExport(ctx context.Context, p *ExportConfiguration) (res *ExportConfiguration, err error) {
+	var ires any
+	ires, err = c.UpdateExportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExportConfiguration), nil
+}
+
+// DeleteExport calls the "DeleteExport" endpoint of the "infohub" service.
+func (c *Client) DeleteExport(ctx context.Context, p *ExportConfigurationRequest) (err error) {
+	_, err = c.DeleteExportEndpoint(ctx, p)
+	return
 }
 
 // Import calls the "Import" endpoint of the "infohub" service.
@@ -46,3 +122,40 @@ func (c *Client) Import(ctx context.Context, p *ImportRequest) (res *ImportResul
 	}
 	return ires.(*ImportResult), nil
 }
+
+// ImportChallenge calls the "ImportChallenge" endpoint of the "infohub"
+// service.
+func (c *Client) ImportChallenge(ctx context.Context) (res *ChallengeResult, err error) {
+	var ires any
+	ires, err = c.ImportChallengeEndpoint(ctx, nil)
+	if err != nil {
+		return
+	}
+	return ires.(*ChallengeResult), nil
+}
+
+// ListImports calls the "ListImports" endpoint of the "infohub" service.
+func (c *Client) ListImports(ctx context.Context, p *ImportRecordsRequest) (res []*ImportRecord, err error) {
+	var ires any
+	ires, err = c.ListImportsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*ImportRecord), nil
+}
+
+// GetImport calls the "GetImport" endpoint of the "infohub" service.
+func (c *Client) GetImport(ctx context.Context, p *ImportRecordRequest) (res *ImportRecord, err error) {
+	var ires any
+	ires, err = c.GetImportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ImportRecord), nil
+}
+
+// DeleteImport calls the "DeleteImport" endpoint of the "infohub" service.
+func (c *Client) DeleteImport(ctx context.Context, p *ImportRecordRequest) (err error) {
+	_, err = c.DeleteImportEndpoint(ctx, p)
+	return
+}
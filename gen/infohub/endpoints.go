@@ -15,22 +15,52 @@ import (
 
 // Endpoints wraps the "infohub" service endpoints.
 type Endpoints struct {
-	Export goa.Endpoint
-	Import goa.Endpoint
+	Export          goa.Endpoint
+	GetExportJob    goa.Endpoint
+	ListExports     goa.Endpoint
+	CreateExport    goa.Endpoint
+	GetExport       goa.Endpoint
+	UpdateExport    goa.Endpoint
+	DeleteExport    goa.Endpoint
+	Import          goa.Endpoint
+	ImportChallenge goa.Endpoint
+	ListImports     goa.Endpoint
+	GetImport       goa.Endpoint
+	DeleteImport    goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "infohub" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		Export: NewExportEndpoint(s),
-		Import: NewImportEndpoint(s),
+		Export:          NewExportEndpoint(s),
+		GetExportJob:    NewGetExportJobEndpoint(s),
+		ListExports:     NewListExportsEndpoint(s),
+		CreateExport:    NewCreateExportEndpoint(s),
+		GetExport:       NewGetExportEndpoint(s),
+		UpdateExport:    NewUpdateExportEndpoint(s),
+		DeleteExport:    NewDeleteExportEndpoint(s),
+		Import:          NewImportEndpoint(s),
+		ImportChallenge: NewImportChallengeEndpoint(s),
+		ListImports:     NewListImportsEndpoint(s),
+		GetImport:       NewGetImportEndpoint(s),
+		DeleteImport:    NewDeleteImportEndpoint(s),
 	}
 }
 
 // Use applies the given middleware to all the "infohub" service endpoints.
 func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.Export = m(e.Export)
+	e.GetExportJob = m(e.GetExportJob)
+	e.ListExports = m(e.ListExports)
+	e.CreateExport = m(e.CreateExport)
+	e.GetExport = m(e.GetExport)
+	e.UpdateExport = m(e.UpdateExport)
+	e.DeleteExport = m(e.DeleteExport)
 	e.Import = m(e.Import)
+	e.ImportChallenge = m(e.ImportChallenge)
+	e.ListImports = m(e.ListImports)
+	e.GetImport = m(e.GetImport)
+	e.DeleteImport = m(e.DeleteImport)
 }
 
 // NewExportEndpoint returns an endpoint function that calls the method
@@ -42,6 +72,59 @@ func NewExportEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetExportJobEndpoint returns an endpoint function that calls the method
+// "GetExportJob" of service "infohub".
+func NewGetExportJobEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportJobRequest)
+		return s.GetExportJob(ctx, p)
+	}
+}
+
+// NewListExportsEndpoint returns an endpoint function that calls the method
+// "ListExports" of service "infohub".
+func NewListExportsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ListExports(ctx)
+	}
+}
+
+// NewCreateExportEndpoint returns an endpoint function that calls the method
+// "CreateExport" of service "infohub".
+func NewCreateExportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportConfiguration)
+		return s.CreateExport(ctx, p)
+	}
+}
+
+// NewGetExportEndpoint returns an endpoint function that calls the method
+// "GetExport" of service "infohub".
+func NewGetExportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportConfigurationRequest)
+		return s.GetExport(ctx, p)
+	}
+}
+
+// NewUpdateExportEndpoint returns an endpoint function that calls the method
+// "UpdateExport" of service "infohub".
+func NewUpdateExportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportConfiguration)
+		return s.UpdateExport(ctx, p)
+	}
+}
+
+// NewDeleteExportEndpoint returns an endpoint function that calls the method
+// "DeleteExport" of service "infohub".
+func NewDeleteExportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportConfigurationRequest)
+		return nil, s.DeleteExport(ctx, p)
+	}
+}
+
 // NewImportEndpoint returns an endpoint function that calls the method
 // "Import" of service "infohub".
 func NewImportEndpoint(s Service) goa.Endpoint {
@@ -50,3 +133,38 @@ func NewImportEndpoint(s Service) goa.Endpoint {
 		return s.Import(ctx, p)
 	}
 }
+
+// NewImportChallengeEndpoint returns an endpoint function that calls the
+// method "ImportChallenge" of service "infohub".
+func NewImportChallengeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		return s.ImportChallenge(ctx)
+	}
+}
+
+// NewListImportsEndpoint returns an endpoint function that calls the method
+// "ListImports" of service "infohub".
+func NewListImportsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportRecordsRequest)
+		return s.ListImports(ctx, p)
+	}
+}
+
+// NewGetImportEndpoint returns an endpoint function that calls the method
+// "GetImport" of service "infohub".
+func NewGetImportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportRecordRequest)
+		return s.GetImport(ctx, p)
+	}
+}
+
+// NewDeleteImportEndpoint returns an endpoint function that calls the method
+// "DeleteImport" of service "infohub".
+func NewDeleteImportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportRecordRequest)
+		return nil, s.DeleteImport(ctx, p)
+	}
+}
@@ -13,10 +13,35 @@ import (
 
 // Information Hub Service enables exporting and importing information.
 type Service interface {
-	// Export returns data signed as Verifiable Presentation.
-	Export(context.Context, *ExportRequest) (res any, err error)
+	// Export returns data signed as Verifiable Presentation. The Accept header
+	// selects a JSON-LD presentation, a VC-JWT presentation (application/vp+jwt)
+	// or an SD-JWT VC (application/vc+sd-jwt).
+	Export(context.Context, *ExportRequest) (res *ExportResult, err error)
+	// GetExportJob returns the progress of an asynchronous export job.
+	GetExportJob(context.Context, *ExportJobRequest) (res *ExportJob, err error)
+	// ListExports returns all export configurations.
+	ListExports(context.Context) (res []*ExportConfiguration, err error)
+	// CreateExport stores a new export configuration.
+	CreateExport(context.Context, *ExportConfiguration) (res *ExportConfiguration, err error)
+	// GetExport returns the export configuration with the given name.
+	GetExport(context.Context, *ExportConfigurationRequest) (res *ExportConfiguration, err error)
+	// UpdateExport replaces the export configuration with the given name.
+	UpdateExport(context.Context, *ExportConfiguration) (res *ExportConfiguration, err error)
+	// DeleteExport removes the export configuration with the given name.
+	DeleteExport(context.Context, *ExportConfigurationRequest) (err error)
 	// Import the given data wrapped as Verifiable Presentation into the Cache.
 	Import(context.Context, *ImportRequest) (res *ImportResult, err error)
+	// ImportChallenge issues a single-use challenge, which must be included in the
+	// proof of an imported Verifiable Presentation.
+	ImportChallenge(context.Context) (res *ChallengeResult, err error)
+	// ListImports returns the records of imported credentials, most recent imports
+	// first.
+	ListImports(context.Context, *ImportRecordsRequest) (res []*ImportRecord, err error)
+	// GetImport returns the record of an imported credential.
+	GetImport(context.Context, *ImportRecordRequest) (res *ImportRecord, err error)
+	// DeleteImport removes an imported credential subject from the Cache together
+	// with its import record.
+	DeleteImport(context.Context, *ImportRecordRequest) (err error)
 }
 
 // APIName is the name of the API as defined in the design.
@@ -33,18 +58,246 @@ const ServiceName = "infohub"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [2]string{"Export", "Import"}
+var MethodNames = [12]string{"Export", "GetExportJob", "ListExports", "CreateExport", "GetExport", "UpdateExport", "DeleteExport", "Import", "ImportChallenge", "ListImports", "GetImport", "DeleteImport"}
+
+// ChallengeResult is the result type of the infohub service ImportChallenge
+// method.
+type ChallengeResult struct {
+	// Challenge which must be given in the proof of the imported presentation.
+	Challenge string
+	// Domain which must be given in the proof of the imported presentation.
+	Domain *string
+	// Time when the challenge expires.
+	ExpiresAt string
+}
+
+type CredentialSchema struct {
+	// URL of the schema.
+	ID string
+	// Type of the schema.
+	Type string
+}
+
+type ExportAuthorization struct {
+	// Scopes which the token must grant.
+	Scopes []string
+	// Claims which the token must have with the given values.
+	Claims map[string]string
+	// Policy evaluated with the token claims as input. It must return allow set to
+	// true.
+	Policy *string
+}
+
+// ExportConfiguration is the payload type of the infohub service CreateExport
+// method.
+type ExportConfiguration struct {
+	// Unique name of the export.
+	ExportName string
+	// Additional JSON-LD contexts of the exported credentials.
+	Contexts []string
+	// Policies evaluated for the export, formatted as 'group/policy/version', and
+	// their input data.
+	Policies map[string]any
+	// Time in seconds for which policy results are kept in Cache.
+	CacheTTL *int
+	// DID of the issuer of the exported credentials.
+	Issuer string
+	// Namespace of the key used for signing the export.
+	KeyNamespace string
+	// Name of the key used for signing the export.
+	Key string
+	// Layout of the exported credentials: a separate credential for each policy
+	// result labeled with the policy name in the infohub:policy field, or a single
+	// credential with all policy results merged by policy name.
+	Layout string
+	// Types added to the VerifiableCredential type of the exported credentials.
+	CredentialTypes []string
+	// Schema of the exported credentials.
+	CredentialSchema *CredentialSchema
+	// Return the last signed presentation of the export while its data is
+	// evaluated again, instead of accepting the export request without data.
+	StaleWhileRevalidate bool
+	// Cron expression specifying when the export data is evaluated in advance, so
+	// that it's available before the previous results expire from Cache.
+	Schedule *string
+	// Parameters which can be given when requesting the export. They are templated
+	// into the policy input as ${name} and are part of the Cache key of the export
+	// data.
+	Parameters []*ExportParameter
+	// Rules which the token of a client must satisfy in order to perform the
+	// export.
+	Authorization *ExportAuthorization
+	// Identifier of a JSON schema which each policy result of the export must
+	// satisfy before it is signed.
+	ResultSchema *string
+	// Format of the exported data, unless another format is requested with the
+	// Accept header: JSON-LD presentation, VC-JWT presentation, SD-JWT VC or
+	// unsigned JSON for trusted internal consumers.
+	Format string
+	// Claims of the credential subject which are selectively disclosable in SD-JWT
+	// VC exports. Nested claims are given as dot separated paths.
+	SelectiveDisclosure []string
+	// Order in which the policy results are exported. It must list every policy of
+	// the export once. Policies are ordered by name by default.
+	PolicyOrder []string
+}
+
+// ExportConfigurationRequest is the payload type of the infohub service
+// GetExport method.
+type ExportConfigurationRequest struct {
+	// Name of the export configuration.
+	ExportName string
+}
+
+// ExportJob is the result type of the infohub service GetExportJob method.
+type ExportJob struct {
+	// Unique identifier of the export job.
+	ID string
+	// Name of the export.
+	ExportName string
+	// Status of the export job.
+	Status string
+	// Progress of the policy evaluations performed by the job.
+	Policies []*ExportJobPolicy
+	// Time when the job was created.
+	CreatedAt string
+	// Time when the job was last updated.
+	UpdatedAt string
+	// Parameter values of the export request.
+	Parameters map[string]any
+}
+
+type ExportJobPolicy struct {
+	// Name of the policy formatted as 'group/policy/version'.
+	Policy string
+	// Status of the policy evaluation.
+	Status string
+	// Error message if the policy evaluation failed.
+	Error *string
+}
+
+// ExportJobRequest is the payload type of the infohub service GetExportJob
+// method.
+type ExportJobRequest struct {
+	// Name of the export.
+	ExportName string
+	// Unique identifier of the export job.
+	ID string
+}
+
+type ExportParameter struct {
+	// Name of the parameter.
+	Name string
+	// Type of the parameter value.
+	Type string
+	// Whether the parameter must be given when requesting the export.
+	Required bool
+	// Regular expression which string values must match.
+	Pattern *string
+	// Value of the parameter when it's not given.
+	Default any
+	// Description of the parameter.
+	Description *string
+}
 
 // ExportRequest is the payload type of the infohub service Export method.
 type ExportRequest struct {
 	// Name of export to be performed.
 	ExportName string
+	// Export parameters given as query string.
+	Query map[string]string
+	// Export parameters given in the request body.
+	Parameters map[string]any
+	// Media types of the requested export format.
+	Accept *string
+}
+
+// ExportResult is the result type of the infohub service Export method.
+type ExportResult struct {
+	// Data signed as Verifiable Presentation or a message that the export request
+	// is accepted.
+	Result any
+	// Status of the export request.
+	Status string
+	// Location of the export job which is started when the export data is not
+	// available.
+	Location *string
+	// Stale is true when the last signed presentation is returned while the export
+	// data is evaluated again.
+	Stale *bool
+	// Age in seconds of a stale presentation.
+	Age *int
+	// Media type of the exported data.
+	ContentType *string
+}
+
+// ImportRecord is the result type of the infohub service GetImport method.
+type ImportRecord struct {
+	// Identifier of the import record.
+	ID string
+	// Cache key of the imported credential subject.
+	Key string
+	// Cache namespace of the imported credential subject.
+	Namespace *string
+	// Cache scope of the imported credential subject.
+	Scope *string
+	// Identifier of the imported credential.
+	CredentialID *string
+	// Issuer of the imported credential.
+	Issuer *string
+	// Holder of the imported presentation.
+	Holder *string
+	// Identifier of the credential subject.
+	SubjectID *string
+	// SHA-256 hash of the canonical form of the credential.
+	Hash string
+	// Client which imported the credential.
+	Importer *string
+	// Time of the import.
+	ImportedAt string
+}
+
+// ImportRecordRequest is the payload type of the infohub service GetImport
+// method.
+type ImportRecordRequest struct {
+	// Identifier of the import record.
+	ID string
+}
+
+// ImportRecordsRequest is the payload type of the infohub service ListImports
+// method.
+type ImportRecordsRequest struct {
+	// Issuer of the imported credentials.
+	Issuer *string
+	// Holder of the imported presentations.
+	Holder *string
+	// Identifier of the subjects of the imported credentials.
+	SubjectID *string
+	// Maximum number of returned records.
+	Limit int
 }
 
 // ImportRequest is the payload type of the infohub service Import method.
 type ImportRequest struct {
 	// Data wrapped in Verifiable Presentation that will be imported into Cache.
 	Data []byte
+	// Cache namespace of the imported data. The namespaces which clients may
+	// import into are configured.
+	Namespace *string
+	// Cache scope of the imported data.
+	Scope *string
+	// Cache key of the imported data. It can only be given for presentations with
+	// a single credential.
+	Key *string
+	// Source of the Cache keys of the imported credentials: random identifiers,
+	// the credential id or the credential subject id.
+	KeyFrom string
+	// Atomic imports either import all credentials or none of them. Entries
+	// written before a failure are removed.
+	Atomic bool
+	// Idempotency key of the import request. Repeated requests with the same key
+	// return the result of the first request.
+	IdempotencyKey *string
 }
 
 // ImportResult is the result type of the infohub service Import method.
@@ -52,4 +305,23 @@ type ImportResult struct {
 	// importIds is an array of unique identifiers used as Cache keys to retrieve
 	// the imported data entries later.
 	ImportIds []string
+	// Result of the import of each credential of the presentation.
+	Credentials []*ImportedCredential
+}
+
+type ImportedCredential struct {
+	// Position of the credential in the presentation.
+	Index int
+	// Identifier of the credential, if it has one.
+	CredentialID *string
+	// Cache key of the credential subject. For duplicate credentials it is the key
+	// of the previous import.
+	Key string
+	// Status of the import of the credential.
+	Status string
+	// Error message if the import of the credential failed.
+	Error *string
+	// Identifier of the import record of the credential, which is used to look up
+	// or delete the imported data.
+	ID *string
 }
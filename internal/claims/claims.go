@@ -0,0 +1,132 @@
+package claims
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/lestrrat-go/jwx/v2/jwt"
+)
+
+type claimsKey struct{}
+
+// Claims of the bearer token of an authenticated request.
+type Claims map[string]interface{}
+
+// NewContext returns a new context carrying the given token claims.
+func NewContext(ctx context.Context, c Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, c)
+}
+
+// FromContext returns the token claims carried by the context, if any.
+func FromContext(ctx context.Context) (Claims, bool) {
+	c, ok := ctx.Value(claimsKey{}).(Claims)
+	return c, ok
+}
+
+// Middleware places the claims of the bearer token in the request context.
+//
+// The token is NOT verified, so the middleware must be applied after the
+// authentication middleware which verifies the token. Requests without
+// a bearer token are passed on without claims.
+func Middleware() func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			c, err := Parse(token)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusUnauthorized)
+				return
+			}
+
+			h.ServeHTTP(w, r.WithContext(NewContext(r.Context(), c)))
+		})
+	}
+}
+
+// Parse returns the claims of a JWT without verifying it.
+func Parse(token string) (Claims, error) {
+	t, err := jwt.ParseInsecure([]byte(token))
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %v", err)
+	}
+
+	c, err := t.AsMap(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("invalid token claims: %v", err)
+	}
+
+	return c, nil
+}
+
+// Subject returns the subject of the token.
+func (c Claims) Subject() string {
+	sub, _ := c["sub"].(string)
+	return sub
+}
+
+// ClientID returns the identifier of the client the token is issued to.
+// It's taken from the "client_id", "azp" or "cid" claim, falling back to
+// the subject for tokens issued with client credentials.
+func (c Claims) ClientID() string {
+	for _, name := range []string{"client_id", "azp", "cid"} {
+		if id, ok := c[name].(string); ok && id != "" {
+			return id
+		}
+	}
+	return c.Subject()
+}
+
+// Scopes returns the scopes granted by the token. Scopes are taken from
+// the space-delimited "scope" claim or from the "scp" claim, which some
+// identity providers issue as array.
+func (c Claims) Scopes() []string {
+	var scopes []string
+	for _, name := range []string{"scope", "scp"} {
+		switch v := c[name].(type) {
+		case string:
+			scopes = append(scopes, strings.Fields(v)...)
+		case []interface{}:
+			for _, s := range v {
+				if s, ok := s.(string); ok {
+					scopes = append(scopes, s)
+				}
+			}
+		}
+	}
+	return scopes
+}
+
+// HasScope reports whether the token grants the given scope.
+func (c Claims) HasScope(scope string) bool {
+	for _, s := range c.Scopes() {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// HasClaim reports whether the token has a claim with the given value.
+// Claims with array values match when the array contains the value.
+func (c Claims) HasClaim(name, value string) bool {
+	switch v := c[name].(type) {
+	case []interface{}:
+		for _, e := range v {
+			if fmt.Sprint(e) == value {
+				return true
+			}
+		}
+		return false
+	case nil:
+		return false
+	default:
+		return fmt.Sprint(v) == value
+	}
+}
@@ -0,0 +1,82 @@
+package claims_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+)
+
+func TestMiddleware(t *testing.T) {
+	token := jwt.New()
+	require.NoError(t, token.Set("sub", "client-1"))
+	require.NoError(t, token.Set("scope", "export:a import"))
+	require.NoError(t, token.Set("groups", []interface{}{"admins", "users"}))
+	signed, err := jwt.Sign(token, jwt.WithKey(jwa.HS256, []byte("secret")))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		header string
+
+		status int
+		claims bool
+	}{
+		{name: "request without token", status: http.StatusOK},
+		{name: "request with invalid token", header: "Bearer invalid", status: http.StatusUnauthorized},
+		{name: "request with token", header: "Bearer " + string(signed), status: http.StatusOK, claims: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var c claims.Claims
+			var ok bool
+			h := claims.Middleware()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				c, ok = claims.FromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/export/test", nil)
+			if test.header != "" {
+				req.Header.Set("Authorization", test.header)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, test.status, rec.Code)
+			assert.Equal(t, test.claims, ok)
+			if test.claims {
+				assert.Equal(t, "client-1", c.Subject())
+				assert.Equal(t, []string{"export:a", "import"}, c.Scopes())
+				assert.True(t, c.HasScope("import"))
+				assert.False(t, c.HasScope("export:b"))
+				assert.True(t, c.HasClaim("groups", "admins"))
+				assert.False(t, c.HasClaim("groups", "guests"))
+				assert.True(t, c.HasClaim("sub", "client-1"))
+			}
+		})
+	}
+}
+
+func TestClaims_Scopes(t *testing.T) {
+	c := claims.Claims{"scp": []interface{}{"a", "b"}}
+	assert.Equal(t, []string{"a", "b"}, c.Scopes())
+
+	ctx := claims.NewContext(context.Background(), c)
+	res, ok := claims.FromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, c, res)
+}
+
+func TestClaims_ClientID(t *testing.T) {
+	assert.Equal(t, "client-1", claims.Claims{"azp": "client-1", "sub": "user-1"}.ClientID())
+	assert.Equal(t, "client-1", claims.Claims{"client_id": "client-1", "azp": "client-2"}.ClientID())
+	assert.Equal(t, "user-1", claims.Claims{"sub": "user-1"}.ClientID())
+	assert.Empty(t, claims.Claims(nil).ClientID())
+}
@@ -0,0 +1,69 @@
+// Package cache implements a client of the Cache service, which extends
+// the client of the core library with removing Cache entries.
+package cache
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	cache "github.com/eclipse-xfsc/microservice-core-go/pkg/cache"
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/transport"
+)
+
+type Client struct {
+	*cache.Client
+
+	addr       string
+	httpClient *http.Client
+}
+
+func New(addr string, opts ...ClientOption) *Client {
+	c := &Client{
+		addr:       addr,
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.Client = cache.New(addr, cache.WithHTTPClient(c.httpClient))
+
+	return c
+}
+
+// Delete removes the Cache entry with the given key, namespace and scope.
+// Removing an entry which doesn't exist is not an error.
+func (c *Client) Delete(ctx context.Context, key, namespace, scope string) error {
+	cacheURL, err := url.ParseRequestURI(c.addr + "/v1/cache")
+	if err != nil {
+		return errors.New(errors.Internal, "invalid cache url", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, cacheURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header = http.Header{
+		"x-cache-key":       []string{key},
+		"x-cache-namespace": []string{namespace},
+		"x-cache-scope":     []string{scope},
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return transport.Cause(err)
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent, http.StatusNotFound:
+		return nil
+	default:
+		return errors.New(errors.GetKind(resp.StatusCode), fmt.Sprintf("unexpected response: %s", resp.Status))
+	}
+}
@@ -0,0 +1,51 @@
+package cache_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/cache"
+)
+
+func TestClient_Delete(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+
+		errkind errors.Kind
+	}{
+		{name: "entry is removed", status: http.StatusOK},
+		{name: "entry is removed without content", status: http.StatusNoContent},
+		{name: "entry doesn't exist", status: http.StatusNotFound},
+		{name: "cache returns error", status: http.StatusServiceUnavailable, errkind: errors.ServiceUnavailable},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodDelete, r.Method)
+				assert.Equal(t, "/v1/cache", r.URL.Path)
+				assert.Equal(t, "key-1", r.Header.Get("x-cache-key"))
+				assert.Equal(t, "participants", r.Header.Get("x-cache-namespace"))
+				assert.Equal(t, "compliance", r.Header.Get("x-cache-scope"))
+				w.WriteHeader(test.status)
+			}))
+			defer srv.Close()
+
+			err := cache.New(srv.URL).Delete(context.Background(), "key-1", "participants", "compliance")
+			if test.errkind == errors.Unknown {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(test.errkind, err))
+		})
+	}
+}
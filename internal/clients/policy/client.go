@@ -11,6 +11,7 @@ import (
 	"strconv"
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/transport"
 )
 
 const (
@@ -67,12 +68,12 @@ func (c *Client) Evaluate(ctx context.Context, policy string, data interface{},
 
 	resp, err := c.httpClient.Do(req.WithContext(ctx))
 	if err != nil {
-		return nil, err
+		return nil, transport.Cause(err)
 	}
 	defer resp.Body.Close() // nolint:errcheck
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected response on policy evaluation: %s", resp.Status)
+		return nil, errors.New(errors.GetKind(resp.StatusCode), fmt.Sprintf("unexpected response on policy evaluation: %s", resp.Status))
 	}
 
 	return io.ReadAll(resp.Body)
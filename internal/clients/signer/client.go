@@ -3,24 +3,44 @@ package signer
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"sync"
 
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/piprate/json-gold/ld"
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/transport"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
 )
 
 const (
-	createPresentationPath = "/v1/presentation"
+	presentationProofPath  = "/v1/presentation/proof"
 	presentationVerifyPath = "/v1/presentation/verify"
+	credentialVerifyPath   = "/v1/credential/verify"
+	signPath               = "/v1/sign"
+	verificationMethodPath = "/v1/verification-methods"
 )
 
 type Client struct {
 	addr       string
 	httpClient *http.Client
 	docLoader  *ld.CachingDocumentLoader
+
+	// verificationMethods caches the verification methods of signing keys
+	verificationMethods sync.Map
+}
+
+// verificationMethod is the public key of a signing key.
+type verificationMethod struct {
+	ID           string           `json:"id"`
+	PublicKeyJWK *jose.JSONWebKey `json:"publicKeyJwk"`
 }
 
 func New(addr string, opts ...ClientOption) *Client {
@@ -38,12 +58,15 @@ func New(addr string, opts ...ClientOption) *Client {
 	return c
 }
 
-func (c *Client) CreatePresentation(ctx context.Context, issuer, namespace, key string, data []map[string]interface{}) (map[string]interface{}, error) {
+// PresentationProof adds a proof to the given Verifiable Presentation
+// and to the credentials it contains, using the signing key identified
+// by namespace and key.
+func (c *Client) PresentationProof(ctx context.Context, issuer, namespace, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
 	payload := map[string]interface{}{
-		"issuer":    issuer,
-		"namespace": namespace,
-		"key":       key,
-		"data":      data,
+		"issuer":       issuer,
+		"namespace":    namespace,
+		"key":          key,
+		"presentation": vp,
 	}
 
 	payloadJSON, err := json.Marshal(payload)
@@ -51,14 +74,14 @@ func (c *Client) CreatePresentation(ctx context.Context, issuer, namespace, key
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", c.addr+createPresentationPath, bytes.NewReader(payloadJSON))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.addr+presentationProofPath, bytes.NewReader(payloadJSON))
 	if err != nil {
 		return nil, err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, transport.Cause(err)
 	}
 	defer resp.Body.Close()
 
@@ -74,15 +97,119 @@ func (c *Client) CreatePresentation(ctx context.Context, issuer, namespace, key
 	return presentation, nil
 }
 
+// SignJWT returns a compact JWT with the given type and claims, signed with
+// the signing key identified by namespace and key. The key ID of the JWT is
+// the verification method of the key for the given issuer.
+func (c *Client) SignJWT(ctx context.Context, issuer, namespace, key, typ string, claims map[string]interface{}) (string, error) {
+	vm, err := c.verificationMethod(ctx, issuer, namespace, key)
+	if err != nil {
+		return "", err
+	}
+
+	alg, err := jwtvc.Algorithm(vm.PublicKeyJWK.Key)
+	if err != nil {
+		return "", errors.New("unsupported signing key", err)
+	}
+
+	input, err := jwtvc.SigningInput(map[string]interface{}{"alg": alg, "typ": typ, "kid": vm.ID}, claims)
+	if err != nil {
+		return "", err
+	}
+
+	payloadJSON, err := json.Marshal(map[string]interface{}{
+		"namespace": namespace,
+		"key":       key,
+		"data":      base64.StdEncoding.EncodeToString([]byte(input)),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.addr+signPath, bytes.NewReader(payloadJSON))
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", transport.Cause(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New(errors.GetKind(resp.StatusCode), getErrorBody(resp))
+	}
+
+	var result struct {
+		Signature string `json:"signature"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", errors.New("error decoding signer response as signature", err)
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(result.Signature)
+	if err != nil {
+		return "", errors.New("invalid signature encoding", err)
+	}
+
+	return jwtvc.Compact(input, alg, sig)
+}
+
+// verificationMethod returns the verification method of the signing key
+// identified by namespace and key for the given issuer.
+func (c *Client) verificationMethod(ctx context.Context, issuer, namespace, key string) (*verificationMethod, error) {
+	path := fmt.Sprintf("%s/%s/%s/%s", verificationMethodPath, url.PathEscape(namespace), url.PathEscape(key), url.PathEscape(issuer))
+	if vm, ok := c.verificationMethods.Load(path); ok {
+		return vm.(*verificationMethod), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", c.addr+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, transport.Cause(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(errors.GetKind(resp.StatusCode), getErrorBody(resp))
+	}
+
+	var vm verificationMethod
+	if err := json.NewDecoder(resp.Body).Decode(&vm); err != nil {
+		return nil, errors.New("error decoding signer response as verification method", err)
+	}
+	if vm.PublicKeyJWK == nil {
+		return nil, errors.New("verification method doesn't contain public key")
+	}
+
+	c.verificationMethods.Store(path, &vm)
+	return &vm, nil
+}
+
 func (c *Client) VerifyPresentation(ctx context.Context, vp []byte) error {
-	req, err := http.NewRequestWithContext(ctx, "POST", c.addr+presentationVerifyPath, bytes.NewReader(vp))
+	return c.verify(ctx, presentationVerifyPath, vp, "invalid presentation proof")
+}
+
+// VerifyCredential verifies the proof of a single verifiable credential.
+func (c *Client) VerifyCredential(ctx context.Context, vc []byte) error {
+	return c.verify(ctx, credentialVerifyPath, vc, "invalid credential proof")
+}
+
+// verify sends the data to the given verification endpoint of the signer.
+// If the proof is not valid, an error with the given message is returned.
+func (c *Client) verify(ctx context.Context, path string, data []byte, invalid string) error {
+	req, err := http.NewRequestWithContext(ctx, "POST", c.addr+path, bytes.NewReader(data))
 	if err != nil {
 		return err
 	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return err
+		return transport.Cause(err)
 	}
 	defer resp.Body.Close()
 
@@ -98,7 +225,7 @@ func (c *Client) VerifyPresentation(ctx context.Context, vp []byte) error {
 	}
 
 	if !result.Valid {
-		return errors.New("invalid presentation proof")
+		return errors.New(invalid)
 	}
 
 	return nil
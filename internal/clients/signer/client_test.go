@@ -2,58 +2,90 @@ package signer_test
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/signer"
 )
 
-func TestClient_CreatePresentation(t *testing.T) {
+func TestClient_PresentationProof(t *testing.T) {
 	tests := []struct {
 		name    string
-		data    []map[string]interface{}
 		handler http.HandlerFunc
 
 		result  map[string]interface{}
-		errkind errors.Kind
 		errtext string
 	}{
 		{
 			name: "signer returns error",
-			data: []map[string]interface{}{{"hello": "world"}},
 			handler: func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusInternalServerError)
-				_, _ = w.Write([]byte("some error"))
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("invalid presentation"))
 			},
-			errkind: errors.Internal,
-			errtext: "some error",
+			errtext: "invalid presentation",
 		},
 		{
-			name: "signer successfully creates verifiable presentation",
-			data: []map[string]interface{}{{"hello": "world"}},
+			name: "signer returns invalid json",
 			handler: func(w http.ResponseWriter, r *http.Request) {
-				w.WriteHeader(http.StatusOK)
-				_, _ = w.Write([]byte(`{"id":"did:web:example.com"}`))
+				_, _ = w.Write([]byte("invalid json"))
+			},
+			errtext: "error decoding signer response as verifiable presentation",
+		},
+		{
+			name: "signer successfully adds proof to verifiable presentation",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/presentation/proof" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+
+				var payload map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				if payload["issuer"] != "issuer" || payload["namespace"] != "namespace" || payload["key"] != "key" || payload["presentation"] == nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				_, _ = w.Write([]byte(`{"id":"did:web:example.com","proof":{"type":"JsonWebSignature2020"}}`))
+			},
+			result: map[string]interface{}{
+				"id":    "did:web:example.com",
+				"proof": map[string]interface{}{"type": "JsonWebSignature2020"},
 			},
-			result: map[string]interface{}{"id": "did:web:example.com"},
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			srv := httptest.NewServer(test.handler)
+			defer srv.Close()
+
+			vp, err := verifiable.NewPresentation()
+			assert.NoError(t, err)
+
 			client := signer.New(srv.URL)
-			result, err := client.CreatePresentation(context.Background(), "issuer", "namespace", "key", test.data)
+			result, err := client.PresentationProof(context.Background(), "issuer", "namespace", "key", vp)
 			if test.errtext != "" {
-				assert.Error(t, err)
-				assert.Contains(t, err.Error(), test.errtext)
+				assert.ErrorContains(t, err, test.errtext)
 				assert.Nil(t, result)
 			} else {
-				assert.NotNil(t, result)
+				assert.NoError(t, err)
 				assert.Equal(t, test.result, result)
 			}
 		})
@@ -111,3 +143,143 @@ func TestClient_VerifyPresentation(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_VerifyCredential(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		errtext string
+	}{
+		{
+			name: "signer returns error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("some error"))
+			},
+			errtext: "some error",
+		},
+		{
+			name: "credential proof is not valid",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"valid":false}`))
+			},
+			errtext: "invalid credential proof",
+		},
+		{
+			name: "credential proof is valid",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/credential/verify", r.URL.Path)
+				_, _ = w.Write([]byte(`{"valid":true}`))
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := httptest.NewServer(test.handler)
+			defer srv.Close()
+
+			err := signer.New(srv.URL).VerifyCredential(context.Background(), []byte(`{"id":"urn:uuid:1"}`))
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClient_SignJWT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwk, err := json.Marshal(jose.JSONWebKey{Key: &key.PublicKey})
+	require.NoError(t, err)
+
+	// signer signs with ASN.1 DER encoded ECDSA signatures
+	sign := func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "namespace", payload["namespace"])
+		assert.Equal(t, "key", payload["key"])
+
+		data, err := base64.StdEncoding.DecodeString(payload["data"])
+		require.NoError(t, err)
+		hash := sha256.Sum256(data)
+		sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+		require.NoError(t, err)
+		_, _ = w.Write([]byte(`{"signature":"` + base64.StdEncoding.EncodeToString(sig) + `"}`))
+	}
+	verificationMethod := func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"did:web:issuer.example.com#key","publicKeyJwk":` + string(jwk) + `}`))
+	}
+
+	tests := []struct {
+		name               string
+		verificationMethod http.HandlerFunc
+		sign               http.HandlerFunc
+		errtext            string
+	}{
+		{
+			name: "verification method not found",
+			verificationMethod: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte("key not found"))
+			},
+			errtext: "key not found",
+		},
+		{
+			name: "verification method without public key",
+			verificationMethod: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"id":"did:web:issuer.example.com#key"}`))
+			},
+			errtext: "verification method doesn't contain public key",
+		},
+		{
+			name:               "signer returns error",
+			verificationMethod: verificationMethod,
+			sign: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte("some error"))
+			},
+			errtext: "some error",
+		},
+		{
+			name:               "signer successfully signs JWT",
+			verificationMethod: verificationMethod,
+			sign:               sign,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v1/verification-methods/namespace/key/did:web:issuer.example.com", test.verificationMethod)
+			if test.sign != nil {
+				mux.HandleFunc("/v1/sign", test.sign)
+			}
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+
+			client := signer.New(srv.URL)
+			tok, err := client.SignJWT(context.Background(), "did:web:issuer.example.com", "namespace", "key", "JWT", map[string]interface{}{"iss": "did:web:issuer.example.com"})
+			if test.errtext != "" {
+				assert.ErrorContains(t, err, test.errtext)
+				assert.Empty(t, tok)
+				return
+			}
+			require.NoError(t, err)
+
+			jws, err := jose.ParseSigned(tok)
+			require.NoError(t, err)
+			header := jws.Signatures[0].Header
+			assert.Equal(t, "ES256", header.Algorithm)
+			assert.Equal(t, "did:web:issuer.example.com#key", header.KeyID)
+			assert.Equal(t, "JWT", header.ExtraHeaders[jose.HeaderType])
+
+			payload, err := jws.Verify(&key.PublicKey)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"iss":"did:web:issuer.example.com"}`, string(payload))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package transport
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	breakerState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "infohub_upstream_circuit_breaker_state",
+		Help: "State of the circuit breaker of an upstream service: 0 closed, 1 half-open, 2 open.",
+	}, []string{"upstream"})
+
+	retries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "infohub_upstream_retries_total",
+		Help: "Number of retried requests to an upstream service.",
+	}, []string{"upstream"})
+
+	rejected = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "infohub_upstream_rejected_total",
+		Help: "Number of requests to an upstream service rejected by its open circuit breaker.",
+	}, []string{"upstream"})
+)
@@ -0,0 +1,70 @@
+package transport
+
+import (
+	"net/http"
+	"time"
+)
+
+type Option func(*Transport)
+
+// WithBase sets the transport which sends the requests.
+func WithBase(base http.RoundTripper) Option {
+	return func(t *Transport) {
+		if base != nil {
+			t.base = base
+		}
+	}
+}
+
+// WithTimeout sets the timeout of each attempt of a request.
+func WithTimeout(timeout time.Duration) Option {
+	return func(t *Transport) {
+		if timeout > 0 {
+			t.timeout = timeout
+		}
+	}
+}
+
+// WithRetries sets how often failed idempotent requests are retried.
+func WithRetries(n int) Option {
+	return func(t *Transport) {
+		if n >= 0 {
+			t.retries = n
+		}
+	}
+}
+
+// WithBackoff sets the bounds of the backoff between retries. The backoff
+// doubles with each retry up to max and is fully jittered.
+func WithBackoff(min, max time.Duration) Option {
+	return func(t *Transport) {
+		if min > 0 && max >= min {
+			t.minBackoff, t.maxBackoff = min, max
+		}
+	}
+}
+
+// WithIdempotentMethods marks requests with the given methods as idempotent
+// in addition to GET, HEAD, OPTIONS, PUT and DELETE requests, e.g. POST
+// requests of upstream services which don't change state with them.
+func WithIdempotentMethods(methods ...string) Option {
+	return func(t *Transport) {
+		for _, m := range methods {
+			t.idempotent[m] = true
+		}
+	}
+}
+
+// WithBreaker configures the circuit breaker, which opens after threshold
+// consecutive failures and lets a probe request pass after openTimeout.
+// A threshold of zero disables the circuit breaker.
+func WithBreaker(threshold int, openTimeout time.Duration) Option {
+	return func(t *Transport) {
+		if threshold >= 0 {
+			t.breaker.threshold = threshold
+		}
+		if openTimeout > 0 {
+			t.breaker.openTimeout = openTimeout
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Package transport implements a resilient HTTP transport for the clients
+// of upstream services. It retries failed idempotent requests with jittered
+// exponential backoff, limits each attempt with a timeout and stops calling
+// unavailable upstream services with a circuit breaker.
+package transport
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// Transport is a http.RoundTripper for the requests to one upstream service.
+type Transport struct {
+	upstream   string
+	base       http.RoundTripper
+	timeout    time.Duration
+	retries    int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	idempotent map[string]bool
+	breaker    *breaker
+}
+
+// New creates the transport of the upstream service with the given name,
+// which labels its metrics and errors.
+func New(upstream string, opts ...Option) *Transport {
+	t := &Transport{
+		upstream:   upstream,
+		base:       http.DefaultTransport,
+		timeout:    10 * time.Second,
+		retries:    2,
+		minBackoff: 100 * time.Millisecond,
+		maxBackoff: 2 * time.Second,
+		idempotent: map[string]bool{
+			http.MethodGet:     true,
+			http.MethodHead:    true,
+			http.MethodOptions: true,
+			http.MethodPut:     true,
+			http.MethodDelete:  true,
+		},
+		breaker: &breaker{threshold: 5, openTimeout: 30 * time.Second},
+	}
+
+	for _, opt := range opts {
+		opt(t)
+	}
+
+	state := breakerState.WithLabelValues(upstream)
+	state.Set(float64(Closed))
+	t.breaker.onChange = func(s State) {
+		state.Set(float64(s))
+	}
+
+	return t
+}
+
+// State returns the state of the circuit breaker of the upstream service.
+func (t *Transport) State() State {
+	return t.breaker.current()
+}
+
+// Ready returns an error while the circuit breaker of the upstream service
+// rejects requests, so the service can't serve requests which depend on it.
+func (t *Transport) Ready(_ context.Context) error {
+	if t.breaker.open() {
+		return errors.New(errors.ServiceUnavailable, fmt.Sprintf("%s is unavailable: circuit breaker is open", t.upstream))
+	}
+	return nil
+}
+
+// RoundTrip sends the request to the upstream service. Idempotent requests
+// are retried on connection errors, timeouts and the responses 429, 502,
+// 503 and 504, as long as their body can be sent again.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attempts := 1
+	if t.idempotent[req.Method] && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil) {
+		attempts += t.retries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if !t.breaker.allow() {
+			rejected.WithLabelValues(t.upstream).Inc()
+			return nil, errors.New(errors.ServiceUnavailable, fmt.Sprintf("%s is unavailable: circuit breaker is open", t.upstream))
+		}
+
+		resp, err := t.send(req, attempt)
+		if req.Context().Err() != nil {
+			// requests canceled by the caller don't tell
+			// anything about the upstream service
+			t.breaker.release()
+			if resp != nil {
+				return resp, nil
+			}
+			return nil, err
+		}
+		t.breaker.done(err != nil || resp.StatusCode >= http.StatusInternalServerError)
+
+		if attempt+1 >= attempts || !retryable(resp, err) {
+			if err != nil {
+				return nil, t.error(err)
+			}
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			_ = resp.Body.Close()
+		}
+		retries.WithLabelValues(t.upstream).Inc()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// send sends one attempt of the request, which is limited by the timeout.
+// The timeout applies until the response body is closed.
+func (t *Transport) send(req *http.Request, attempt int) (*http.Response, error) {
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.Body = body
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelBody{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+// backoff returns the time to wait before the next attempt, which is
+// chosen at random up to the exponential backoff of the attempt. The
+// Retry-After header of the response is respected up to the maximum.
+func (t *Transport) backoff(attempt int, resp *http.Response) time.Duration {
+	backoff := t.maxBackoff
+	if attempt < 30 && t.minBackoff<<attempt < t.maxBackoff {
+		backoff = t.minBackoff << attempt
+	}
+	wait := rand.N(backoff + 1)
+
+	if resp != nil {
+		if secs, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && secs > 0 {
+			wait = max(wait, min(time.Duration(secs)*time.Second, t.maxBackoff))
+		}
+	}
+
+	return wait
+}
+
+// error returns the error of the last attempt with its error kind.
+func (t *Transport) error(err error) error {
+	if stderrors.Is(err, context.DeadlineExceeded) {
+		return errors.New(errors.Timeout, fmt.Sprintf("%s timed out", t.upstream), err)
+	}
+	return errors.New(errors.ServiceUnavailable, fmt.Sprintf("error calling %s", t.upstream), err)
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// Cause returns the error of the transport wrapped by the error of an
+// HTTP client, which keeps the error kind for the callers of clients.
+// Other errors are returned as they are.
+func Cause(err error) error {
+	var e *errors.Error
+	if stderrors.As(err, &e) {
+		return e
+	}
+	return err
+}
+
+// cancelBody cancels the context of a request attempt when
+// the response body is closed.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
@@ -0,0 +1,223 @@
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/transport"
+)
+
+// upstream responds with the given status codes in turn and
+// echoes the request body, if it responds with 200.
+func upstream(t *testing.T, calls *int32, statuses ...int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+		status := statuses[len(statuses)-1]
+		if int(n) <= len(statuses) {
+			status = statuses[n-1]
+		}
+		if status == 0 {
+			time.Sleep(200 * time.Millisecond)
+			status = http.StatusOK
+		}
+		w.WriteHeader(status)
+		_, err := io.Copy(w, r.Body)
+		require.NoError(t, err)
+	}))
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int
+		opts     []transport.Option
+
+		status  int
+		calls   int32
+		errkind errors.Kind
+	}{
+		{
+			name:     "successful request",
+			method:   http.MethodGet,
+			statuses: []int{http.StatusOK},
+			status:   http.StatusOK,
+			calls:    1,
+		},
+		{
+			name:     "idempotent request is retried",
+			method:   http.MethodPut,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK},
+			status:   http.StatusOK,
+			calls:    3,
+		},
+		{
+			name:     "retries are exhausted",
+			method:   http.MethodGet,
+			statuses: []int{http.StatusServiceUnavailable},
+			opts:     []transport.Option{transport.WithRetries(1)},
+			status:   http.StatusServiceUnavailable,
+			calls:    2,
+		},
+		{
+			name:     "non-idempotent request is not retried",
+			method:   http.MethodPost,
+			statuses: []int{http.StatusServiceUnavailable, http.StatusOK},
+			status:   http.StatusServiceUnavailable,
+			calls:    1,
+		},
+		{
+			name:     "request with method marked as idempotent is retried",
+			method:   http.MethodPost,
+			statuses: []int{http.StatusTooManyRequests, http.StatusOK},
+			opts:     []transport.Option{transport.WithIdempotentMethods(http.MethodPost)},
+			status:   http.StatusOK,
+			calls:    2,
+		},
+		{
+			name:     "client error is not retried",
+			method:   http.MethodGet,
+			statuses: []int{http.StatusNotFound, http.StatusOK},
+			status:   http.StatusNotFound,
+			calls:    1,
+		},
+		{
+			name:     "internal server error is not retried",
+			method:   http.MethodGet,
+			statuses: []int{http.StatusInternalServerError, http.StatusOK},
+			status:   http.StatusInternalServerError,
+			calls:    1,
+		},
+		{
+			name:     "attempt timing out is retried",
+			method:   http.MethodGet,
+			statuses: []int{0, http.StatusOK},
+			opts:     []transport.Option{transport.WithTimeout(50 * time.Millisecond)},
+			status:   http.StatusOK,
+			calls:    2,
+		},
+		{
+			name:     "all attempts time out",
+			method:   http.MethodGet,
+			statuses: []int{0},
+			opts:     []transport.Option{transport.WithTimeout(50 * time.Millisecond), transport.WithRetries(1)},
+			calls:    2,
+			errkind:  errors.Timeout,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var calls int32
+			srv := upstream(t, &calls, test.statuses...)
+			defer srv.Close()
+
+			opts := append([]transport.Option{transport.WithBackoff(time.Millisecond, 5*time.Millisecond)}, test.opts...)
+			client := &http.Client{Transport: transport.New("test", opts...)}
+
+			req, err := http.NewRequest(test.method, srv.URL, bytes.NewReader([]byte("body")))
+			require.NoError(t, err)
+			resp, err := client.Do(req)
+			assert.Equal(t, test.calls, atomic.LoadInt32(&calls))
+			if test.errkind != errors.Unknown {
+				require.Error(t, err)
+				assert.True(t, errors.Is(test.errkind, transport.Cause(err)))
+				return
+			}
+			require.NoError(t, err)
+			defer resp.Body.Close() // nolint:errcheck
+
+			assert.Equal(t, test.status, resp.StatusCode)
+			if resp.StatusCode == http.StatusOK {
+				// the body is sent again with each attempt
+				body, err := io.ReadAll(resp.Body)
+				require.NoError(t, err)
+				assert.Equal(t, "body", string(body))
+			}
+		})
+	}
+}
+
+func TestTransport_Breaker(t *testing.T) {
+	var calls int32
+	srv := upstream(t, &calls, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK)
+	defer srv.Close()
+
+	tr := transport.New(
+		"test",
+		transport.WithRetries(0),
+		transport.WithBreaker(2, 100*time.Millisecond),
+	)
+	client := &http.Client{Transport: tr}
+
+	get := func() (*http.Response, error) {
+		resp, err := client.Get(srv.URL)
+		if err == nil {
+			_ = resp.Body.Close()
+		}
+		return resp, transport.Cause(err)
+	}
+
+	// the breaker opens after two consecutive failures
+	for i := 0; i < 2; i++ {
+		resp, err := get()
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	assert.Equal(t, transport.Open, tr.State())
+	assert.True(t, errors.Is(errors.ServiceUnavailable, tr.Ready(context.Background())))
+
+	// requests are rejected without calling the upstream service
+	_, err := get()
+	assert.True(t, errors.Is(errors.ServiceUnavailable, err))
+	assert.ErrorContains(t, err, "test is unavailable: circuit breaker is open")
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+	// a failed probe opens the breaker again
+	time.Sleep(150 * time.Millisecond)
+	assert.NoError(t, tr.Ready(context.Background()))
+	resp, err := get()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, transport.Open, tr.State())
+
+	// a successful probe closes the breaker
+	time.Sleep(150 * time.Millisecond)
+	resp, err = get()
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, transport.Closed, tr.State())
+	assert.NoError(t, tr.Ready(context.Background()))
+	assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+}
+
+func TestTransport_Canceled(t *testing.T) {
+	var calls int32
+	srv := upstream(t, &calls, 0)
+	defer srv.Close()
+
+	tr := transport.New("test", transport.WithBreaker(1, time.Minute))
+	client := &http.Client{Transport: tr}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// requests canceled by the caller are neither retried
+	// nor counted as failures of the upstream service
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	assert.Equal(t, transport.Closed, tr.State())
+}
@@ -0,0 +1,244 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/transport"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+// algorithms are the JWS algorithms of the Transit key types
+// which can be used for signing exports.
+var algorithms = map[string]string{
+	"ed25519":    "EdDSA",
+	"ecdsa-p256": "ES256",
+	"ecdsa-p384": "ES384",
+	"rsa-2048":   "PS256",
+	"rsa-3072":   "PS256",
+	"rsa-4096":   "PS256",
+}
+
+// Client signs exported presentations and JWTs with the keys of the Vault
+// Transit secrets engine. The key namespace of an export is the mount path
+// of the Transit engine and the key is the name of the Transit key.
+type Client struct {
+	*credential.Prover
+	addr       string
+	token      string
+	httpClient *http.Client
+}
+
+// New creates a client of the Vault at the given address. The prover adds
+// the proofs of presentations and verifies imported presentations, as
+// Transit can only verify signatures of its own keys. The default prover
+// can't verify them.
+func New(addr, token string, prover *credential.Prover, opts ...ClientOption) *Client {
+	if prover == nil {
+		prover = credential.NewProver()
+	}
+
+	c := &Client{
+		Prover:     prover,
+		addr:       strings.TrimSuffix(addr, "/"),
+		token:      token,
+		httpClient: http.DefaultClient,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// transitKey signs data with a version of a Transit key. It implements
+// the signer of linked data signature suites, which don't pass a context.
+type transitKey struct {
+	ctx     context.Context
+	client  *Client
+	mount   string
+	name    string
+	version int
+	alg     string
+}
+
+func (k *transitKey) Sign(data []byte) ([]byte, error) {
+	return k.client.sign(k.ctx, k, data)
+}
+
+func (k *transitKey) Alg() string {
+	return k.alg
+}
+
+// PresentationProof adds proofs to the given presentation and to the
+// credentials without proofs it contains, signed with the latest version
+// of the Transit key identified by namespace and key.
+func (c *Client) PresentationProof(ctx context.Context, issuer, namespace, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
+	k, err := c.transitKey(ctx, issuer, namespace, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.AddProofs(vp, k, verificationMethod(issuer, k))
+}
+
+// SignJWT returns a compact JWT with the given type and claims, signed with
+// the latest version of the Transit key identified by namespace and key.
+func (c *Client) SignJWT(ctx context.Context, issuer, namespace, key, typ string, claims map[string]interface{}) (string, error) {
+	k, err := c.transitKey(ctx, issuer, namespace, key)
+	if err != nil {
+		return "", err
+	}
+
+	return jwtvc.Sign(k, typ, verificationMethod(issuer, k), claims)
+}
+
+// transitKey returns the latest version of the Transit key with the given
+// name. The version is looked up for every export, so that the exports are
+// signed with the new version as soon as the key is rotated.
+func (c *Client) transitKey(ctx context.Context, issuer, mount, name string) (*transitKey, error) {
+	if issuer == "" {
+		return nil, errors.New(errors.BadRequest, "issuer is required for signing")
+	}
+
+	var result struct {
+		Data struct {
+			Type          string `json:"type"`
+			LatestVersion int    `json:"latest_version"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodGet, transitPath(mount, "keys", name), nil, &result); err != nil {
+		return nil, err
+	}
+
+	alg, ok := algorithms[result.Data.Type]
+	if !ok {
+		return nil, errors.New(errors.Internal, fmt.Sprintf("unsupported Transit key type %q", result.Data.Type))
+	}
+
+	return &transitKey{ctx: ctx, client: c, mount: mount, name: name, version: result.Data.LatestVersion, alg: alg}, nil
+}
+
+// sign returns the JWS signature of the data created with the
+// version of the Transit key, which is part of its key ID.
+func (c *Client) sign(ctx context.Context, k *transitKey, data []byte) ([]byte, error) {
+	payload := signParams(k.alg)
+	payload["input"] = base64.StdEncoding.EncodeToString(data)
+	payload["key_version"] = k.version
+
+	var result struct {
+		Data struct {
+			Signature string `json:"signature"`
+		} `json:"data"`
+	}
+	if err := c.do(ctx, http.MethodPost, transitPath(k.mount, "sign", k.name), payload, &result); err != nil {
+		return nil, err
+	}
+
+	// signatures are prefixed with the key version, e.g. "vault:v1:"
+	parts := strings.SplitN(result.Data.Signature, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" {
+		return nil, errors.New("invalid Transit signature")
+	}
+	if parts[1] != "v"+strconv.Itoa(k.version) {
+		return nil, errors.New(fmt.Sprintf("Transit signature of key version %s instead of v%d", parts[1], k.version))
+	}
+
+	var sig []byte
+	var err error
+	if strings.HasPrefix(k.alg, "ES") {
+		sig, err = base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[2], "="))
+	} else {
+		sig, err = base64.StdEncoding.DecodeString(parts[2])
+	}
+	if err != nil {
+		return nil, errors.New("invalid Transit signature encoding", err)
+	}
+
+	return sig, nil
+}
+
+// signParams returns the parameters of Transit sign requests, which create
+// signatures as specified by the JWS algorithm. ECDSA signatures are
+// marshaled like in JWS instead of ASN.1.
+func signParams(alg string) map[string]interface{} {
+	switch alg {
+	case "ES256":
+		return map[string]interface{}{"hash_algorithm": "sha2-256", "marshaling_algorithm": "jws"}
+	case "ES384":
+		return map[string]interface{}{"hash_algorithm": "sha2-384", "marshaling_algorithm": "jws"}
+	case "PS256":
+		return map[string]interface{}{"hash_algorithm": "sha2-256", "signature_algorithm": "pss", "salt_length": "hash"}
+	}
+	return map[string]interface{}{}
+}
+
+func (c *Client) do(ctx context.Context, method, path string, payload interface{}, result interface{}) error {
+	var body io.Reader
+	if payload != nil {
+		payloadJSON, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(payloadJSON)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.addr+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-Vault-Token", c.token)
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return transport.Cause(err)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	// errors of Vault, like an invalid token or a missing key, are errors
+	// of the configuration and not of the export request
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(errors.Internal, fmt.Sprintf("unexpected Vault response: %s: %s", resp.Status, getErrorBody(resp)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return errors.New("error decoding Vault response", err)
+	}
+
+	return nil
+}
+
+// verificationMethod returns the verification method of the Transit key
+// version, which is the key name and version relative to the issuer, so
+// that the versions of rotated keys can be told apart, e.g. "#key1-v2".
+func verificationMethod(issuer string, k *transitKey) string {
+	return fmt.Sprintf("%s#%s-v%d", issuer, k.name, k.version)
+}
+
+func transitPath(mount, endpoint, name string) string {
+	return fmt.Sprintf("/v1/%s/%s/%s", strings.Trim(mount, "/"), endpoint, url.PathEscape(name))
+}
+
+func getErrorBody(resp *http.Response) string {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 2<<20))
+	if err != nil {
+		return ""
+	}
+	return string(body)
+}
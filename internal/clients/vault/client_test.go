@@ -0,0 +1,289 @@
+package vault_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose/jwk"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/clients/vault"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+const (
+	issuerDID = "did:web:issuer.example.com"
+	token     = "s.token"
+)
+
+var testContexts = []string{
+	"https://www.w3.org/2018/credentials/v1",
+	"https://w3id.org/security/suites/jws-2020/v1",
+}
+
+type transitKey struct {
+	typ     string
+	version int
+	priv    crypto.Signer
+}
+
+// transitServer is a stand-in for the Vault Transit secrets engine
+// mounted at "transit", which signs with the given keys. The private
+// key is the key of the latest version, which defaults to 1.
+func transitServer(t *testing.T, keys map[string]transitKey) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/v1/"), "/")
+		k, ok := keys[parts[len(parts)-1]]
+		if len(parts) != 3 || parts[0] != "transit" || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+
+		if k.version == 0 {
+			k.version = 1
+		}
+
+		if parts[1] == "keys" && r.Method == http.MethodGet {
+			writeData(t, w, map[string]interface{}{"type": k.typ, "latest_version": k.version})
+			return
+		}
+		if parts[1] != "sign" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		params := make(map[string]string, len(req))
+		for name, v := range req {
+			params[name] = fmt.Sprint(v)
+		}
+		input, err := base64.StdEncoding.DecodeString(params["input"])
+		require.NoError(t, err)
+		require.Equal(t, strconv.Itoa(k.version), params["key_version"])
+
+		sig := transitSign(t, k, params, input)
+		writeData(t, w, map[string]interface{}{"signature": "vault:v" + params["key_version"] + ":" + sig})
+	}))
+}
+
+func writeData(t *testing.T, w http.ResponseWriter, data map[string]interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": data}))
+}
+
+// transitSign signs like Transit with the parameters of the request.
+func transitSign(t *testing.T, k transitKey, req map[string]string, input []byte) string {
+	switch p := k.priv.(type) {
+	case ed25519.PrivateKey:
+		return base64.StdEncoding.EncodeToString(ed25519.Sign(p, input))
+	case *ecdsa.PrivateKey:
+		require.Equal(t, "jws", req["marshaling_algorithm"])
+		r, s, err := ecdsa.Sign(rand.Reader, p, hash(req["hash_algorithm"], input))
+		require.NoError(t, err)
+		size := (p.Curve.Params().BitSize + 7) / 8
+		sig := make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+		return base64.RawURLEncoding.EncodeToString(sig)
+	case *rsa.PrivateKey:
+		require.Equal(t, "pss", req["signature_algorithm"])
+		require.Equal(t, "hash", req["salt_length"])
+		sig, err := rsa.SignPSS(rand.Reader, p, crypto.SHA256, hash(req["hash_algorithm"], input), &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(sig)
+	}
+	t.Fatalf("unsupported key type %T", k.priv)
+	return ""
+}
+
+func hash(alg string, data []byte) []byte {
+	if alg == "sha2-384" {
+		h := sha512.Sum384(data)
+		return h[:]
+	}
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+// publicKey returns the public key of a private key as key of verifiers.
+func publicKey(priv crypto.Signer) *verifier.PublicKey {
+	pub := &verifier.PublicKey{Type: "JsonWebKey2020", JWK: &jwk.JWK{JSONWebKey: jose.JSONWebKey{Key: priv.Public()}}}
+	switch p := priv.(type) {
+	case ed25519.PrivateKey:
+		pub.JWK.Kty, pub.JWK.Crv = "OKP", "Ed25519"
+	case *ecdsa.PrivateKey:
+		pub.JWK.Kty, pub.JWK.Crv = "EC", p.Curve.Params().Name
+	case *rsa.PrivateKey:
+		// RSA signatures are verified with PKCS#1 encoded keys
+		pub.JWK.Kty, pub.Value = "RSA", x509.MarshalPKCS1PublicKey(&p.PublicKey)
+	}
+	return pub
+}
+
+func TestClient(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	srv := transitServer(t, map[string]transitKey{
+		"ed25519": {typ: "ed25519", priv: edKey},
+		"p256":    {typ: "ecdsa-p256", priv: p256},
+		"p384":    {typ: "ecdsa-p384", priv: p384},
+		"rsa":     {typ: "rsa-2048", priv: rsaKey},
+		"rotated": {typ: "ed25519", version: 3, priv: edKey},
+		"aes":     {typ: "aes256-gcm96"},
+	})
+	defer srv.Close()
+
+	tests := []struct {
+		name      string
+		issuer    string
+		namespace string
+		key       string
+		priv      crypto.Signer
+
+		alg     string
+		kid     string
+		errkind errors.Kind
+		errtext string
+	}{
+		{name: "Ed25519 key", namespace: "transit", key: "ed25519", priv: edKey, alg: "EdDSA", kid: issuerDID + "#ed25519-v1"},
+		{name: "ECDSA P-256 key", namespace: "transit", key: "p256", priv: p256, alg: "ES256", kid: issuerDID + "#p256-v1"},
+		{name: "ECDSA P-384 key", namespace: "/transit/", key: "p384", priv: p384, alg: "ES384", kid: issuerDID + "#p384-v1"},
+		{name: "RSA key", namespace: "transit", key: "rsa", priv: rsaKey, alg: "PS256", kid: issuerDID + "#rsa-v1"},
+		{name: "rotated key", namespace: "transit", key: "rotated", priv: edKey, alg: "EdDSA", kid: issuerDID + "#rotated-v3"},
+		{
+			name:      "key type which can't sign",
+			namespace: "transit",
+			key:       "aes",
+			errkind:   errors.Internal,
+			errtext:   `unsupported Transit key type "aes256-gcm96"`,
+		},
+		{
+			name:      "key not found",
+			namespace: "transit",
+			key:       "missing",
+			errkind:   errors.Internal,
+			errtext:   "unexpected Vault response: 404 Not Found",
+		},
+		{
+			name:      "mount not found",
+			namespace: "secret",
+			key:       "p256",
+			errkind:   errors.Internal,
+			errtext:   "unexpected Vault response: 404 Not Found",
+		},
+		{
+			name:      "issuer is missing",
+			issuer:    "-",
+			namespace: "transit",
+			key:       "p256",
+			errkind:   errors.BadRequest,
+			errtext:   "issuer is required",
+		},
+	}
+
+	client := vault.New(srv.URL+"/", token, nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			issuer := issuerDID
+			if test.issuer == "-" {
+				issuer = ""
+			}
+
+			vc := &verifiable.Credential{
+				Context: testContexts,
+				Types:   []string{verifiable.VCType},
+				Issuer:  verifiable.Issuer{ID: issuerDID},
+				Issued:  util.NewTime(time.Now()),
+				Subject: verifiable.Subject{ID: "did:web:participant.example.com"},
+			}
+			vp, err := verifiable.NewPresentation(verifiable.WithCredentials(vc))
+			require.NoError(t, err)
+			vp.Context = testContexts
+			vp.Holder = issuerDID
+
+			res, err := client.PresentationProof(context.Background(), issuer, test.namespace, test.key, vp)
+			if test.errkind != errors.Unknown {
+				assert.Nil(t, res)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(test.errkind, err))
+				return
+			}
+			require.NoError(t, err)
+
+			proof := res["proof"].(map[string]interface{})
+			assert.Equal(t, "JsonWebSignature2020", proof["type"])
+			assert.Equal(t, test.kid, proof["verificationMethod"])
+
+			keyFetcher := func(issuerID, keyID string) (*verifier.PublicKey, error) {
+				return publicKey(test.priv), nil
+			}
+			vpJSON, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.NoError(t, credential.NewVerifier(keyFetcher, http.DefaultClient).VerifyPresentation(context.Background(), vpJSON))
+
+			tok, err := client.SignJWT(context.Background(), issuer, test.namespace, test.key, jwtvc.TypeJWT, map[string]interface{}{"iss": issuerDID})
+			require.NoError(t, err)
+			jws, err := jose.ParseSigned(tok)
+			require.NoError(t, err)
+			assert.Equal(t, test.alg, jws.Signatures[0].Header.Algorithm)
+			assert.Equal(t, test.kid, jws.Signatures[0].Header.KeyID)
+			payload, err := jws.Verify(test.priv.Public())
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"iss":"`+issuerDID+`"}`, string(payload))
+		})
+	}
+}
+
+func TestClient_Token(t *testing.T) {
+	srv := transitServer(t, map[string]transitKey{})
+	defer srv.Close()
+
+	client := vault.New(srv.URL, "invalid", nil)
+	_, err := client.SignJWT(context.Background(), issuerDID, "transit", "key1", jwtvc.TypeJWT, map[string]interface{}{})
+	require.Error(t, err)
+	// an invalid token is not reported as an error of the caller
+	assert.True(t, errors.Is(errors.Internal, err))
+	assert.Contains(t, err.Error(), "unexpected Vault response: 403 Forbidden")
+	assert.Contains(t, err.Error(), "permission denied")
+}
@@ -0,0 +1,15 @@
+package vault
+
+import (
+	"net/http"
+)
+
+type ClientOption func(*Client)
+
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		if client != nil {
+			c.httpClient = client
+		}
+	}
+}
@@ -9,6 +9,13 @@ type Config struct {
 	Cache      cacheConfig
 	Credential credentialConfig
 	Signer     signerConfig
+	Upstream   upstreamConfig
+	Export     exportConfig
+	Import     importConfig
+	DID        didConfig
+	Trust      trustConfig
+	Schema     schemaConfig
+	Scheduler  schedulerConfig
 	Metrics    metricsConfig
 	OAuth      oauthConfig
 	Auth       authConfig
@@ -38,15 +45,117 @@ type credentialConfig struct {
 }
 
 type policyConfig struct {
-	Addr string `envconfig:"POLICY_ADDR" required:"true"`
+	Addr    string        `envconfig:"POLICY_ADDR" required:"true"`
+	Timeout time.Duration `envconfig:"POLICY_TIMEOUT" default:"10s"`
 }
 
 type cacheConfig struct {
-	Addr string `envconfig:"CACHE_ADDR" required:"true"`
+	Addr    string        `envconfig:"CACHE_ADDR" required:"true"`
+	Timeout time.Duration `envconfig:"CACHE_TIMEOUT" default:"10s"`
 }
 
+// signerConfig selects the backend which signs exports: the Signer
+// service ("remote"), in-process signing with local keys ("local")
+// or Vault Transit keys ("vault").
 type signerConfig struct {
-	Addr string `envconfig:"SIGNER_ADDR" required:"true"`
+	Type string `envconfig:"SIGNER_TYPE" default:"remote"`
+	Addr string `envconfig:"SIGNER_ADDR"`
+	// Timeout limits the requests to the Signer service or to Vault.
+	Timeout time.Duration `envconfig:"SIGNER_TIMEOUT" default:"10s"`
+	// KeysPath is a key file or a directory of key files of the local signer.
+	KeysPath string `envconfig:"SIGNER_KEYS_PATH"`
+	// VaultAddr and VaultToken are used to sign with Vault Transit keys.
+	VaultAddr  string `envconfig:"VAULT_ADDR"`
+	VaultToken string `envconfig:"VAULT_TOKEN"`
+}
+
+// upstreamConfig configures the retries and circuit breakers of the
+// requests to the Policy, Cache and Signer services.
+type upstreamConfig struct {
+	Retries    int           `envconfig:"UPSTREAM_RETRIES" default:"2"`
+	BackoffMin time.Duration `envconfig:"UPSTREAM_BACKOFF_MIN" default:"100ms"`
+	BackoffMax time.Duration `envconfig:"UPSTREAM_BACKOFF_MAX" default:"2s"`
+	// BreakerThreshold is the number of consecutive failures which open
+	// the circuit breaker of an upstream service, 0 disables it.
+	BreakerThreshold int           `envconfig:"UPSTREAM_BREAKER_THRESHOLD" default:"5"`
+	BreakerTimeout   time.Duration `envconfig:"UPSTREAM_BREAKER_TIMEOUT" default:"30s"`
+}
+
+type exportConfig struct {
+	PolicyWorkers int `envconfig:"EXPORT_POLICY_WORKERS" default:"5"`
+	// JobTimeout is the time after which unfinished export jobs
+	// without progress are considered interrupted and failed.
+	JobTimeout time.Duration `envconfig:"EXPORT_JOB_TIMEOUT" default:"10m"`
+}
+
+type importConfig struct {
+	RequiredScope string `envconfig:"IMPORT_REQUIRED_SCOPE"`
+	// AllowedNamespaces maps client IDs to space-separated lists of Cache
+	// namespaces which the clients may import into, e.g.
+	// "client-a:participants services,*:public". The "*" client means all clients.
+	AllowedNamespaces map[string]string `envconfig:"IMPORT_ALLOWED_NAMESPACES"`
+	// Policy validates imported data, unless a more specific policy is
+	// configured for the Cache namespace or the type of the credential.
+	// Policies are formatted as 'group/policy/version'.
+	Policy            string            `envconfig:"IMPORT_POLICY"`
+	NamespacePolicies map[string]string `envconfig:"IMPORT_NAMESPACE_POLICIES"`
+	TypePolicies      map[string]string `envconfig:"IMPORT_TYPE_POLICIES"`
+	// Verifier selects how presentation proofs are verified: by the
+	// Signer service ("signer") or in-process ("local").
+	Verifier string `envconfig:"IMPORT_VERIFIER" default:"signer"`
+	// KeyBindingMaxAge limits the age of the key binding JWTs
+	// of imported SD-JWT credentials.
+	KeyBindingMaxAge time.Duration `envconfig:"IMPORT_KEY_BINDING_MAX_AGE" default:"5m"`
+	// StatusCheck enables checking the revocation and suspension
+	// status of imported credentials with status lists.
+	StatusCheck    bool          `envconfig:"IMPORT_STATUS_CHECK" default:"true"`
+	StatusCacheTTL time.Duration `envconfig:"IMPORT_STATUS_CACHE_TTL" default:"5m"`
+	// StatusFailOpen accepts credentials whose status list cannot be
+	// fetched and credentials with unsupported status types.
+	StatusFailOpen bool `envconfig:"IMPORT_STATUS_FAIL_OPEN" default:"false"`
+	// ChallengeRequired makes imported presentations require a challenge
+	// issued by GET /v1/import/challenge and the configured domain.
+	ChallengeRequired bool          `envconfig:"IMPORT_CHALLENGE_REQUIRED" default:"false"`
+	ChallengeDomain   string        `envconfig:"IMPORT_CHALLENGE_DOMAIN"`
+	ChallengeTTL      time.Duration `envconfig:"IMPORT_CHALLENGE_TTL" default:"5m"`
+	// SchemaValidation validates imported credentials with the
+	// JSON schemas referenced by their credentialSchema property.
+	SchemaValidation bool `envconfig:"IMPORT_SCHEMA_VALIDATION" default:"false"`
+	// HashAlgorithm selects the canonicalization of imported credentials
+	// whose hashes detect duplicate imports: "jcs" or "urdna2015".
+	HashAlgorithm string `envconfig:"IMPORT_HASH_ALGORITHM" default:"jcs"`
+}
+
+type didConfig struct {
+	ResolverURL      string        `envconfig:"DID_RESOLVER_URL"`
+	CacheTTL         time.Duration `envconfig:"DID_CACHE_TTL" default:"1h"`
+	NegativeCacheTTL time.Duration `envconfig:"DID_NEGATIVE_CACHE_TTL" default:"1m"`
+	// WebKeyURLs are the URLs of issuers which aren't DIDs, from
+	// which keys may be fetched, e.g. "http://signer:8080/v1/keys".
+	WebKeyURLs []string `envconfig:"WEB_KEY_URLS"`
+}
+
+// trustConfig configures the trust anchors of imported credentials. Any
+// issuer is trusted, unless at least one source of trust anchors is given.
+type trustConfig struct {
+	Issuers         []string      `envconfig:"TRUSTED_ISSUERS"`
+	Mongo           bool          `envconfig:"TRUSTED_ISSUERS_MONGO" default:"false"`
+	ListSource      string        `envconfig:"TRUST_LIST_SOURCE"` // file path or URL
+	RefreshInterval time.Duration `envconfig:"TRUST_LIST_REFRESH_INTERVAL" default:"1h"`
+}
+
+// schemaConfig configures the sources of the JSON schemas which validate
+// imported credentials and export data.
+type schemaConfig struct {
+	Dir      string        `envconfig:"SCHEMA_DIR"` // directory of JSON schema files
+	Mongo    bool          `envconfig:"SCHEMA_MONGO" default:"false"`
+	CacheTTL time.Duration `envconfig:"SCHEMA_CACHE_TTL" default:"1h"`
+}
+
+type schedulerConfig struct {
+	Enabled  bool          `envconfig:"SCHEDULER_ENABLED" default:"true"`
+	Interval time.Duration `envconfig:"SCHEDULER_INTERVAL" default:"30s"`
+	LeaseTTL time.Duration `envconfig:"SCHEDULER_LEASE_TTL" default:"5m"`
 }
 
 type metricsConfig struct {
@@ -63,4 +172,6 @@ type authConfig struct {
 	Enabled         bool          `envconfig:"AUTH_ENABLED" default:"false"`
 	JwkURL          string        `envconfig:"AUTH_JWK_URL"`
 	RefreshInterval time.Duration `envconfig:"AUTH_REFRESH_INTERVAL" default:"1h"`
+	// AdminScope is the scope required for managing export configurations.
+	AdminScope string `envconfig:"AUTH_ADMIN_SCOPE" default:"infohub:admin"`
 }
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
-	"github.com/piprate/json-gold/ld"
 )
 
 var defaultContexts = []string{
@@ -17,49 +16,78 @@ var defaultContexts = []string{
 
 type Credentials struct {
 	issuerURI  string
-	docLoader  *ld.CachingDocumentLoader
+	docLoader  *DocumentLoader
 	httpClient *http.Client
 }
 
-func New(issuerURI string, httpClient *http.Client) *Credentials {
-	loader := ld.NewDefaultDocumentLoader(httpClient)
+// CredentialOption customizes the credentials created with NewCredential.
+type CredentialOption func(*verifiable.Credential)
+
+// WithIssuer sets the issuer of the credential instead of the
+// default issuer URI.
+func WithIssuer(issuer string) CredentialOption {
+	return func(vc *verifiable.Credential) {
+		if issuer != "" {
+			vc.Issuer = verifiable.Issuer{ID: issuer}
+		}
+	}
+}
+
+// WithTypes adds the given types to the VerifiableCredential type.
+func WithTypes(types ...string) CredentialOption {
+	return func(vc *verifiable.Credential) {
+		vc.Types = mergeUnique(vc.Types, types)
+	}
+}
 
+// WithSchema sets the credentialSchema of the credential.
+func WithSchema(id, schemaType string) CredentialOption {
+	return func(vc *verifiable.Credential) {
+		vc.Schemas = append(vc.Schemas, verifiable.TypedID{ID: id, Type: schemaType})
+	}
+}
+
+// WithExpiration sets the expirationDate of the credential.
+func WithExpiration(expires time.Time) CredentialOption {
+	return func(vc *verifiable.Credential) {
+		vc.Expired = &util.TimeWrapper{Time: expires}
+	}
+}
+
+func New(issuerURI string, httpClient *http.Client) *Credentials {
 	return &Credentials{
 		issuerURI:  issuerURI,
-		docLoader:  ld.NewCachingDocumentLoader(loader),
+		docLoader:  NewDocumentLoader(httpClient),
 		httpClient: httpClient,
 	}
 }
 
 // NewCredential creates a Verifiable Credential without proofs.
-func (c *Credentials) NewCredential(contexts []string, subjectID string, subject map[string]interface{}, proof bool) (*verifiable.Credential, error) { //nolint:revive
-	jsonldContexts := defaultContexts
-	jsonldContexts = append(jsonldContexts, contexts...)
-
+// The given contexts are added to the default JSON-LD contexts.
+func (c *Credentials) NewCredential(contexts []string, subject map[string]interface{}, opts ...CredentialOption) (*verifiable.Credential, error) {
 	vc := &verifiable.Credential{
-		Context: jsonldContexts,
+		Context: mergeUnique(defaultContexts, contexts),
 		Types:   []string{verifiable.VCType},
 		Issuer:  verifiable.Issuer{ID: c.issuerURI},
 		Issued:  &util.TimeWrapper{Time: time.Now()},
-		Subject: verifiable.Subject{
-			//ID:           subjectID,
-			CustomFields: subject,
-		},
+		Subject: newSubject(subject),
+	}
+
+	for _, opt := range opts {
+		opt(vc)
 	}
 
 	return vc, nil
 }
 
 // NewPresentation creates a Verifiable Presentation without proofs.
+// The given contexts are added to the default JSON-LD contexts.
 func (c *Credentials) NewPresentation(contexts []string, vc ...*verifiable.Credential) (*verifiable.Presentation, error) {
-	jsonldContexts := defaultContexts
-	jsonldContexts = append(jsonldContexts, contexts...)
-
 	vp, err := verifiable.NewPresentation(verifiable.WithCredentials(vc...))
 	if err != nil {
 		return nil, err
 	}
-	vp.Context = jsonldContexts
+	vp.Context = mergeUnique(defaultContexts, contexts)
 	vp.ID = c.issuerURI
 	vp.Type = []string{verifiable.VPType}
 
@@ -75,3 +103,44 @@ func (c *Credentials) ParsePresentation(vpBytes []byte) (*verifiable.Presentatio
 		verifiable.WithPresStrictValidation(),
 	)
 }
+
+// newSubject creates a credential subject with the given fields.
+// If the fields contain an "id", it is used as subject ID.
+func newSubject(fields map[string]interface{}) verifiable.Subject {
+	subject := verifiable.Subject{CustomFields: make(verifiable.CustomFields, len(fields))}
+	for k, v := range fields {
+		if id, ok := v.(string); ok && k == "id" {
+			subject.ID = id
+			continue
+		}
+		subject.CustomFields[k] = v
+	}
+	return subject
+}
+
+// mergeUnique returns a new slice with the values of a followed by
+// the values of b which are not already present.
+func mergeUnique(a, b []string) []string {
+	res := make([]string, 0, len(a)+len(b))
+	seen := make(map[string]bool, len(a)+len(b))
+	for _, v := range append(append([]string{}, a...), b...) {
+		if !seen[v] {
+			seen[v] = true
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Issuer returns the id of the issuer of a credential, which
+// is given as string or as object with an id.
+func Issuer(cred map[string]interface{}) string {
+	switch issuer := cred["issuer"].(type) {
+	case string:
+		return issuer
+	case map[string]interface{}:
+		id, _ := issuer["id"].(string)
+		return id
+	}
+	return ""
+}
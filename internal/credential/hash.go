@@ -0,0 +1,226 @@
+package credential
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/piprate/json-gold/ld"
+)
+
+// Canonicalization algorithms of credential hashes.
+const (
+	// CanonicalizationJCS serializes credentials with the JSON
+	// Canonicalization Scheme (RFC 8785).
+	CanonicalizationJCS = "jcs"
+	// CanonicalizationURDNA2015 normalizes credentials as RDF datasets,
+	// so hashes don't depend on the JSON-LD form of the credentials.
+	CanonicalizationURDNA2015 = "urdna2015"
+)
+
+// Hasher computes the hashes of credentials, which identify credentials
+// with the same content. The zero value uses the JSON Canonicalization Scheme.
+type Hasher struct {
+	algorithm string
+	docLoader *DocumentLoader
+}
+
+func NewHasher(algorithm string, httpClient *http.Client) (*Hasher, error) {
+	switch algorithm {
+	case CanonicalizationJCS:
+		return &Hasher{algorithm: algorithm}, nil
+	case CanonicalizationURDNA2015:
+		return &Hasher{algorithm: algorithm, docLoader: NewDocumentLoader(httpClient)}, nil
+	default:
+		return nil, fmt.Errorf("unknown canonicalization algorithm: %s", algorithm)
+	}
+}
+
+// Hash returns the hex encoded SHA-256 hash of the canonical
+// form of the credential.
+func (h *Hasher) Hash(cred map[string]interface{}) (string, error) {
+	var (
+		canonical []byte
+		err       error
+	)
+	if h.algorithm == CanonicalizationURDNA2015 {
+		canonical, err = h.normalize(cred)
+	} else {
+		canonical, err = CanonicalJSON(cred)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(canonical)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (h *Hasher) normalize(cred map[string]interface{}) ([]byte, error) {
+	opts := ld.NewJsonLdOptions("")
+	opts.Algorithm = ld.AlgorithmURDNA2015
+	opts.Format = "application/n-quads"
+	opts.ProduceGeneralizedRdf = true
+	opts.DocumentLoader = h.docLoader
+
+	normalized, err := ld.NewJsonLdProcessor().Normalize(cred, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error normalizing credential: %w", err)
+	}
+
+	s, ok := normalized.(string)
+	if !ok || s == "" {
+		return nil, fmt.Errorf("credential is normalized to an empty dataset")
+	}
+
+	return []byte(s), nil
+}
+
+// CanonicalJSON serializes a decoded JSON value with the JSON
+// Canonicalization Scheme (RFC 8785).
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case string:
+		writeCanonicalString(buf, v)
+	case float64:
+		s, err := canonicalNumber(v)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return err
+		}
+		return writeCanonical(buf, f)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, e); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		// properties are sorted by their UTF-16 code units
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return lessUTF16(keys[i], keys[j])
+		})
+
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, k)
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, v[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		// values of other types are converted to their JSON representation
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		var decoded interface{}
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			return err
+		}
+		return writeCanonical(buf, decoded)
+	}
+	return nil
+}
+
+// canonicalNumber formats a number like ECMAScript Number.prototype.toString.
+func canonicalNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("invalid number: %v", f)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+
+	abs := math.Abs(f)
+	if abs >= 1e21 || abs < 1e-6 {
+		// ECMAScript exponents have a sign and no leading zeros, e.g. 1e-7 instead of 1e-07
+		s := strconv.FormatFloat(f, 'e', -1, 64)
+		i := strings.LastIndexByte(s, 'e')
+		exp := strings.TrimLeft(s[i+2:], "0")
+		return s[:i+2] + exp, nil
+	}
+	return strconv.FormatFloat(f, 'f', -1, 64), nil
+}
+
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	const hex = "0123456789abcdef"
+
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				buf.WriteString(`\u00`)
+				buf.WriteByte(hex[r>>4])
+				buf.WriteByte(hex[r&0xf])
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+func lessUTF16(a, b string) bool {
+	ua, ub := utf16.Encode([]rune(a)), utf16.Encode([]rune(b))
+	for i := 0; i < len(ua) && i < len(ub); i++ {
+		if ua[i] != ub[i] {
+			return ua[i] < ub[i]
+		}
+	}
+	return len(ua) < len(ub)
+}
@@ -0,0 +1,76 @@
+package credential_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		canonical string
+	}{
+		{
+			name:      "whitespace is removed",
+			json:      `{ "a" : [ 1, true, null ] }`,
+			canonical: `{"a":[1,true,null]}`,
+		},
+		{
+			name:      "properties are sorted by UTF-16 code units",
+			json:      `{"\u20ac":"Euro","\r":"CR","\ufb33":"Hebrew","1":"One","\ud83d\ude00":"Smiley","\u0080":"Control","\u00f6":"Latin"}`,
+			canonical: "{\"\\r\":\"CR\",\"1\":\"One\",\"\u0080\":\"Control\",\"\u00f6\":\"Latin\",\"\u20ac\":\"Euro\",\"\U0001F600\":\"Smiley\",\"\ufb33\":\"Hebrew\"}",
+		},
+		{
+			name:      "numbers are serialized like ECMAScript",
+			json:      `[333333333.33333329, 1E30, 4.50, 2e-3, 0.000000000000000000000000001, -0, 1e-7, 100]`,
+			canonical: `[333333333.3333333,1e+30,4.5,0.002,1e-27,0,1e-7,100]`,
+		},
+		{
+			name:      "only required characters are escaped",
+			json:      `"\u20ac$\u000F\u000aA'\u0042\u0022\u005c\\\"\/<>&"`,
+			canonical: "\"\u20ac$\\u000f\\nA'B\\\"\\\\\\\\\\\"/<>&\"",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var v interface{}
+			require.NoError(t, json.Unmarshal([]byte(test.json), &v))
+
+			canonical, err := credential.CanonicalJSON(v)
+			require.NoError(t, err)
+			assert.Equal(t, test.canonical, string(canonical))
+		})
+	}
+}
+
+func TestHasher_Hash(t *testing.T) {
+	decode := func(s string) map[string]interface{} {
+		var m map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(s), &m))
+		return m
+	}
+
+	hasher, err := credential.NewHasher(credential.CanonicalizationJCS, nil)
+	require.NoError(t, err)
+
+	hash1, err := hasher.Hash(decode(`{"id":"urn:uuid:1","credentialSubject":{"name":"a","age":1}}`))
+	require.NoError(t, err)
+	hash2, err := hasher.Hash(decode(`{"credentialSubject":{"age":1.0,"name":"a"},"id":"urn:uuid:1"}`))
+	require.NoError(t, err)
+	hash3, err := hasher.Hash(decode(`{"id":"urn:uuid:1","credentialSubject":{"name":"b","age":1}}`))
+	require.NoError(t, err)
+
+	assert.Len(t, hash1, 64)
+	assert.Equal(t, hash1, hash2)
+	assert.NotEqual(t, hash1, hash3)
+
+	_, err = credential.NewHasher("md5", nil)
+	assert.Error(t, err)
+}
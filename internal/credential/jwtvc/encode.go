@@ -0,0 +1,263 @@
+package jwtvc
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+)
+
+// Types of the JWTs of exported presentations and credentials.
+const (
+	TypeJWT   = "JWT"
+	TypeSDJWT = "vc+sd-jwt"
+)
+
+// Algorithm returns the JWS algorithm of the signatures created with the
+// private key of the given public key. RSA keys sign with RSASSA-PSS.
+func Algorithm(pub crypto.PublicKey) (string, error) {
+	switch k := pub.(type) {
+	case ed25519.PublicKey:
+		return "EdDSA", nil
+	case *ecdsa.PublicKey:
+		switch k.Curve.Params().Name {
+		case "P-256":
+			return "ES256", nil
+		case "P-384":
+			return "ES384", nil
+		case "P-521":
+			return "ES512", nil
+		}
+		return "", fmt.Errorf("unsupported curve: %s", k.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return "PS256", nil
+	}
+	return "", fmt.Errorf("unsupported key type: %T", pub)
+}
+
+// Signer creates JWS signatures with the algorithm it returns.
+type Signer interface {
+	Sign(data []byte) ([]byte, error)
+	Alg() string
+}
+
+// Sign returns a compact JWT with the given type, key ID and claims,
+// signed by the signer.
+func Sign(signer Signer, typ, kid string, claims map[string]interface{}) (string, error) {
+	input, err := SigningInput(map[string]interface{}{"alg": signer.Alg(), "typ": typ, "kid": kid}, claims)
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := signer.Sign([]byte(input))
+	if err != nil {
+		return "", fmt.Errorf("error signing JWT: %w", err)
+	}
+
+	return Compact(input, signer.Alg(), sig)
+}
+
+// SigningInput returns the JWS signing input of a JWT
+// with the given header and claims.
+func SigningInput(header, claims map[string]interface{}) (string, error) {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON), nil
+}
+
+// Compact returns the compact JWS of the signing input and its signature.
+// ECDSA signatures encoded as ASN.1 DER are converted to the fixed-size
+// encoding of JWS.
+func Compact(input, alg string, sig []byte) (string, error) {
+	size := map[string]int{"ES256": 32, "ES384": 48, "ES512": 66}[alg]
+	if size > 0 && len(sig) != 2*size {
+		var der struct{ R, S *big.Int }
+		if _, err := asn1.Unmarshal(sig, &der); err != nil {
+			return "", fmt.Errorf("invalid %s signature: %w", alg, err)
+		}
+		sig = make([]byte, 2*size)
+		der.R.FillBytes(sig[:size])
+		der.S.FillBytes(sig[size:])
+	}
+	return input + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+// CredentialClaims returns the claims of a VC-JWT of VC Data Model 1.1
+// encoding the given credential.
+func CredentialClaims(cred map[string]interface{}) map[string]interface{} {
+	claims := map[string]interface{}{"vc": cred}
+	setClaim(claims, "iss", issuerClaim(cred))
+	setClaim(claims, "jti", id(cred["id"]))
+	setClaim(claims, "sub", id(cred["credentialSubject"]))
+	setTimeClaim(claims, "nbf", cred["issuanceDate"])
+	setTimeClaim(claims, "exp", cred["expirationDate"])
+	return claims
+}
+
+// PresentationClaims returns the claims of a VP-JWT of VC Data Model 1.1
+// encoding the given presentation with the given JWT credentials.
+func PresentationClaims(vp map[string]interface{}, credentials []string) map[string]interface{} {
+	vp = copyMap(vp)
+	vp["verifiableCredential"] = credentials
+	delete(vp, "proof")
+
+	claims := map[string]interface{}{
+		"vp":  vp,
+		"iat": time.Now().Unix(),
+	}
+	setClaim(claims, "iss", id(vp["holder"]))
+	setClaim(claims, "jti", id(vp["id"]))
+	return claims
+}
+
+// SDJWTClaims returns the claims of an SD-JWT VC encoding the given
+// credential with the given type. The claims of the credential subject
+// are the claims of the SD-JWT, so subject claims which collide with the
+// registered claims of SD-JWTs are rejected.
+func SDJWTClaims(cred map[string]interface{}, vct string) (map[string]interface{}, error) {
+	claims := make(map[string]interface{})
+	if subject, ok := cred["credentialSubject"].(map[string]interface{}); ok {
+		for k, v := range subject {
+			claims[k] = v
+		}
+	}
+	delete(claims, "id")
+
+	for _, c := range append(sdJWTClaims, "_sd") {
+		if _, ok := claims[c]; ok {
+			return nil, fmt.Errorf("credential subject contains registered SD-JWT claim %q", c)
+		}
+	}
+
+	claims["vct"] = vct
+	setClaim(claims, "iss", issuerClaim(cred))
+	setClaim(claims, "sub", id(cred["credentialSubject"]))
+	setClaim(claims, "jti", id(cred["id"]))
+	setTimeClaim(claims, "iat", cred["issuanceDate"])
+	setTimeClaim(claims, "exp", cred["expirationDate"])
+	return claims, nil
+}
+
+// SelectiveDisclosure makes the claims with the given paths selectively
+// disclosable. Nested claims are given as dot separated paths, in which
+// claim names may contain dots, like the policy names of merged export
+// results. The claims are replaced with the digests of their disclosures,
+// which are returned. Paths of claims which don't exist are ignored.
+func SelectiveDisclosure(claims map[string]interface{}, paths []string) ([]string, error) {
+	type claim struct {
+		parent map[string]interface{}
+		name   string
+		depth  int
+	}
+
+	var selected []claim
+	for _, path := range paths {
+		if parent, name, depth, ok := lookup(claims, path, 0); ok {
+			selected = append(selected, claim{parent: parent, name: name, depth: depth})
+		}
+	}
+
+	// nested claims are disclosed before their parents,
+	// so that their digests are part of the parent disclosures
+	sort.SliceStable(selected, func(i, j int) bool { return selected[i].depth > selected[j].depth })
+
+	var disclosures []string
+	for _, c := range selected {
+		value, ok := c.parent[c.name]
+		if !ok {
+			continue
+		}
+
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal([]interface{}{base64.RawURLEncoding.EncodeToString(salt), c.name, value})
+		if err != nil {
+			return nil, err
+		}
+		disclosure := base64.RawURLEncoding.EncodeToString(data)
+		h := sha256.Sum256([]byte(disclosure))
+
+		digests, _ := c.parent["_sd"].([]interface{})
+		digests = append(digests, base64.RawURLEncoding.EncodeToString(h[:]))
+		// digests are sorted, so that their order doesn't reveal the claims
+		sort.Slice(digests, func(i, j int) bool { return digests[i].(string) < digests[j].(string) })
+		c.parent["_sd"] = digests
+		delete(c.parent, c.name)
+
+		disclosures = append(disclosures, disclosure)
+	}
+
+	if len(disclosures) > 0 {
+		claims["_sd_alg"] = "sha-256"
+	}
+	return disclosures, nil
+}
+
+// lookup returns the object containing the claim with the given path
+// and the name and depth of the claim. The longest claim name matching
+// the path is used on every level.
+func lookup(obj map[string]interface{}, path string, depth int) (map[string]interface{}, string, int, bool) {
+	if _, ok := obj[path]; ok {
+		return obj, path, depth, true
+	}
+
+	var name string
+	for k := range obj {
+		if strings.HasPrefix(path, k+".") && len(k) > len(name) {
+			name = k
+		}
+	}
+	child, ok := obj[name].(map[string]interface{})
+	if name == "" || !ok {
+		return nil, "", 0, false
+	}
+	return lookup(child, strings.TrimPrefix(path, name+"."), depth+1)
+}
+
+// VCType returns the type of an SD-JWT VC for a credential with the given
+// types, which is the first type other than VerifiableCredential.
+func VCType(types []string) string {
+	for _, t := range types {
+		if t != verifiable.VCType {
+			return t
+		}
+	}
+	return ""
+}
+
+func setClaim(claims map[string]interface{}, name, value string) {
+	if value != "" {
+		claims[name] = value
+	}
+}
+
+// setTimeClaim sets a NumericDate claim of a credential date.
+func setTimeClaim(claims map[string]interface{}, name string, value interface{}) {
+	s, ok := value.(string)
+	if !ok {
+		return
+	}
+	if t, err := time.Parse(time.RFC3339Nano, s); err == nil {
+		claims[name] = t.Unix()
+	}
+}
@@ -0,0 +1,206 @@
+package jwtvc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"strings"
+	"testing"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+// signJWT returns a JWT signed with the key pair
+// using the encoding functions of the package.
+func (k *keyPair) signJWT(t *testing.T, typ string, claims map[string]interface{}) string {
+	input, err := jwtvc.SigningInput(map[string]interface{}{"alg": "EdDSA", "typ": typ, "kid": "#key-1"}, claims)
+	require.NoError(t, err)
+	tok, err := jwtvc.Compact(input, "EdDSA", ed25519.Sign(k.priv, []byte(input)))
+	require.NoError(t, err)
+	return tok
+}
+
+func TestAlgorithm(t *testing.T) {
+	edKey, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		key     interface{}
+		alg     string
+		errtext string
+	}{
+		{key: edKey, alg: "EdDSA"},
+		{key: &p256.PublicKey, alg: "ES256"},
+		{key: &p384.PublicKey, alg: "ES384"},
+		{key: &rsaKey.PublicKey, alg: "PS256"},
+		{key: "secret", errtext: "unsupported key type: string"},
+	}
+
+	for _, test := range tests {
+		alg, err := jwtvc.Algorithm(test.key)
+		if test.errtext != "" {
+			assert.ErrorContains(t, err, test.errtext)
+			continue
+		}
+		assert.NoError(t, err)
+		assert.Equal(t, test.alg, alg)
+	}
+}
+
+func TestCompact_ECDSA(t *testing.T) {
+	for _, curve := range []elliptic.Curve{elliptic.P256(), elliptic.P384()} {
+		t.Run(curve.Params().Name, func(t *testing.T) {
+			key, err := ecdsa.GenerateKey(curve, rand.Reader)
+			require.NoError(t, err)
+			alg, err := jwtvc.Algorithm(&key.PublicKey)
+			require.NoError(t, err)
+
+			input, err := jwtvc.SigningInput(map[string]interface{}{"alg": alg}, map[string]interface{}{"iss": issuerDID})
+			require.NoError(t, err)
+
+			var hash []byte
+			if alg == "ES256" {
+				h := sha256.Sum256([]byte(input))
+				hash = h[:]
+			} else {
+				h := sha512.Sum384([]byte(input))
+				hash = h[:]
+			}
+			// signatures in ASN.1 DER are converted
+			sig, err := ecdsa.SignASN1(rand.Reader, key, hash)
+			require.NoError(t, err)
+
+			tok, err := jwtvc.Compact(input, alg, sig)
+			require.NoError(t, err)
+
+			jws, err := jose.ParseSigned(tok)
+			require.NoError(t, err)
+			_, err = jws.Verify(&key.PublicKey)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPresentationClaims(t *testing.T) {
+	issuer := newKeyPair(t)
+	parser := jwtvc.NewParser(keyFetcher(map[string]*keyPair{issuerDID: issuer}))
+
+	cred := map[string]interface{}{
+		"@context":          []interface{}{"https://www.w3.org/2018/credentials/v1"},
+		"type":              []interface{}{"VerifiableCredential", "ComplianceCredential"},
+		"issuer":            issuerDID,
+		"issuanceDate":      "2024-01-01T00:00:00Z",
+		"expirationDate":    "2124-01-01T00:00:00Z",
+		"credentialSubject": map[string]interface{}{"id": "did:web:subject.example.com", "allow": true},
+	}
+	vcJWT := issuer.signJWT(t, jwtvc.TypeJWT, jwtvc.CredentialClaims(cred))
+
+	vp := map[string]interface{}{
+		"@context":             []interface{}{"https://www.w3.org/2018/credentials/v1"},
+		"type":                 []interface{}{"VerifiablePresentation"},
+		"holder":               issuerDID,
+		"verifiableCredential": []interface{}{cred},
+	}
+	vpJWT := issuer.signJWT(t, jwtvc.TypeJWT, jwtvc.PresentationClaims(vp, []string{vcJWT}))
+
+	res, err := parser.ParsePresentation(context.Background(), []byte(vpJWT))
+	require.NoError(t, err)
+	assert.Equal(t, issuerDID, res.Holder)
+	require.Len(t, res.Credentials, 1)
+	assert.Equal(t, issuerDID, res.Credentials[0]["issuer"])
+	assert.Equal(t, "2124-01-01T00:00:00Z", res.Credentials[0]["expirationDate"])
+	assert.Equal(t, map[string]interface{}{"id": "did:web:subject.example.com", "allow": true}, res.Credentials[0]["credentialSubject"])
+}
+
+func TestSDJWTClaims_RegisteredClaims(t *testing.T) {
+	for _, claim := range []string{"iss", "exp", "vct", "cnf", "status", "_sd"} {
+		t.Run(claim, func(t *testing.T) {
+			cred := map[string]interface{}{
+				"issuer":            issuerDID,
+				"credentialSubject": map[string]interface{}{"id": "did:web:subject.example.com", claim: "value"},
+			}
+			claims, err := jwtvc.SDJWTClaims(cred, "https://credentials.example.com/compliance")
+			assert.Nil(t, claims)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), `credential subject contains registered SD-JWT claim "`+claim+`"`)
+		})
+	}
+}
+
+func TestSelectiveDisclosure(t *testing.T) {
+	issuer := newKeyPair(t)
+	parser := jwtvc.NewParser(keyFetcher(map[string]*keyPair{issuerDID: issuer}))
+
+	cred := map[string]interface{}{
+		"type":         []interface{}{"VerifiableCredential", "https://credentials.example.com/compliance"},
+		"issuer":       issuerDID,
+		"issuanceDate": "2024-01-01T00:00:00Z",
+		"credentialSubject": map[string]interface{}{
+			"id":      "did:web:subject.example.com",
+			"name":    "Example",
+			"address": map[string]interface{}{"city": "Berlin", "country": "DE"},
+		},
+	}
+	claims, err := jwtvc.SDJWTClaims(cred, jwtvc.VCType([]string{"VerifiableCredential", "https://credentials.example.com/compliance"}))
+	require.NoError(t, err)
+	disclosures, err := jwtvc.SelectiveDisclosure(claims, []string{"address", "address.city", "missing.claim"})
+	require.NoError(t, err)
+	require.Len(t, disclosures, 2)
+	assert.NotContains(t, claims, "address")
+	assert.Equal(t, "Example", claims["name"])
+
+	sdJWT := issuer.signJWT(t, jwtvc.TypeSDJWT, claims)
+
+	t.Run("all claims disclosed", func(t *testing.T) {
+		res, err := parser.ParseCredential(context.Background(), sdJWT+"~"+strings.Join(disclosures, "~")+"~")
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{"VerifiableCredential", "https://credentials.example.com/compliance"}, res["type"])
+		assert.Equal(t, "2024-01-01T00:00:00Z", res["issuanceDate"])
+		assert.Equal(t, map[string]interface{}{
+			"id":      "did:web:subject.example.com",
+			"name":    "Example",
+			"address": map[string]interface{}{"city": "Berlin", "country": "DE"},
+		}, res["credentialSubject"])
+	})
+
+	t.Run("nested claim not disclosed", func(t *testing.T) {
+		// the disclosure of the nested claim comes first
+		res, err := parser.ParseCredential(context.Background(), sdJWT+"~"+disclosures[1]+"~")
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"id":      "did:web:subject.example.com",
+			"name":    "Example",
+			"address": map[string]interface{}{"country": "DE"},
+		}, res["credentialSubject"])
+	})
+}
+
+func TestSelectiveDisclosure_DottedClaimNames(t *testing.T) {
+	claims := map[string]interface{}{
+		"test/a/1.0": map[string]interface{}{"city": "Berlin", "country": "DE"},
+	}
+	disclosures, err := jwtvc.SelectiveDisclosure(claims, []string{"test/a/1.0.city", "test/b/1.0.city"})
+	require.NoError(t, err)
+	require.Len(t, disclosures, 1)
+	assert.Equal(t, "sha-256", claims["_sd_alg"])
+
+	result := claims["test/a/1.0"].(map[string]interface{})
+	assert.Equal(t, "DE", result["country"])
+	assert.NotContains(t, result, "city")
+	assert.Len(t, result["_sd"], 1)
+}
@@ -0,0 +1,441 @@
+// Package jwtvc parses and verifies JWT encoded presentations and
+// credentials: VC-JWT (VC Data Model 1.1 and 2.0) and SD-JWT VC with
+// selective disclosures and key binding. Credentials are normalised into
+// the JSON-LD data model, so that they can be handled like JSON-LD
+// credentials.
+package jwtvc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// Media types of JWT encoded presentations and credentials.
+const (
+	MediaTypeVPJWT   = "application/vp+jwt"
+	MediaTypeVCJWT   = "application/vc+jwt"
+	MediaTypeVCSDJWT = "application/vc+sd-jwt"
+	MediaTypeDCSDJWT = "application/dc+sd-jwt"
+)
+
+// EnvelopedType is the type of credentials which are embedded in
+// JSON-LD presentations as data URLs.
+const EnvelopedType = "EnvelopedVerifiableCredential"
+
+const (
+	defaultKeyBindingMaxAge = 5 * time.Minute
+
+	// clockSkew is tolerated between the clocks of the holder and the service.
+	clockSkew = time.Minute
+)
+
+// compactJWT matches compact JWS and SD-JWT serializations.
+var compactJWT = regexp.MustCompile(`^[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*(~[A-Za-z0-9_.-]*)*$`)
+
+// Presentation contains the verified credentials of a JWT encoded
+// presentation, or of a single JWT encoded credential.
+type Presentation struct {
+	Holder      string
+	Credentials []map[string]interface{}
+
+	// Nonce and Audience bind the presentation to a challenge and domain
+	// of the verifier. They are taken from the presentation JWT or from
+	// the key binding JWT of a single SD-JWT credential.
+	Nonce    string
+	Audience string
+}
+
+// Parser parses JWT encoded presentations and credentials and verifies their
+// signatures with the keys of their issuers retrieved with the key fetcher.
+type Parser struct {
+	keyFetcher       verifiable.PublicKeyFetcher
+	keyBindingMaxAge time.Duration
+}
+
+type Option func(*Parser)
+
+// WithKeyBindingMaxAge limits the age of the key binding JWTs of SD-JWT credentials.
+func WithKeyBindingMaxAge(d time.Duration) Option {
+	return func(p *Parser) {
+		if d > 0 {
+			p.keyBindingMaxAge = d
+		}
+	}
+}
+
+func NewParser(keyFetcher verifiable.PublicKeyFetcher, opts ...Option) *Parser {
+	p := &Parser{
+		keyFetcher:       keyFetcher,
+		keyBindingMaxAge: defaultKeyBindingMaxAge,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// IsJWT reports whether the data is a compact JWT or SD-JWT,
+// which may be given as JSON string.
+func IsJWT(data []byte) bool {
+	return compactJWT.MatchString(token(data))
+}
+
+// IsEnveloped reports whether a credential of a JSON-LD presentation is JWT
+// encoded, i.e. it is a compact JWT or an EnvelopedVerifiableCredential.
+func IsEnveloped(cred interface{}) bool {
+	switch c := cred.(type) {
+	case string:
+		return IsJWT([]byte(c))
+	case map[string]interface{}:
+		return hasType(c, EnvelopedType)
+	}
+	return false
+}
+
+// ParsePresentation parses and verifies a VP-JWT with the credentials it
+// contains. A single VC-JWT or SD-JWT credential is returned as presentation
+// without holder.
+func (p *Parser) ParsePresentation(ctx context.Context, data []byte) (*Presentation, error) {
+	tok := token(data)
+	if !compactJWT.MatchString(tok) {
+		return nil, errors.New(errors.BadRequest, "data is not a JWT")
+	}
+
+	if strings.Contains(tok, "~") {
+		cred, kb, err := p.parseSDJWT(tok)
+		if err != nil {
+			return nil, err
+		}
+
+		res := &Presentation{Credentials: []map[string]interface{}{cred}}
+		if kb != nil {
+			res.Nonce, res.Audience = kb.nonce, kb.audience
+		}
+		return res, nil
+	}
+
+	typ, claims, err := p.verify(tok)
+	if err != nil {
+		return nil, err
+	}
+
+	vp, ok := claims["vp"].(map[string]interface{})
+	if !ok {
+		if typ != "vp+jwt" && !hasType(claims, verifiable.VPType) {
+			cred, err := normalizeJWT(claims)
+			if err != nil {
+				return nil, err
+			}
+			return &Presentation{Credentials: []map[string]interface{}{cred}}, nil
+		}
+		// presentations of VC Data Model 2.0 are the JWT payload
+		vp = claims
+	}
+
+	res := &Presentation{
+		Holder:   stringClaim(claims, "iss"),
+		Nonce:    stringClaim(claims, "nonce"),
+		Audience: audience(claims),
+	}
+	if holder := id(vp["holder"]); holder != "" {
+		if res.Holder != "" && holder != res.Holder {
+			return nil, errors.New(errors.BadRequest, "presentation holder doesn't match JWT issuer")
+		}
+		res.Holder = holder
+	}
+
+	var creds []interface{}
+	switch vc := vp["verifiableCredential"].(type) {
+	case []interface{}:
+		creds = vc
+	case nil:
+	default:
+		creds = []interface{}{vc}
+	}
+
+	for i, c := range creds {
+		cred, err := p.ParseCredential(ctx, c)
+		if err != nil {
+			msg := err.Error()
+			if e, ok := err.(*errors.Error); ok {
+				msg = e.Message
+			}
+			return nil, errors.New(errors.BadRequest, fmt.Sprintf("credential %d: %s", i, msg))
+		}
+		res.Credentials = append(res.Credentials, cred)
+	}
+
+	return res, nil
+}
+
+// ParseCredential parses and verifies a JWT encoded credential of a
+// presentation, which is given as compact JWT or SD-JWT or as
+// EnvelopedVerifiableCredential with a data URL.
+func (p *Parser) ParseCredential(_ context.Context, cred interface{}) (map[string]interface{}, error) {
+	var tok string
+	switch c := cred.(type) {
+	case string:
+		tok = token([]byte(c))
+	case map[string]interface{}:
+		if !hasType(c, EnvelopedType) {
+			return nil, errors.New(errors.BadRequest, "credential is not enveloped")
+		}
+		var err error
+		if tok, err = dataURL(id(c["id"])); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New(errors.BadRequest, "unknown credential type")
+	}
+
+	if !compactJWT.MatchString(tok) {
+		return nil, errors.New(errors.BadRequest, "credential is not a JWT")
+	}
+
+	if strings.Contains(tok, "~") {
+		c, _, err := p.parseSDJWT(tok)
+		return c, err
+	}
+
+	_, claims, err := p.verify(tok)
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeJWT(claims)
+}
+
+// verify verifies the signature and the validity period of a compact JWS
+// with the key of its issuer and returns its type header and claims. The issuer is taken from the "iss"
+// claim or from the "issuer" property of credentials and the "holder" property
+// of presentations without "iss" claim.
+func (p *Parser) verify(tok string) (string, map[string]interface{}, error) {
+	jws, err := jose.ParseSigned(tok)
+	if err != nil {
+		return "", nil, errors.New(errors.BadRequest, "invalid JWT", err)
+	}
+	header := jws.Signatures[0].Header
+
+	unverified, err := unverifiedClaims(tok)
+	if err != nil {
+		return "", nil, err
+	}
+	issuer := stringClaim(unverified, "iss")
+	if issuer == "" {
+		issuer = issuerClaim(unverified)
+	}
+	if issuer == "" {
+		issuer = id(unverified["holder"])
+	}
+	if issuer == "" {
+		return "", nil, errors.New(errors.BadRequest, "JWT doesn't contain issuer")
+	}
+
+	key, err := p.keyFetcher(issuer, header.KeyID)
+	if err != nil {
+		return "", nil, errors.New(errors.BadRequest, fmt.Sprintf("cannot get key %q of issuer %s", header.KeyID, issuer), err)
+	}
+	if key.JWK == nil {
+		return "", nil, errors.New(errors.BadRequest, fmt.Sprintf("key %q of issuer %s is not a JSON web key", header.KeyID, issuer))
+	}
+
+	payload, err := jws.Verify(key.JWK.Key)
+	if err != nil {
+		return "", nil, errors.New(errors.BadRequest, "invalid JWT signature", err)
+	}
+
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", nil, errors.New(errors.BadRequest, "invalid JWT claims", err)
+	}
+	if err := checkTime(claims, time.Now()); err != nil {
+		return "", nil, err
+	}
+
+	typ, _ := header.ExtraHeaders[jose.HeaderType].(string)
+	return strings.TrimPrefix(typ, "application/"), claims, nil
+}
+
+// normalizeJWT returns the credential of VC-JWT claims. Registered JWT
+// claims of VC Data Model 1.1 credentials are mapped to the credential
+// properties. Payloads of VC Data Model 2.0 are the credential.
+func normalizeJWT(claims map[string]interface{}) (map[string]interface{}, error) {
+	cred, ok := claims["vc"].(map[string]interface{})
+	if !ok {
+		if claims["credentialSubject"] == nil {
+			return nil, errors.New(errors.BadRequest, "JWT doesn't contain verifiable credential")
+		}
+		cred = copyMap(claims)
+		for _, c := range []string{"iss", "sub", "jti", "iat", "nbf", "exp", "aud", "cnf"} {
+			delete(cred, c)
+		}
+		setDefault(cred, "validFrom", timeClaim(claims, "nbf"))
+		setDefault(cred, "validUntil", timeClaim(claims, "exp"))
+	} else {
+		cred = copyMap(cred)
+		setDefault(cred, "issuanceDate", timeClaim(claims, "nbf"))
+		setDefault(cred, "expirationDate", timeClaim(claims, "exp"))
+	}
+
+	iss := stringClaim(claims, "iss")
+	if issuer := issuerClaim(cred); issuer != "" && iss != "" && issuer != iss {
+		return nil, errors.New(errors.BadRequest, "credential issuer doesn't match JWT issuer")
+	}
+	setDefault(cred, "issuer", iss)
+	setDefault(cred, "id", stringClaim(claims, "jti"))
+
+	if subject, ok := cred["credentialSubject"].(map[string]interface{}); ok {
+		subject = copyMap(subject)
+		setDefault(subject, "id", stringClaim(claims, "sub"))
+		cred["credentialSubject"] = subject
+	}
+
+	return cred, nil
+}
+
+// checkTime checks the expiration and not-before claims of a JWT.
+func checkTime(claims map[string]interface{}, at time.Time) error {
+	if exp, ok := numericDate(claims, "exp"); ok && exp.Before(at.Add(-clockSkew)) {
+		return errors.New(errors.BadRequest, "JWT is expired")
+	}
+	if nbf, ok := numericDate(claims, "nbf"); ok && nbf.After(at.Add(clockSkew)) {
+		return errors.New(errors.BadRequest, "JWT is not valid yet")
+	}
+	return nil
+}
+
+// token returns the JWT of the data, which may be given as JSON string.
+func token(data []byte) string {
+	tok := strings.TrimSpace(string(data))
+	if len(tok) > 1 && strings.HasPrefix(tok, `"`) && strings.HasSuffix(tok, `"`) {
+		var s string
+		if err := json.Unmarshal([]byte(tok), &s); err == nil {
+			return s
+		}
+	}
+	return tok
+}
+
+// dataURL returns the JWT contained in a data URL of a JWT media type.
+func dataURL(u string) (string, error) {
+	mediaType, data, ok := strings.Cut(strings.TrimPrefix(u, "data:"), ",")
+	if !ok || !strings.HasPrefix(u, "data:") {
+		return "", errors.New(errors.BadRequest, "enveloped credential doesn't contain data URL")
+	}
+
+	switch mediaType {
+	case MediaTypeVCJWT, MediaTypeVCSDJWT, MediaTypeDCSDJWT:
+		return data, nil
+	}
+	return "", errors.New(errors.BadRequest, fmt.Sprintf("unsupported media type of enveloped credential: %s", mediaType))
+}
+
+func unverifiedClaims(tok string) (map[string]interface{}, error) {
+	parts := strings.Split(tok, ".")
+	if len(parts) != 3 {
+		return nil, errors.New(errors.BadRequest, "invalid JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid JWT payload", err)
+	}
+
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid JWT claims", err)
+	}
+
+	return claims, nil
+}
+
+func hasType(m map[string]interface{}, typ string) bool {
+	switch t := m["type"].(type) {
+	case string:
+		return t == typ
+	case []interface{}:
+		for _, v := range t {
+			if v == typ {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// id returns a URI, which may be given as string or as object with an id.
+func id(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		s, _ := v["id"].(string)
+		return s
+	}
+	return ""
+}
+
+func issuerClaim(cred map[string]interface{}) string {
+	return id(cred["issuer"])
+}
+
+func stringClaim(claims map[string]interface{}, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// audience returns the audience of a JWT, which may be given
+// as string or as array with a single element.
+func audience(claims map[string]interface{}) string {
+	if aud, ok := claims["aud"].([]interface{}); ok && len(aud) == 1 {
+		s, _ := aud[0].(string)
+		return s
+	}
+	return stringClaim(claims, "aud")
+}
+
+func numericDate(claims map[string]interface{}, name string) (time.Time, bool) {
+	v, ok := claims[name].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(v), 0), true
+}
+
+// timeClaim returns a NumericDate claim formatted as XML Schema
+// dateTime, or an empty string if the claim is not present.
+func timeClaim(claims map[string]interface{}, name string) string {
+	t, ok := numericDate(claims, name)
+	if !ok {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// setDefault sets the property to the value, unless it is
+// already present or the value is empty.
+func setDefault(m map[string]interface{}, name, value string) {
+	if _, ok := m[name]; !ok && value != "" {
+		m[name] = value
+	}
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
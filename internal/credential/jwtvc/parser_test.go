@@ -0,0 +1,380 @@
+package jwtvc_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose/jwk"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+const (
+	issuerDID = "did:web:issuer.example.com"
+	holderDID = "did:web:holder.example.com"
+)
+
+type keyPair struct {
+	pub  ed25519.PublicKey
+	priv ed25519.PrivateKey
+}
+
+func newKeyPair(t *testing.T) *keyPair {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return &keyPair{pub: pub, priv: priv}
+}
+
+// sign returns a compact JWS of the claims with the given type header.
+func (k *keyPair) sign(t *testing.T, typ string, claims interface{}) string {
+	opts := (&jose.SignerOptions{}).WithType(jose.ContentType(typ)).WithHeader("kid", "#key-1")
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.EdDSA, Key: k.priv}, opts)
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	jws, err := signer.Sign(payload)
+	require.NoError(t, err)
+	tok, err := jws.CompactSerialize()
+	require.NoError(t, err)
+	return tok
+}
+
+func (k *keyPair) jwk() map[string]interface{} {
+	return map[string]interface{}{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"x":   base64.RawURLEncoding.EncodeToString(k.pub),
+	}
+}
+
+// keyFetcher returns the keys of the given issuers.
+func keyFetcher(keys map[string]*keyPair) func(issuerID, keyID string) (*verifier.PublicKey, error) {
+	return func(issuerID, keyID string) (*verifier.PublicKey, error) {
+		k, ok := keys[issuerID]
+		if !ok || keyID != "#key-1" {
+			return nil, errors.New(errors.NotFound, "key not found")
+		}
+		return &verifier.PublicKey{
+			Type: "JsonWebKey2020",
+			JWK:  &jwk.JWK{JSONWebKey: jose.JSONWebKey{Key: k.pub}, Kty: "OKP", Crv: "Ed25519"},
+		}, nil
+	}
+}
+
+func encodeDisclosure(t *testing.T, values ...interface{}) (string, string) {
+	data, err := json.Marshal(values)
+	require.NoError(t, err)
+	d := base64.RawURLEncoding.EncodeToString(data)
+	h := sha256.Sum256([]byte(d))
+	return d, base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+func sdHash(sdJWT string) string {
+	h := sha256.Sum256([]byte(sdJWT))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+func TestIsJWT(t *testing.T) {
+	assert.True(t, jwtvc.IsJWT([]byte("eyJhbGciOiJFZERTQSJ9.eyJpc3MiOiJ4In0.c2ln")))
+	assert.True(t, jwtvc.IsJWT([]byte(`"eyJhbGciOiJFZERTQSJ9.eyJpc3MiOiJ4In0.c2ln~WyJzIiwibiIsInYiXQ~"`)))
+	assert.False(t, jwtvc.IsJWT([]byte(`{"type": "VerifiablePresentation"}`)))
+	assert.False(t, jwtvc.IsJWT([]byte("not a jwt")))
+
+	assert.True(t, jwtvc.IsEnveloped(map[string]interface{}{"type": "EnvelopedVerifiableCredential"}))
+	assert.False(t, jwtvc.IsEnveloped(map[string]interface{}{"type": []interface{}{"VerifiableCredential"}}))
+}
+
+func TestParser_ParsePresentation_VCJWT(t *testing.T) {
+	issuer, holder := newKeyPair(t), newKeyPair(t)
+	parser := jwtvc.NewParser(keyFetcher(map[string]*keyPair{issuerDID: issuer, holderDID: holder}))
+
+	nbf := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	vcJWT := issuer.sign(t, "JWT", map[string]interface{}{
+		"iss": issuerDID,
+		"sub": "did:web:subject.example.com",
+		"jti": "https://issuer.example.com/credentials/1",
+		"nbf": nbf.Unix(),
+		"vc": map[string]interface{}{
+			"@context":          []string{"https://www.w3.org/2018/credentials/v1"},
+			"type":              []string{"VerifiableCredential", "LegalParticipant"},
+			"credentialSubject": map[string]interface{}{"name": "Example"},
+		},
+	})
+	vc2JWT := issuer.sign(t, "vc+jwt", map[string]interface{}{
+		"@context":          []string{"https://www.w3.org/ns/credentials/v2"},
+		"type":              []string{"VerifiableCredential"},
+		"issuer":            issuerDID,
+		"credentialSubject": map[string]interface{}{"id": "did:web:other.example.com", "name": "Other"},
+	})
+	expected := []map[string]interface{}{
+		{
+			"@context":          []interface{}{"https://www.w3.org/2018/credentials/v1"},
+			"type":              []interface{}{"VerifiableCredential", "LegalParticipant"},
+			"id":                "https://issuer.example.com/credentials/1",
+			"issuer":            issuerDID,
+			"issuanceDate":      "2024-01-01T00:00:00Z",
+			"credentialSubject": map[string]interface{}{"id": "did:web:subject.example.com", "name": "Example"},
+		},
+		{
+			"@context":          []interface{}{"https://www.w3.org/ns/credentials/v2"},
+			"type":              []interface{}{"VerifiableCredential"},
+			"issuer":            issuerDID,
+			"credentialSubject": map[string]interface{}{"id": "did:web:other.example.com", "name": "Other"},
+		},
+	}
+
+	t.Run("VC Data Model 1.1 presentation", func(t *testing.T) {
+		vpJWT := holder.sign(t, "JWT", map[string]interface{}{
+			"iss":   holderDID,
+			"aud":   "infohub.example.com",
+			"nonce": "abc",
+			"exp":   time.Now().Add(time.Minute).Unix(),
+			"vp": map[string]interface{}{
+				"type":                 []string{"VerifiablePresentation"},
+				"verifiableCredential": []string{vcJWT, vc2JWT},
+			},
+		})
+
+		vp, err := parser.ParsePresentation(context.Background(), []byte(vpJWT))
+		require.NoError(t, err)
+		assert.Equal(t, holderDID, vp.Holder)
+		assert.Equal(t, "abc", vp.Nonce)
+		assert.Equal(t, "infohub.example.com", vp.Audience)
+		assert.Equal(t, expected, vp.Credentials)
+	})
+
+	t.Run("VC Data Model 2.0 presentation with enveloped credentials", func(t *testing.T) {
+		vpJWT := holder.sign(t, "vp+jwt", map[string]interface{}{
+			"type":   []string{"VerifiablePresentation"},
+			"holder": holderDID,
+			"verifiableCredential": []interface{}{
+				map[string]interface{}{"type": "EnvelopedVerifiableCredential", "id": "data:application/vc+jwt," + vcJWT},
+				map[string]interface{}{"type": "EnvelopedVerifiableCredential", "id": "data:application/vc+jwt," + vc2JWT},
+			},
+		})
+
+		vp, err := parser.ParsePresentation(context.Background(), []byte(vpJWT))
+		require.NoError(t, err)
+		assert.Equal(t, holderDID, vp.Holder)
+		assert.Equal(t, expected, vp.Credentials)
+	})
+
+	t.Run("single credential", func(t *testing.T) {
+		vp, err := parser.ParsePresentation(context.Background(), []byte(`"`+vcJWT+`"`))
+		require.NoError(t, err)
+		assert.Empty(t, vp.Holder)
+		assert.Equal(t, expected[:1], vp.Credentials)
+	})
+
+	tests := []struct {
+		name    string
+		vp      string
+		errtext string
+	}{
+		{
+			name:    "credential of unknown issuer",
+			vp:      newKeyPair(t).sign(t, "JWT", map[string]interface{}{"iss": "did:web:unknown.example.com", "vc": map[string]interface{}{}}),
+			errtext: "cannot get key",
+		},
+		{
+			name:    "credential with invalid signature",
+			vp:      holder.sign(t, "JWT", map[string]interface{}{"iss": issuerDID, "vc": map[string]interface{}{}}),
+			errtext: "invalid JWT signature",
+		},
+		{
+			name: "credential with other issuer",
+			vp: issuer.sign(t, "JWT", map[string]interface{}{
+				"iss": issuerDID,
+				"vc":  map[string]interface{}{"issuer": holderDID, "credentialSubject": map[string]interface{}{}},
+			}),
+			errtext: "credential issuer doesn't match JWT issuer",
+		},
+		{
+			name: "expired presentation",
+			vp: holder.sign(t, "JWT", map[string]interface{}{
+				"iss": holderDID,
+				"exp": time.Now().Add(-time.Hour).Unix(),
+				"vp":  map[string]interface{}{"verifiableCredential": []string{vcJWT}},
+			}),
+			errtext: "JWT is expired",
+		},
+		{
+			name: "expired credential with later expiration date",
+			vp: holder.sign(t, "JWT", map[string]interface{}{
+				"iss": holderDID,
+				"vp": map[string]interface{}{"verifiableCredential": []string{issuer.sign(t, "JWT", map[string]interface{}{
+					"iss": issuerDID,
+					"exp": time.Now().Add(-time.Hour).Unix(),
+					"vc": map[string]interface{}{
+						"expirationDate":    time.Now().Add(time.Hour).Format(time.RFC3339),
+						"credentialSubject": map[string]interface{}{},
+					},
+				})}},
+			}),
+			errtext: "credential 0: JWT is expired",
+		},
+		{
+			name: "presentation of other holder",
+			vp: holder.sign(t, "JWT", map[string]interface{}{
+				"iss": holderDID,
+				"vp":  map[string]interface{}{"holder": issuerDID, "verifiableCredential": []string{vcJWT}},
+			}),
+			errtext: "presentation holder doesn't match JWT issuer",
+		},
+		{
+			name: "unsupported enveloped credential",
+			vp: holder.sign(t, "vp+jwt", map[string]interface{}{
+				"type":                 "VerifiablePresentation",
+				"holder":               holderDID,
+				"verifiableCredential": map[string]interface{}{"type": "EnvelopedVerifiableCredential", "id": "data:application/vc+ld+json,{}"},
+			}),
+			errtext: "credential 0: unsupported media type of enveloped credential: application/vc+ld+json",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vp, err := parser.ParsePresentation(context.Background(), []byte(test.vp))
+			assert.Nil(t, vp)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.errtext)
+			assert.True(t, errors.Is(errors.BadRequest, err))
+		})
+	}
+}
+
+func TestParser_ParsePresentation_SDJWT(t *testing.T) {
+	issuer, holder := newKeyPair(t), newKeyPair(t)
+	parser := jwtvc.NewParser(keyFetcher(map[string]*keyPair{issuerDID: issuer}))
+
+	name, nameDigest := encodeDisclosure(t, "salt1", "name", "Example")
+	city, cityDigest := encodeDisclosure(t, "salt2", "city", "Berlin")
+	nationality, nationalityDigest := encodeDisclosure(t, "salt3", "DE")
+	_, undisclosedDigest := encodeDisclosure(t, "salt4", "secret", "hidden")
+
+	claims := map[string]interface{}{
+		"iss":     issuerDID,
+		"sub":     "did:web:subject.example.com",
+		"iat":     time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).Unix(),
+		"vct":     "https://credentials.example.com/identity",
+		"_sd_alg": "sha-256",
+		"_sd":     []string{nameDigest, undisclosedDigest},
+		"address": map[string]interface{}{
+			"_sd":     []string{cityDigest},
+			"country": "DE",
+		},
+		"nationalities": []interface{}{map[string]interface{}{"...": nationalityDigest}, "FR"},
+		"cnf":           map[string]interface{}{"jwk": holder.jwk()},
+	}
+	sdJWT := issuer.sign(t, "vc+sd-jwt", claims) + "~" + name + "~" + city + "~" + nationality + "~"
+
+	keyBinding := func(sdJWT string, iat time.Time) string {
+		return holder.sign(t, "kb+jwt", map[string]interface{}{
+			"iat":     iat.Unix(),
+			"aud":     "infohub.example.com",
+			"nonce":   "abc",
+			"sd_hash": sdHash(sdJWT),
+		})
+	}
+
+	vp, err := parser.ParsePresentation(context.Background(), []byte(sdJWT+keyBinding(sdJWT, time.Now())))
+	require.NoError(t, err)
+	assert.Empty(t, vp.Holder)
+	assert.Equal(t, "abc", vp.Nonce)
+	assert.Equal(t, "infohub.example.com", vp.Audience)
+	assert.Equal(t, []map[string]interface{}{{
+		"type":         []interface{}{"VerifiableCredential", "https://credentials.example.com/identity"},
+		"issuer":       issuerDID,
+		"issuanceDate": "2024-01-01T00:00:00Z",
+		"credentialSubject": map[string]interface{}{
+			"id":            "did:web:subject.example.com",
+			"name":          "Example",
+			"address":       map[string]interface{}{"country": "DE", "city": "Berlin"},
+			"nationalities": []interface{}{"DE", "FR"},
+		},
+	}}, vp.Credentials)
+
+	unreferenced, _ := encodeDisclosure(t, "salt5", "extra", "value")
+
+	tests := []struct {
+		name    string
+		sdJWT   string
+		errtext string
+	}{
+		{
+			name:    "key binding is missing",
+			sdJWT:   sdJWT,
+			errtext: "SD-JWT doesn't contain key binding",
+		},
+		{
+			name:    "key binding of other SD-JWT",
+			sdJWT:   sdJWT + keyBinding(issuer.sign(t, "vc+sd-jwt", claims)+"~", time.Now()),
+			errtext: "key binding JWT doesn't match SD-JWT",
+		},
+		{
+			name:    "old key binding",
+			sdJWT:   sdJWT + keyBinding(sdJWT, time.Now().Add(-time.Hour)),
+			errtext: "key binding JWT is not issued recently",
+		},
+		{
+			name:    "disclosure which is not referenced",
+			sdJWT:   strings.TrimSuffix(sdJWT, "~") + "~" + unreferenced + "~",
+			errtext: "SD-JWT contains disclosure which is not referenced",
+		},
+		{
+			name:    "duplicate disclosure",
+			sdJWT:   sdJWT + name + "~",
+			errtext: "SD-JWT contains duplicate disclosures",
+		},
+		{
+			name:    "SD-JWT of other type",
+			sdJWT:   issuer.sign(t, "JWT", claims) + "~",
+			errtext: `unsupported SD-JWT type "JWT"`,
+		},
+		{
+			name: "SD-JWT with status",
+			sdJWT: issuer.sign(t, "vc+sd-jwt", map[string]interface{}{
+				"iss":     issuerDID,
+				"vct":     "https://credentials.example.com/identity",
+				"_sd_alg": "sha-256",
+				"status": map[string]interface{}{
+					"status_list": map[string]interface{}{"idx": 0, "uri": "https://issuer.example.com/statuslists/1"},
+				},
+			}) + "~",
+			errtext: "SD-JWT credentials with status are not supported",
+		},
+		{
+			name: "expired SD-JWT",
+			sdJWT: issuer.sign(t, "vc+sd-jwt", map[string]interface{}{
+				"iss":     issuerDID,
+				"exp":     time.Now().Add(-time.Hour).Unix(),
+				"vct":     "https://credentials.example.com/identity",
+				"_sd_alg": "sha-256",
+			}) + "~",
+			errtext: "JWT is expired",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vp, err := parser.ParsePresentation(context.Background(), []byte(test.sdJWT))
+			assert.Nil(t, vp)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.errtext)
+			assert.True(t, errors.Is(errors.BadRequest, err))
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package jwtvc
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// sdJWTClaims are the claims of SD-JWT credentials, which are
+// not part of the credential subject.
+var sdJWTClaims = []string{"iss", "sub", "jti", "iat", "nbf", "exp", "vct", "cnf", "status", "_sd_alg"}
+
+// disclosure is a decoded disclosure of an SD-JWT. Disclosures of
+// array elements don't have a name.
+type disclosure struct {
+	name  string
+	value interface{}
+	array bool
+	used  bool
+}
+
+// keyBinding contains the claims of a verified key binding JWT.
+type keyBinding struct {
+	nonce    string
+	audience string
+}
+
+// parseSDJWT verifies an SD-JWT credential, resolves its disclosures and
+// checks its key binding, which is required if the credential contains a
+// confirmation key. The normalised credential and the key binding are returned.
+func (p *Parser) parseSDJWT(tok string) (map[string]interface{}, *keyBinding, error) {
+	parts := strings.Split(tok, "~")
+	issuerJWT, disclosures, kbJWT := parts[0], parts[1:len(parts)-1], parts[len(parts)-1]
+
+	typ, claims, err := p.verify(issuerJWT)
+	if err != nil {
+		return nil, nil, err
+	}
+	if typ != "vc+sd-jwt" && typ != "dc+sd-jwt" {
+		return nil, nil, errors.New(errors.BadRequest, fmt.Sprintf("unsupported SD-JWT type %q", typ))
+	}
+	// token status lists are not supported, so the status of the
+	// credential cannot be checked
+	if claims["status"] != nil {
+		return nil, nil, errors.New(errors.BadRequest, "SD-JWT credentials with status are not supported")
+	}
+
+	hash, err := hashFunc(claims["_sd_alg"])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := disclose(claims, disclosures, hash); err != nil {
+		return nil, nil, err
+	}
+
+	kb, err := p.checkKeyBinding(claims, strings.TrimSuffix(tok, kbJWT), kbJWT, hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cred, err := normalizeSDJWT(claims)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return cred, kb, nil
+}
+
+// disclose replaces the digests of the SD-JWT claims with the disclosed
+// values. Digests without disclosures are removed. Disclosures which are
+// not referenced by the claims are rejected.
+func disclose(claims map[string]interface{}, encoded []string, hash func([]byte) []byte) error {
+	disclosures := make(map[string]*disclosure, len(encoded))
+	for _, e := range encoded {
+		d, err := decodeDisclosure(e)
+		if err != nil {
+			return err
+		}
+
+		dg := digest(hash, e)
+		if disclosures[dg] != nil {
+			return errors.New(errors.BadRequest, "SD-JWT contains duplicate disclosures")
+		}
+		disclosures[dg] = d
+	}
+
+	if err := resolveObject(claims, disclosures); err != nil {
+		return err
+	}
+
+	for _, d := range disclosures {
+		if !d.used {
+			return errors.New(errors.BadRequest, "SD-JWT contains disclosure which is not referenced")
+		}
+	}
+
+	return nil
+}
+
+func resolveObject(obj map[string]interface{}, disclosures map[string]*disclosure) error {
+	digests, ok := obj["_sd"].([]interface{})
+	if !ok && obj["_sd"] != nil {
+		return errors.New(errors.BadRequest, "invalid SD-JWT digests")
+	}
+	delete(obj, "_sd")
+
+	for name, v := range obj {
+		resolved, err := resolveValue(v, disclosures)
+		if err != nil {
+			return err
+		}
+		obj[name] = resolved
+	}
+
+	for _, dg := range digests {
+		s, ok := dg.(string)
+		if !ok {
+			return errors.New(errors.BadRequest, "invalid SD-JWT digest")
+		}
+
+		d := disclosures[s]
+		if d == nil {
+			// claim is not disclosed
+			continue
+		}
+		if d.used || d.array {
+			return errors.New(errors.BadRequest, "SD-JWT disclosure is referenced more than once or in wrong place")
+		}
+		if _, ok := obj[d.name]; ok {
+			return errors.New(errors.BadRequest, fmt.Sprintf("SD-JWT disclosure overrides claim %q", d.name))
+		}
+		d.used = true
+
+		v, err := resolveValue(d.value, disclosures)
+		if err != nil {
+			return err
+		}
+		obj[d.name] = v
+	}
+
+	return nil
+}
+
+func resolveValue(v interface{}, disclosures map[string]*disclosure) (interface{}, error) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return v, resolveObject(v, disclosures)
+	case []interface{}:
+		res := make([]interface{}, 0, len(v))
+		for _, e := range v {
+			if dg, ok := arrayDigest(e); ok {
+				d := disclosures[dg]
+				if d == nil {
+					// element is not disclosed
+					continue
+				}
+				if d.used || !d.array {
+					return nil, errors.New(errors.BadRequest, "SD-JWT disclosure is referenced more than once or in wrong place")
+				}
+				d.used = true
+				e = d.value
+			}
+
+			resolved, err := resolveValue(e, disclosures)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, resolved)
+		}
+		return res, nil
+	}
+	return v, nil
+}
+
+// arrayDigest returns the digest of an array element, which
+// is given as object with the single property "...".
+func arrayDigest(e interface{}) (string, bool) {
+	m, ok := e.(map[string]interface{})
+	if !ok || len(m) != 1 {
+		return "", false
+	}
+	dg, ok := m["..."].(string)
+	return dg, ok
+}
+
+func decodeDisclosure(e string) (*disclosure, error) {
+	data, err := base64.RawURLEncoding.DecodeString(e)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid SD-JWT disclosure", err)
+	}
+
+	var values []interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid SD-JWT disclosure", err)
+	}
+
+	switch len(values) {
+	case 2:
+		return &disclosure{value: values[1], array: true}, nil
+	case 3:
+		name, ok := values[1].(string)
+		if !ok || name == "_sd" || name == "..." {
+			return nil, errors.New(errors.BadRequest, "invalid SD-JWT disclosure claim name")
+		}
+		return &disclosure{name: name, value: values[2]}, nil
+	}
+
+	return nil, errors.New(errors.BadRequest, "invalid SD-JWT disclosure")
+}
+
+// checkKeyBinding verifies the key binding JWT of an SD-JWT with the
+// confirmation key of the credential. It must be issued recently and
+// contain the hash of the SD-JWT it is appended to.
+func (p *Parser) checkKeyBinding(claims map[string]interface{}, sdJWT, kbJWT string, hash func([]byte) []byte) (*keyBinding, error) {
+	cnf, _ := claims["cnf"].(map[string]interface{})
+	if cnf == nil {
+		if kbJWT != "" {
+			return nil, errors.New(errors.BadRequest, "SD-JWT without confirmation key contains key binding")
+		}
+		return nil, nil
+	}
+
+	if kbJWT == "" {
+		return nil, errors.New(errors.BadRequest, "SD-JWT doesn't contain key binding")
+	}
+
+	jwkBytes, err := json.Marshal(cnf["jwk"])
+	if err != nil || cnf["jwk"] == nil {
+		return nil, errors.New(errors.BadRequest, "SD-JWT confirmation key is not a JSON web key")
+	}
+	var key jose.JSONWebKey
+	if err := key.UnmarshalJSON(jwkBytes); err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid SD-JWT confirmation key", err)
+	}
+
+	jws, err := jose.ParseSigned(kbJWT)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid key binding JWT", err)
+	}
+	if typ, _ := jws.Signatures[0].Header.ExtraHeaders[jose.HeaderType].(string); typ != "kb+jwt" {
+		return nil, errors.New(errors.BadRequest, fmt.Sprintf("unsupported key binding JWT type %q", typ))
+	}
+
+	payload, err := jws.Verify(key.Key)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid key binding JWT signature", err)
+	}
+
+	var kb map[string]interface{}
+	if err := json.Unmarshal(payload, &kb); err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid key binding JWT claims", err)
+	}
+
+	now := time.Now()
+	iat, ok := numericDate(kb, "iat")
+	if !ok || iat.After(now.Add(clockSkew)) || iat.Before(now.Add(-p.keyBindingMaxAge)) {
+		return nil, errors.New(errors.BadRequest, "key binding JWT is not issued recently")
+	}
+
+	if stringClaim(kb, "sd_hash") != digest(hash, sdJWT) {
+		return nil, errors.New(errors.BadRequest, "key binding JWT doesn't match SD-JWT")
+	}
+
+	return &keyBinding{
+		nonce:    stringClaim(kb, "nonce"),
+		audience: audience(kb),
+	}, nil
+}
+
+// normalizeSDJWT returns the credential of disclosed SD-JWT VC claims.
+// The "vct" claim is used as credential type and all claims, which are
+// not registered claims, become claims of the credential subject.
+func normalizeSDJWT(claims map[string]interface{}) (map[string]interface{}, error) {
+	vct := stringClaim(claims, "vct")
+	if vct == "" {
+		return nil, errors.New(errors.BadRequest, "SD-JWT doesn't contain vct")
+	}
+
+	subject := copyMap(claims)
+	for _, c := range sdJWTClaims {
+		delete(subject, c)
+	}
+	setDefault(subject, "id", stringClaim(claims, "sub"))
+
+	cred := map[string]interface{}{
+		"type":              []interface{}{verifiable.VCType, vct},
+		"issuer":            stringClaim(claims, "iss"),
+		"credentialSubject": subject,
+	}
+	setDefault(cred, "id", stringClaim(claims, "jti"))
+	setDefault(cred, "issuanceDate", timeClaim(claims, "iat"))
+	setDefault(cred, "validFrom", timeClaim(claims, "nbf"))
+	setDefault(cred, "expirationDate", timeClaim(claims, "exp"))
+
+	return cred, nil
+}
+
+func hashFunc(alg interface{}) (func([]byte) []byte, error) {
+	switch alg {
+	case nil, "sha-256":
+		return func(b []byte) []byte { h := sha256.Sum256(b); return h[:] }, nil
+	case "sha-384":
+		return func(b []byte) []byte { h := sha512.Sum384(b); return h[:] }, nil
+	case "sha-512":
+		return func(b []byte) []byte { h := sha512.Sum512(b); return h[:] }, nil
+	}
+	return nil, errors.New(errors.BadRequest, fmt.Sprintf("unsupported SD-JWT hash algorithm %v", alg))
+}
+
+func digest(hash func([]byte) []byte, s string) string {
+	return base64.RawURLEncoding.EncodeToString(hash([]byte(s)))
+}
@@ -0,0 +1,50 @@
+package keyfetcher
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/didresolver"
+)
+
+// New returns a key fetcher which resolves the keys of DID issuers with the
+// DID resolver and fetches the keys of other issuers from web key URLs.
+// Issuers are taken from documents of clients, so keys are only fetched
+// from the given web key URLs and from no other URL.
+func New(httpClient *http.Client, resolver *didresolver.Resolver, webKeyURLs ...string) verifiable.PublicKeyFetcher {
+	webKey := NewWebKeyFetcher(httpClient)
+	didKey := resolver.PublicKeyFetcher()
+
+	return func(issuerID, keyID string) (*verifier.PublicKey, error) {
+		if didresolver.IsDID(issuerID) {
+			return didKey(issuerID, keyID)
+		}
+		if !allowedURL(issuerID, keyID, webKeyURLs) {
+			return nil, errors.New(errors.BadRequest, fmt.Sprintf("keys of issuer %q can't be fetched: not a DID or web key URL", issuerID))
+		}
+		return webKey(issuerID, keyID)
+	}
+}
+
+// allowedURL reports whether the issuer URL is one of the web key URLs or
+// a path below one of them. The key ID must name a key below the issuer.
+func allowedURL(issuerID, keyID string, webKeyURLs []string) bool {
+	keyID = strings.TrimPrefix(keyID, "#")
+	if strings.ContainsAny(issuerID, "?#") || strings.Contains(issuerID, "..") ||
+		keyID == "" || strings.ContainsAny(keyID, "/?#") || strings.Contains(keyID, "..") {
+		return false
+	}
+
+	for _, u := range webKeyURLs {
+		u = strings.TrimSuffix(u, "/")
+		if u != "" && (issuerID == u || strings.HasPrefix(issuerID, u+"/")) {
+			return true
+		}
+	}
+	return false
+}
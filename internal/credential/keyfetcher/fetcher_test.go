@@ -0,0 +1,92 @@
+package keyfetcher_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/fingerprint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/keyfetcher"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/didresolver"
+)
+
+func TestNew(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	didKey, keyID := fingerprint.CreateDIDKey(pub)
+
+	fetch := keyfetcher.New(http.DefaultClient, didresolver.New())
+
+	key, err := fetch(didKey, keyID)
+	require.NoError(t, err)
+	require.NotNil(t, key.JWK)
+	assert.Equal(t, pub, key.JWK.Public().Key)
+
+	_, err = fetch("did:example:123", "#key-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported DID method")
+}
+
+func TestNew_WebKeyURLs(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	fetch := keyfetcher.New(http.DefaultClient, didresolver.New(), srv.URL+"/v1/keys")
+
+	tests := []struct {
+		name     string
+		issuerID string
+		keyID    string
+
+		errkind errors.Kind
+	}{
+		{
+			name:     "key of web key URL is fetched",
+			issuerID: srv.URL + "/v1/keys",
+			keyID:    "#key1",
+			errkind:  errors.NotFound,
+		},
+		{
+			name:     "key of other URL is not fetched",
+			issuerID: srv.URL + "/internal",
+			keyID:    "#key1",
+			errkind:  errors.BadRequest,
+		},
+		{
+			name:     "key of URL outside of web key URL is not fetched",
+			issuerID: srv.URL + "/v1/keys/../../internal",
+			keyID:    "#key1",
+			errkind:  errors.BadRequest,
+		},
+		{
+			name:     "key ID outside of web key URL is not fetched",
+			issuerID: srv.URL + "/v1/keys",
+			keyID:    "#../../internal",
+			errkind:  errors.BadRequest,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			atomic.StoreInt32(&calls, 0)
+			_, err := fetch(test.issuerID, test.keyID)
+			require.Error(t, err)
+			assert.True(t, errors.Is(test.errkind, err))
+			if test.errkind == errors.BadRequest {
+				assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+			}
+		})
+	}
+}
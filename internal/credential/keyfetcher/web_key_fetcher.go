@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/rsa"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -74,29 +75,40 @@ func (f *WebKeyFetcher) fetch(issuerID, keyID string) (*verifier.PublicKey, erro
 		return nil, fmt.Errorf("public key not found after decoding response")
 	}
 
+	return publicKey(*verificationMethod.PublicKeyJWK)
+}
+
+// publicKey converts a JSON Web Key to a public key for the Aries verifiers.
+func publicKey(key jose.JSONWebKey) (*verifier.PublicKey, error) {
 	// We need to extract the Curve and Kty values as they are needed by the
 	// Aries public key verifiers.
-	curve, kty, err := keyParams(verificationMethod.PublicKeyJWK.Key)
+	curve, kty, err := keyParams(key.Key)
 	if err != nil {
 		return nil, err
 	}
 
-	return &verifier.PublicKey{
+	pub := &verifier.PublicKey{
 		Type: "JsonWebKey2020",
 		JWK: &ariesjwk.JWK{
-			JSONWebKey: *verificationMethod.PublicKeyJWK,
+			JSONWebKey: key,
 			Crv:        curve,
 			Kty:        kty,
 		},
-	}, nil
+	}
+	// RSA signatures are verified with the PKCS#1 encoding of the key
+	if k, ok := key.Key.(*rsa.PublicKey); ok {
+		pub.Value = x509.MarshalPKCS1PublicKey(k)
+	}
+
+	return pub, nil
 }
 
 func keyParams(key interface{}) (curve string, kty string, err error) {
 	switch k := key.(type) {
 	case *ecdsa.PublicKey:
 		return k.Curve.Params().Name, "EC", nil
-	case *ed25519.PublicKey:
-		return "ED25519", "OKP", nil
+	case ed25519.PublicKey, *ed25519.PublicKey:
+		return "Ed25519", "OKP", nil
 	case *rsa.PublicKey:
 		return "", "RSA", nil
 	default:
@@ -0,0 +1,42 @@
+package credential
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/hyperledger/aries-framework-go/component/models/ld/context/embed"
+	"github.com/piprate/json-gold/ld"
+)
+
+// DocumentLoader loads the well-known JSON-LD contexts embedded in the
+// binary and fetches and caches all other documents.
+type DocumentLoader struct {
+	embedded map[string]*ld.RemoteDocument
+	remote   *ld.CachingDocumentLoader
+}
+
+func NewDocumentLoader(httpClient *http.Client) *DocumentLoader {
+	l := &DocumentLoader{
+		embedded: make(map[string]*ld.RemoteDocument, len(embed.Contexts)),
+		remote:   ld.NewCachingDocumentLoader(ld.NewDefaultDocumentLoader(httpClient)),
+	}
+
+	for _, c := range embed.Contexts {
+		doc, err := ld.DocumentFromReader(bytes.NewReader(c.Content))
+		if err != nil {
+			// embedded contexts are valid JSON, but if one isn't,
+			// it's simply fetched when needed
+			continue
+		}
+		l.embedded[c.URL] = &ld.RemoteDocument{DocumentURL: c.DocumentURL, Document: doc}
+	}
+
+	return l
+}
+
+func (l *DocumentLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	if doc, ok := l.embedded[u]; ok {
+		return doc, nil
+	}
+	return l.remote.LoadDocument(u)
+}
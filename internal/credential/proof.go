@@ -0,0 +1,58 @@
+package credential
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/jsonwebsignature2020"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+// proofPurpose is the purpose of the proofs of exported presentations and credentials.
+const proofPurpose = "assertionMethod"
+
+// AddProofs adds JsonWebSignature2020 proofs created by the JWS signer to the
+// presentation and to the credentials without proofs it contains. The
+// signed presentation is returned.
+func AddProofs(vp *verifiable.Presentation, signer jwtvc.Signer, verificationMethod string, docLoader ld.DocumentLoader) (map[string]interface{}, error) {
+	created := time.Now()
+	proofContext := &verifiable.LinkedDataProofContext{
+		SignatureType:           "JsonWebSignature2020",
+		Suite:                   jsonwebsignature2020.New(suite.WithSigner(signer)),
+		SignatureRepresentation: verifiable.SignatureJWS,
+		Created:                 &created,
+		VerificationMethod:      verificationMethod,
+		Purpose:                 proofPurpose,
+	}
+
+	for _, c := range vp.Credentials() {
+		vc, ok := c.(*verifiable.Credential)
+		if !ok || len(vc.Proofs) > 0 {
+			continue
+		}
+		if err := vc.AddLinkedDataProof(proofContext, jsonld.WithDocumentLoader(docLoader)); err != nil {
+			return nil, errors.New("error signing verifiable credential", err)
+		}
+	}
+
+	if err := vp.AddLinkedDataProof(proofContext, jsonld.WithDocumentLoader(docLoader)); err != nil {
+		return nil, errors.New("error signing verifiable presentation", err)
+	}
+
+	vpJSON, err := json.Marshal(vp)
+	if err != nil {
+		return nil, err
+	}
+	var presentation map[string]interface{}
+	if err := json.Unmarshal(vpJSON, &presentation); err != nil {
+		return nil, err
+	}
+
+	return presentation, nil
+}
@@ -0,0 +1,78 @@
+package credential
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/piprate/json-gold/ld"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+// ProofVerifier verifies the proofs of imported presentations and credentials.
+type ProofVerifier interface {
+	VerifyPresentation(ctx context.Context, vp []byte) error
+	VerifyCredential(ctx context.Context, vc []byte) error
+}
+
+// Prover adds proofs to exported presentations for the signers which sign
+// in-process instead of the Signer service, like the local and the Vault
+// signer. These signers can't verify the proofs of imported presentations,
+// so verification is delegated to the verifier of the prover.
+type Prover struct {
+	docLoader ld.DocumentLoader
+	verifier  ProofVerifier
+}
+
+type ProverOption func(*Prover)
+
+// WithDocumentLoader sets the loader of the JSON-LD contexts, which are
+// needed to canonicalize the signed presentations and credentials.
+func WithDocumentLoader(loader ld.DocumentLoader) ProverOption {
+	return func(p *Prover) {
+		if loader != nil {
+			p.docLoader = loader
+		}
+	}
+}
+
+// WithVerifier sets the verifier of imported presentations and credentials.
+func WithVerifier(v ProofVerifier) ProverOption {
+	return func(p *Prover) {
+		if v != nil {
+			p.verifier = v
+		}
+	}
+}
+
+func NewProver(opts ...ProverOption) *Prover {
+	p := &Prover{docLoader: NewDocumentLoader(http.DefaultClient)}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// AddProofs adds proofs created by the JWS signer to the presentation and
+// to the credentials without proofs it contains.
+func (p *Prover) AddProofs(vp *verifiable.Presentation, signer jwtvc.Signer, verificationMethod string) (map[string]interface{}, error) {
+	return AddProofs(vp, signer, verificationMethod, p.docLoader)
+}
+
+// VerifyPresentation verifies imported presentations with the verifier of the prover.
+func (p *Prover) VerifyPresentation(ctx context.Context, vp []byte) error {
+	if p.verifier == nil {
+		return errors.New(errors.Internal, "presentation verification is not configured")
+	}
+	return p.verifier.VerifyPresentation(ctx, vp)
+}
+
+// VerifyCredential verifies a credential with the verifier of the prover.
+func (p *Prover) VerifyCredential(ctx context.Context, vc []byte) error {
+	if p.verifier == nil {
+		return errors.New(errors.Internal, "credential verification is not configured")
+	}
+	return p.verifier.VerifyCredential(ctx, vc)
+}
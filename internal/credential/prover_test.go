@@ -0,0 +1,62 @@
+package credential_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+)
+
+type proofVerifier struct {
+	err error
+}
+
+func (v proofVerifier) VerifyPresentation(context.Context, []byte) error { return v.err }
+func (v proofVerifier) VerifyCredential(context.Context, []byte) error   { return v.err }
+
+func TestProver_Verify(t *testing.T) {
+	tests := []struct {
+		name     string
+		verifier credential.ProofVerifier
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "verification is not configured",
+			errkind: errors.Internal,
+			errtext: "verification is not configured",
+		},
+		{
+			name:     "verification is delegated",
+			verifier: proofVerifier{err: errors.New(errors.BadRequest, "invalid proof")},
+			errkind:  errors.BadRequest,
+			errtext:  "invalid proof",
+		},
+		{
+			name:     "proofs are valid",
+			verifier: proofVerifier{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prover := credential.NewProver(credential.WithVerifier(test.verifier))
+			for _, err := range []error{
+				prover.VerifyPresentation(context.Background(), []byte(`{}`)),
+				prover.VerifyCredential(context.Background(), []byte(`{}`)),
+			} {
+				if test.errtext == "" {
+					assert.NoError(t, err)
+					continue
+				}
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(test.errkind, err))
+			}
+		})
+	}
+}
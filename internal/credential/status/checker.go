@@ -0,0 +1,200 @@
+// Package status checks the status of credentials with status lists,
+// as specified by StatusList2021 and Bitstring Status List.
+package status
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+)
+
+const defaultCacheTTL = 5 * time.Minute
+
+// Types of credential status entries which are supported.
+const (
+	StatusList2021Entry      = "StatusList2021Entry"
+	BitstringStatusListEntry = "BitstringStatusListEntry"
+)
+
+// Purposes of status lists which invalidate credentials.
+const (
+	PurposeRevocation = "revocation"
+	PurposeSuspension = "suspension"
+)
+
+// CredentialVerifier verifies the proof of a status list credential.
+type CredentialVerifier interface {
+	VerifyCredential(ctx context.Context, vc []byte) error
+}
+
+// Checker checks the status of credentials in the status list credentials
+// referenced by them. Status lists are cached, so they are not fetched for
+// every credential, and removed from the cache when they expire.
+//
+// If a status list cannot be fetched or verified, the status of the
+// credential cannot be determined and the check fails with a
+// ServiceUnavailable error, unless the checker fails open.
+type Checker struct {
+	httpClient *http.Client
+	verifier   CredentialVerifier
+	cacheTTL   time.Duration
+	failOpen   bool
+	logger     *zap.Logger
+
+	mu    sync.Mutex
+	lists map[string]*cachedList
+}
+
+type cachedList struct {
+	list    *statusList
+	expires time.Time
+}
+
+func New(httpClient *http.Client, logger *zap.Logger, opts ...Option) *Checker {
+	c := &Checker{
+		httpClient: httpClient,
+		cacheTTL:   defaultCacheTTL,
+		logger:     logger,
+		lists:      make(map[string]*cachedList),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// CheckStatus checks all status entries of the credential. Credentials
+// which are revoked or suspended are reported as BadRequest errors.
+func (c *Checker) CheckStatus(ctx context.Context, cred map[string]interface{}) error {
+	var entries []interface{}
+	switch status := cred["credentialStatus"].(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		entries = []interface{}{status}
+	case []interface{}:
+		entries = status
+	default:
+		return errors.New(errors.BadRequest, "invalid credentialStatus")
+	}
+
+	for _, e := range entries {
+		entry, ok := e.(map[string]interface{})
+		if !ok {
+			return errors.New(errors.BadRequest, "invalid credentialStatus")
+		}
+		if err := c.checkEntry(ctx, cred, entry); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *Checker) checkEntry(ctx context.Context, cred, entry map[string]interface{}) error {
+	entryType, _ := entry["type"].(string)
+	if entryType != StatusList2021Entry && entryType != BitstringStatusListEntry {
+		return c.unchecked(errors.New(errors.BadRequest, fmt.Sprintf("unsupported credential status type %q", entryType)))
+	}
+
+	purpose, _ := entry["statusPurpose"].(string)
+	listURL, _ := entry["statusListCredential"].(string)
+	index, err := statusIndex(entry["statusListIndex"])
+	if err != nil || listURL == "" {
+		return errors.New(errors.BadRequest, "invalid credentialStatus entry", err)
+	}
+
+	list, err := c.statusList(ctx, listURL)
+	if err != nil {
+		return c.unchecked(errors.New(errors.ServiceUnavailable, "credential status cannot be checked", err))
+	}
+
+	if list.issuer != credential.Issuer(cred) {
+		return errors.New(errors.BadRequest, "status list credential is not issued by the credential issuer")
+	}
+	if list.purpose != "" && purpose != "" && list.purpose != purpose {
+		return errors.New(errors.BadRequest, fmt.Sprintf("status purpose %q doesn't match the status list", purpose))
+	}
+	// entries may omit the purpose, which is then the purpose of the list
+	if purpose == "" {
+		purpose = list.purpose
+	}
+	if purpose == "" {
+		return errors.New(errors.BadRequest, "invalid credentialStatus entry: unknown status purpose")
+	}
+
+	set, err := list.bit(index)
+	if err != nil {
+		return errors.New(errors.BadRequest, "invalid credentialStatus entry", err)
+	}
+
+	if set {
+		switch purpose {
+		case PurposeRevocation:
+			return errors.New(errors.BadRequest, "credential is revoked")
+		case PurposeSuspension:
+			return errors.New(errors.BadRequest, "credential is suspended")
+		}
+	}
+
+	return nil
+}
+
+// unchecked returns the error of a status which cannot be checked,
+// or nil if the checker fails open.
+func (c *Checker) unchecked(err error) error {
+	if c.failOpen {
+		c.logger.Warn("credential status is not checked", zap.Error(err))
+		return nil
+	}
+	return err
+}
+
+// statusList returns the cached status list or fetches it.
+func (c *Checker) statusList(ctx context.Context, listURL string) (*statusList, error) {
+	c.mu.Lock()
+	cached, ok := c.lists[listURL]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.list, nil
+	}
+
+	list, err := c.fetchList(ctx, listURL)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	c.mu.Lock()
+	// status list URLs are given by the imported credentials,
+	// so expired lists are removed instead of being kept forever
+	for u, l := range c.lists {
+		if !now.Before(l.expires) {
+			delete(c.lists, u)
+		}
+	}
+	c.lists[listURL] = &cachedList{list: list, expires: now.Add(c.cacheTTL)}
+	c.mu.Unlock()
+
+	return list, nil
+}
+
+func statusIndex(v interface{}) (int, error) {
+	switch i := v.(type) {
+	case string:
+		return strconv.Atoi(i)
+	case float64:
+		return int(i), nil
+	}
+	return 0, fmt.Errorf("invalid statusListIndex: %v", v)
+}
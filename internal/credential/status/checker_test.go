@@ -0,0 +1,243 @@
+package status_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/status"
+)
+
+const issuer = "did:web:issuer.example.com"
+
+// encodeList returns a GZIP compressed, base64url encoded bitstring
+// of 16KB with the bits at the given indexes set.
+func encodeList(t *testing.T, multibase bool, indexes ...int) string {
+	bits := make([]byte, 16*1024)
+	for _, i := range indexes {
+		bits[i/8] |= 1 << (7 - uint(i%8))
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(bits)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	encoded := base64.RawURLEncoding.EncodeToString(buf.Bytes())
+	if multibase {
+		return "u" + encoded
+	}
+	return encoded
+}
+
+type verifierFunc func(ctx context.Context, vc []byte) error
+
+func (f verifierFunc) VerifyCredential(ctx context.Context, vc []byte) error {
+	return f(ctx, vc)
+}
+
+func TestChecker_CheckStatus(t *testing.T) {
+	var fetches atomic.Int32
+	lists := map[string]string{
+		"/revocation": fmt.Sprintf(`{
+			"issuer": %q,
+			"type": ["VerifiableCredential", "StatusList2021Credential"],
+			"credentialSubject": {"type": "StatusList2021", "statusPurpose": "revocation", "encodedList": %q}
+		}`, issuer, encodeList(t, false, 42)),
+		"/suspension": fmt.Sprintf(`{
+			"issuer": {"id": %q},
+			"type": ["VerifiableCredential", "BitstringStatusListCredential"],
+			"credentialSubject": {"type": "BitstringStatusList", "statusPurpose": "suspension", "encodedList": %q}
+		}`, issuer, encodeList(t, true, 7)),
+		"/unknown": fmt.Sprintf(`{
+			"issuer": %q,
+			"credentialSubject": {"encodedList": %q}
+		}`, issuer, encodeList(t, false, 42)),
+		"/expired": fmt.Sprintf(`{
+			"issuer": %q,
+			"expirationDate": "2020-01-01T00:00:00Z",
+			"credentialSubject": {"statusPurpose": "revocation", "encodedList": %q}
+		}`, issuer, encodeList(t, false)),
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		list, ok := lists[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(list))
+	}))
+	defer srv.Close()
+
+	entry := func(entryType, purpose, list string, index interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"issuer": issuer,
+			"credentialStatus": map[string]interface{}{
+				"type":                 entryType,
+				"statusPurpose":        purpose,
+				"statusListIndex":      index,
+				"statusListCredential": srv.URL + list,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		cred     map[string]interface{}
+		failOpen bool
+		verifier status.CredentialVerifier
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "credential without status",
+			cred: map[string]interface{}{"issuer": issuer},
+		},
+		{
+			name: "credential is not revoked",
+			cred: entry(status.StatusList2021Entry, status.PurposeRevocation, "/revocation", "41"),
+		},
+		{
+			name:    "credential is revoked",
+			cred:    entry(status.StatusList2021Entry, status.PurposeRevocation, "/revocation", "42"),
+			errkind: errors.BadRequest,
+			errtext: "credential is revoked",
+		},
+		{
+			name:    "credential without status purpose is revoked",
+			cred:    entry(status.StatusList2021Entry, "", "/revocation", "42"),
+			errkind: errors.BadRequest,
+			errtext: "credential is revoked",
+		},
+		{
+			name:    "unknown status purpose",
+			cred:    entry(status.StatusList2021Entry, "", "/unknown", "42"),
+			errkind: errors.BadRequest,
+			errtext: "unknown status purpose",
+		},
+		{
+			name:    "credential is suspended",
+			cred:    entry(status.BitstringStatusListEntry, status.PurposeSuspension, "/suspension", float64(7)),
+			errkind: errors.BadRequest,
+			errtext: "credential is suspended",
+		},
+		{
+			name:    "status purpose doesn't match status list",
+			cred:    entry(status.BitstringStatusListEntry, status.PurposeRevocation, "/suspension", "7"),
+			errkind: errors.BadRequest,
+			errtext: `status purpose "revocation" doesn't match the status list`,
+		},
+		{
+			name: "status list of other issuer",
+			cred: func() map[string]interface{} {
+				cred := entry(status.StatusList2021Entry, status.PurposeRevocation, "/revocation", "1")
+				cred["issuer"] = "did:web:other.example.com"
+				return cred
+			}(),
+			errkind: errors.BadRequest,
+			errtext: "status list credential is not issued by the credential issuer",
+		},
+		{
+			name:    "index out of range",
+			cred:    entry(status.StatusList2021Entry, status.PurposeRevocation, "/revocation", "131072"),
+			errkind: errors.BadRequest,
+			errtext: "statusListIndex 131072 is out of range",
+		},
+		{
+			name:    "unsupported status type",
+			cred:    entry("RevocationList2020Status", status.PurposeRevocation, "/revocation", "1"),
+			errkind: errors.BadRequest,
+			errtext: `unsupported credential status type "RevocationList2020Status"`,
+		},
+		{
+			name:     "unsupported status type with fail open",
+			cred:     entry("RevocationList2020Status", status.PurposeRevocation, "/revocation", "1"),
+			failOpen: true,
+		},
+		{
+			name:    "unreachable status list",
+			cred:    entry(status.StatusList2021Entry, status.PurposeRevocation, "/unavailable", "1"),
+			errkind: errors.ServiceUnavailable,
+			errtext: "credential status cannot be checked",
+		},
+		{
+			name:     "unreachable status list with fail open",
+			cred:     entry(status.StatusList2021Entry, status.PurposeRevocation, "/unavailable", "1"),
+			failOpen: true,
+		},
+		{
+			name:    "expired status list",
+			cred:    entry(status.StatusList2021Entry, status.PurposeRevocation, "/expired", "1"),
+			errkind: errors.ServiceUnavailable,
+			errtext: "invalid status list credential",
+		},
+		{
+			name: "status list with invalid proof",
+			cred: entry(status.StatusList2021Entry, status.PurposeRevocation, "/revocation", "1"),
+			verifier: verifierFunc(func(ctx context.Context, vc []byte) error {
+				return errors.New(errors.BadRequest, "invalid credential proof")
+			}),
+			errkind: errors.ServiceUnavailable,
+			errtext: "invalid credential proof",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			checker := status.New(
+				srv.Client(),
+				zap.NewNop(),
+				status.WithFailOpen(test.failOpen),
+				status.WithCredentialVerifier(test.verifier),
+			)
+
+			err := checker.CheckStatus(context.Background(), test.cred)
+			if test.errtext == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.errtext)
+			e, ok := err.(*errors.Error)
+			require.True(t, ok)
+			assert.Equal(t, test.errkind, e.Kind)
+		})
+	}
+
+	t.Run("status lists are cached", func(t *testing.T) {
+		checker := status.New(srv.Client(), zap.NewNop())
+		fetches.Store(0)
+		for i := 0; i < 3; i++ {
+			err := checker.CheckStatus(context.Background(), entry(status.StatusList2021Entry, status.PurposeRevocation, "/revocation", "1"))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+
+	t.Run("expired status lists are fetched again", func(t *testing.T) {
+		checker := status.New(srv.Client(), zap.NewNop(), status.WithCacheTTL(time.Millisecond))
+		fetches.Store(0)
+		for i := 0; i < 2; i++ {
+			err := checker.CheckStatus(context.Background(), entry(status.StatusList2021Entry, status.PurposeRevocation, "/revocation", "1"))
+			require.NoError(t, err)
+			time.Sleep(2 * time.Millisecond)
+		}
+		assert.Equal(t, int32(2), fetches.Load())
+	})
+}
@@ -0,0 +1,132 @@
+package status
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+)
+
+const (
+	// maxListCredentialSize limits the size of fetched status list credentials.
+	maxListCredentialSize = 1 << 20
+	// maxListSize limits the size of decompressed status lists.
+	maxListSize = 16 << 20
+)
+
+// statusList is a decoded bitstring of a status list credential.
+type statusList struct {
+	issuer  string
+	purpose string
+	bits    []byte
+}
+
+// bit reports whether the status bit at the given index is set. The first
+// index refers to the most significant bit of the first byte.
+func (l *statusList) bit(index int) (bool, error) {
+	if index < 0 || index >= len(l.bits)*8 {
+		return false, fmt.Errorf("statusListIndex %d is out of range", index)
+	}
+	return l.bits[index/8]&(1<<(7-uint(index%8))) != 0, nil
+}
+
+func (c *Checker) fetchList(ctx context.Context, listURL string) (*statusList, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", listURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vc+ld+json, application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New(errors.GetKind(resp.StatusCode), fmt.Errorf("unexpected response: %s", resp.Status))
+	}
+
+	vcBytes, err := io.ReadAll(io.LimitReader(resp.Body, maxListCredentialSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if c.verifier != nil {
+		if err := c.verifier.VerifyCredential(ctx, vcBytes); err != nil {
+			return nil, fmt.Errorf("invalid status list credential: %w", err)
+		}
+	}
+
+	return parseList(vcBytes)
+}
+
+func parseList(vcBytes []byte) (*statusList, error) {
+	var vc map[string]interface{}
+	if err := json.Unmarshal(vcBytes, &vc); err != nil {
+		return nil, fmt.Errorf("invalid status list credential: %w", err)
+	}
+
+	if err := credential.CheckValidity(vc, time.Now()); err != nil {
+		return nil, fmt.Errorf("invalid status list credential: %w", err)
+	}
+
+	subject, ok := vc["credentialSubject"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("status list credential doesn't contain subject")
+	}
+
+	encoded, _ := subject["encodedList"].(string)
+	if encoded == "" {
+		return nil, fmt.Errorf("status list credential doesn't contain encodedList")
+	}
+
+	bits, err := decodeList(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encodedList: %w", err)
+	}
+
+	purpose, _ := subject["statusPurpose"].(string)
+	return &statusList{
+		issuer:  credential.Issuer(vc),
+		purpose: purpose,
+		bits:    bits,
+	}, nil
+}
+
+// decodeList decodes a GZIP compressed, base64url encoded bitstring. Bitstring
+// Status Lists are multibase encoded with the "u" prefix, while StatusList2021
+// lists have no prefix. Padding is accepted too.
+func decodeList(encoded string) ([]byte, error) {
+	encoded = strings.TrimRight(strings.TrimPrefix(encoded, "u"), "=")
+
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close() // nolint:errcheck
+
+	bits, err := io.ReadAll(io.LimitReader(r, maxListSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(bits) > maxListSize {
+		return nil, fmt.Errorf("status list is too large")
+	}
+
+	return bits, nil
+}
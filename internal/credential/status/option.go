@@ -0,0 +1,29 @@
+package status
+
+import "time"
+
+type Option func(*Checker)
+
+// WithCredentialVerifier verifies the proofs of status list credentials.
+func WithCredentialVerifier(v CredentialVerifier) Option {
+	return func(c *Checker) {
+		c.verifier = v
+	}
+}
+
+// WithCacheTTL sets how long status lists are cached.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(c *Checker) {
+		if ttl > 0 {
+			c.cacheTTL = ttl
+		}
+	}
+}
+
+// WithFailOpen accepts credentials whose status cannot be checked, e.g.
+// because the status list is unreachable, instead of rejecting them.
+func WithFailOpen(failOpen bool) Option {
+	return func(c *Checker) {
+		c.failOpen = failOpen
+	}
+}
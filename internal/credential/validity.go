@@ -0,0 +1,61 @@
+package credential
+
+import (
+	"fmt"
+	"time"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// clockSkew is tolerated between the clocks of the issuer and the service.
+const clockSkew = time.Minute
+
+// CheckValidity checks that the credential is valid at the given time, i.e.
+// it's not expired and not issued in the future. Both VC Data Model 1.1
+// (issuanceDate, expirationDate) and 2.0 (validFrom, validUntil) fields
+// are checked. Invalid credentials are reported as BadRequest errors.
+func CheckValidity(cred map[string]interface{}, at time.Time) error {
+	for _, field := range []string{"issuanceDate", "validFrom"} {
+		from, err := credentialTime(cred, field)
+		if err != nil {
+			return err
+		}
+		if !from.IsZero() && from.After(at.Add(clockSkew)) {
+			return errors.New(errors.BadRequest, fmt.Sprintf("credential is not valid before %s", from.Format(time.RFC3339)))
+		}
+	}
+
+	for _, field := range []string{"expirationDate", "validUntil"} {
+		until, err := credentialTime(cred, field)
+		if err != nil {
+			return err
+		}
+		if !until.IsZero() && until.Before(at.Add(-clockSkew)) {
+			return errors.New(errors.BadRequest, fmt.Sprintf("credential expired at %s", until.Format(time.RFC3339)))
+		}
+	}
+
+	return nil
+}
+
+// credentialTime returns the time of the given credential field,
+// or zero time if the field is not present.
+func credentialTime(cred map[string]interface{}, field string) (time.Time, error) {
+	v, ok := cred[field]
+	if !ok || v == nil {
+		return time.Time{}, nil
+	}
+
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, errors.New(errors.BadRequest, fmt.Sprintf("invalid %s", field))
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, errors.New(errors.BadRequest, fmt.Sprintf("invalid %s: %s", field, s))
+}
@@ -0,0 +1,84 @@
+package credential_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+)
+
+func TestCheckValidity(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		cred map[string]interface{}
+
+		errtext string
+	}{
+		{
+			name: "credential without validity period",
+			cred: map[string]interface{}{},
+		},
+		{
+			name: "valid credential",
+			cred: map[string]interface{}{"issuanceDate": "2024-01-01T00:00:00Z", "expirationDate": "2025-01-01T00:00:00Z"},
+		},
+		{
+			name: "valid credential of data model 2.0",
+			cred: map[string]interface{}{"validFrom": "2024-01-01T00:00:00Z", "validUntil": "2025-01-01T00:00:00Z"},
+		},
+		{
+			name: "issuance within tolerated clock skew",
+			cred: map[string]interface{}{"issuanceDate": "2024-06-01T12:00:30Z"},
+		},
+		{
+			name:    "expired credential",
+			cred:    map[string]interface{}{"issuanceDate": "2023-01-01T00:00:00Z", "expirationDate": "2024-01-01T00:00:00Z"},
+			errtext: "credential expired at 2024-01-01T00:00:00Z",
+		},
+		{
+			name:    "expired credential of data model 2.0",
+			cred:    map[string]interface{}{"validUntil": "2024-06-01T11:00:00+00:00"},
+			errtext: "credential expired at 2024-06-01T11:00:00Z",
+		},
+		{
+			name:    "credential issued in the future",
+			cred:    map[string]interface{}{"issuanceDate": "2024-07-01T00:00:00Z"},
+			errtext: "credential is not valid before 2024-07-01T00:00:00Z",
+		},
+		{
+			name:    "credential valid from the future",
+			cred:    map[string]interface{}{"validFrom": "2024-07-01T00:00:00"},
+			errtext: "credential is not valid before 2024-07-01T00:00:00Z",
+		},
+		{
+			name:    "invalid date",
+			cred:    map[string]interface{}{"expirationDate": "tomorrow"},
+			errtext: "invalid expirationDate: tomorrow",
+		},
+		{
+			name:    "date of invalid type",
+			cred:    map[string]interface{}{"issuanceDate": 1717243200},
+			errtext: "invalid issuanceDate",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := credential.CheckValidity(test.cred, now)
+			if test.errtext == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.errtext)
+			assert.True(t, errors.Is(errors.BadRequest, err))
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package credential
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+// Verifier verifies the proofs of presentations and of the credentials
+// they contain without calling the Signer service. Public keys are
+// retrieved with the given key fetcher.
+type Verifier struct {
+	keyFetcher verifiable.PublicKeyFetcher
+	docLoader  *DocumentLoader
+}
+
+func NewVerifier(keyFetcher verifiable.PublicKeyFetcher, httpClient *http.Client) *Verifier {
+	return &Verifier{
+		keyFetcher: keyFetcher,
+		docLoader:  NewDocumentLoader(httpClient),
+	}
+}
+
+// VerifyPresentation verifies the proof of the presentation and the proofs
+// of all credentials contained in it. Ed25519Signature2018/2020 and
+// JsonWebSignature2020 proofs are supported.
+func (v *Verifier) VerifyPresentation(ctx context.Context, vpBytes []byte) error {
+	vp, err := verifiable.ParsePresentation(
+		vpBytes,
+		verifiable.WithPresPublicKeyFetcher(v.keyFetcher),
+		verifiable.WithPresJSONLDDocumentLoader(v.docLoader),
+		verifiable.WithPresStrictValidation(),
+	)
+	if err != nil {
+		return errors.New(errors.BadRequest, "invalid presentation proof", err)
+	}
+
+	if len(vp.Proofs) == 0 {
+		return errors.New(errors.BadRequest, "presentation has no proof")
+	}
+	if err := checkProofSigner(vp.Proofs, vp.Holder); err != nil {
+		return errors.New(errors.BadRequest, "invalid presentation proof", err)
+	}
+
+	for _, cred := range vp.Credentials() {
+		// JWT encoded credentials are verified when they are imported
+		if vc, ok := cred.(*verifiable.Credential); ok && vc.JWT != "" || jwtvc.IsEnveloped(cred) {
+			continue
+		}
+
+		vcBytes, err := json.Marshal(cred)
+		if err != nil {
+			return errors.New(errors.BadRequest, "invalid verifiable credential", err)
+		}
+
+		if err := v.VerifyCredential(ctx, vcBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// VerifyCredential verifies the proof of a single credential.
+func (v *Verifier) VerifyCredential(_ context.Context, vcBytes []byte) error {
+	vc, err := verifiable.ParseCredential(
+		vcBytes,
+		verifiable.WithPublicKeyFetcher(v.keyFetcher),
+		verifiable.WithJSONLDDocumentLoader(v.docLoader),
+		verifiable.WithStrictValidation(),
+	)
+	if err != nil {
+		return errors.New(errors.BadRequest, "invalid credential proof", err)
+	}
+
+	if len(vc.Proofs) == 0 {
+		return errors.New(errors.BadRequest, "credential has no proof")
+	}
+	if err := checkProofSigner(vc.Proofs, vc.Issuer.ID); err != nil {
+		return errors.New(errors.BadRequest, "invalid credential proof", err)
+	}
+
+	return nil
+}
+
+// checkProofSigner checks that all proofs were created with keys of the
+// given signer, which is the issuer of a credential or the holder of a
+// presentation. The keys are resolved from the verification methods of
+// the proofs, so a valid proof only shows that the DID or URL before the
+// fragment of its verification method signed the document.
+func checkProofSigner(proofs []verifiable.Proof, signer string) error {
+	if signer == "" {
+		return fmt.Errorf("proofs can't be attributed to a signer")
+	}
+
+	for _, proof := range proofs {
+		method, _ := proof["verificationMethod"].(string)
+		controller, _, _ := strings.Cut(method, "#")
+		if controller != signer {
+			return fmt.Errorf("verification method %q is not a key of %s", method, signer)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package credential_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/jsonld"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/suite/jsonwebsignature2020"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/fingerprint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/keyfetcher"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/didresolver"
+)
+
+var testContexts = []string{
+	"https://www.w3.org/2018/credentials/v1",
+	"https://w3id.org/security/suites/jws-2020/v1",
+}
+
+type ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+func (s *ed25519Signer) Sign(data []byte) ([]byte, error) {
+	return ed25519.Sign(s.key, data), nil
+}
+
+func (s *ed25519Signer) Alg() string {
+	return "EdDSA"
+}
+
+// signedPresentation returns a presentation with a credential, both signed
+// with JsonWebSignature2020 by a did:key issuer. If modifyVC is set, the
+// credential is changed after it is signed.
+func signedPresentation(t *testing.T, signVP, modifyVC bool) []byte {
+	return forgedPresentation(t, signVP, modifyVC, "", "")
+}
+
+// forgedPresentation returns a presentation like signedPresentation, whose
+// credential claims the given issuer and which claims the given holder,
+// although both are signed by the did:key issuer.
+func forgedPresentation(t *testing.T, signVP, modifyVC bool, issuer, holder string) []byte {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	didKey, keyID := fingerprint.CreateDIDKey(pub)
+	if issuer == "" {
+		issuer = didKey
+	}
+	if holder == "" {
+		holder = didKey
+	}
+
+	loader := credential.NewDocumentLoader(http.DefaultClient)
+	proofContext := &verifiable.LinkedDataProofContext{
+		SignatureType:           "JsonWebSignature2020",
+		Suite:                   jsonwebsignature2020.New(suite.WithSigner(&ed25519Signer{key: priv})),
+		SignatureRepresentation: verifiable.SignatureJWS,
+		VerificationMethod:      keyID,
+	}
+
+	vc := &verifiable.Credential{
+		Context: testContexts,
+		Types:   []string{verifiable.VCType},
+		Issuer:  verifiable.Issuer{ID: issuer},
+		Issued:  util.NewTime(time.Now()),
+		Subject: verifiable.Subject{ID: "did:web:participant.example.com"},
+	}
+	require.NoError(t, vc.AddLinkedDataProof(proofContext, jsonld.WithDocumentLoader(loader)))
+	if modifyVC {
+		vc.Subject = verifiable.Subject{ID: "did:web:other.example.com"}
+	}
+
+	vp, err := verifiable.NewPresentation(verifiable.WithCredentials(vc))
+	require.NoError(t, err)
+	vp.Context = testContexts
+	vp.Holder = holder
+	if signVP {
+		require.NoError(t, vp.AddLinkedDataProof(proofContext, jsonld.WithDocumentLoader(loader)))
+	}
+
+	b, err := json.Marshal(vp)
+	require.NoError(t, err)
+	return b
+}
+
+func TestVerifier_VerifyPresentation(t *testing.T) {
+	tests := []struct {
+		name string
+		vp   []byte
+
+		errtext string
+	}{
+		{
+			name: "valid presentation",
+			vp:   signedPresentation(t, true, false),
+		},
+		{
+			name:    "presentation without proof",
+			vp:      signedPresentation(t, false, false),
+			errtext: "presentation has no proof",
+		},
+		{
+			name:    "credential with invalid proof",
+			vp:      signedPresentation(t, true, true),
+			errtext: "invalid credential proof",
+		},
+		{
+			name:    "presentation with modified credential",
+			vp:      []byte(strings.Replace(string(signedPresentation(t, true, false)), "participant.example.com", "other.example.com", 1)),
+			errtext: "invalid presentation proof",
+		},
+		{
+			name:    "credential signed by other key than the issuer's",
+			vp:      forgedPresentation(t, true, false, "did:web:trusted.example.com", ""),
+			errtext: "is not a key of did:web:trusted.example.com",
+		},
+		{
+			name:    "presentation signed by other key than the holder's",
+			vp:      forgedPresentation(t, true, false, "", "did:web:holder.example.com"),
+			errtext: "is not a key of did:web:holder.example.com",
+		},
+		{
+			name:    "invalid presentation",
+			vp:      []byte(`{"invalid":"presentation"}`),
+			errtext: "invalid presentation proof",
+		},
+	}
+
+	verifier := credential.NewVerifier(keyfetcher.New(http.DefaultClient, didresolver.New()), http.DefaultClient)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := verifier.VerifyPresentation(context.Background(), test.vp)
+			if test.errtext == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.errtext)
+			assert.True(t, errors.Is(errors.BadRequest, err))
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package didresolver
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+)
+
+// maxCacheEntries triggers the removal of expired entries when exceeded.
+const maxCacheEntries = 10000
+
+type cacheEntry struct {
+	doc     *did.Doc
+	err     error
+	expires time.Time
+}
+
+// cache keeps DID documents and resolution failures until they expire.
+type cache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+func newCache() *cache {
+	return &cache{entries: make(map[string]*cacheEntry)}
+}
+
+func (c *cache) get(id string) (*cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(e.expires) {
+		delete(c.entries, id)
+		return nil, false
+	}
+	return e, true
+}
+
+func (c *cache) set(id string, doc *did.Doc, err error, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= maxCacheEntries {
+		now := time.Now()
+		for k, e := range c.entries {
+			if now.After(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[id] = &cacheEntry{doc: doc, err: err, expires: time.Now().Add(ttl)}
+}
@@ -0,0 +1,62 @@
+package didresolver
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// PublicKeyFetcher returns a public key fetcher, which resolves the DID of
+// the issuer and returns its verification method with the given key ID.
+// keyID may be given as DID URL or as fragment(#).
+func (r *Resolver) PublicKeyFetcher() verifiable.PublicKeyFetcher {
+	return func(issuerID, keyID string) (*verifier.PublicKey, error) {
+		doc, err := r.Resolve(context.Background(), issuerID)
+		if err != nil {
+			return nil, err
+		}
+
+		keyURL := keyID
+		if strings.HasPrefix(keyID, "#") {
+			keyURL = issuerID + keyID
+		}
+
+		for _, vm := range doc.VerificationMethod {
+			if vm.ID == keyURL || vm.ID == keyID {
+				return publicKey(&vm)
+			}
+		}
+
+		return nil, errors.New(errors.NotFound, fmt.Sprintf("key %s not found in DID document of %s", keyID, issuerID))
+	}
+}
+
+// publicKey converts a verification method to a public key for the Aries
+// verifiers, which need the JSON Web Key of the method. Ed25519 methods
+// with raw public keys are converted to JSON Web Keys.
+func publicKey(vm *did.VerificationMethod) (*verifier.PublicKey, error) {
+	j := vm.JSONWebKey()
+	if j == nil {
+		if len(vm.Value) != ed25519.PublicKeySize || !strings.HasPrefix(vm.Type, "Ed25519VerificationKey") {
+			return nil, fmt.Errorf("unsupported verification method type: %s", vm.Type)
+		}
+
+		var err error
+		if j, err = newJWK(ed25519.PublicKey(vm.Value)); err != nil {
+			return nil, err
+		}
+	}
+
+	return &verifier.PublicKey{
+		Type:  vm.Type,
+		Value: vm.Value,
+		JWK:   j,
+	}, nil
+}
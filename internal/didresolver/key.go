@@ -0,0 +1,148 @@
+package didresolver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose/jwk"
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/fingerprint"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const jsonWebKey2020 = "JsonWebKey2020"
+
+type keyDriver struct{}
+
+// NewKeyDriver returns a driver which resolves did:key DIDs. The DID
+// document is generated from the public key encoded in the DID.
+func NewKeyDriver() Driver {
+	return keyDriver{}
+}
+
+func (keyDriver) Resolve(_ context.Context, id string) (*did.Doc, error) {
+	methodID, ok := strings.CutPrefix(id, "did:key:")
+	if !ok {
+		return nil, errors.New(errors.BadRequest, fmt.Sprintf("not a did:key: %s", id))
+	}
+
+	raw, code, err := fingerprint.PubKeyFromFingerprint(methodID)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid did:key", err)
+	}
+
+	var key interface{}
+	switch code {
+	case fingerprint.ED25519PubKeyMultiCodec:
+		if len(raw) != ed25519.PublicKeySize {
+			return nil, errors.New(errors.BadRequest, "invalid did:key: wrong Ed25519 key size")
+		}
+		key = ed25519.PublicKey(raw)
+	case fingerprint.P256PubKeyMultiCodec:
+		key, err = ecdsaKey(elliptic.P256(), raw)
+	case fingerprint.P384PubKeyMultiCodec:
+		key, err = ecdsaKey(elliptic.P384(), raw)
+	case fingerprint.P521PubKeyMultiCodec:
+		key, err = ecdsaKey(elliptic.P521(), raw)
+	default:
+		return nil, errors.New(errors.BadRequest, fmt.Sprintf("unsupported did:key multicodec: 0x%x", code))
+	}
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid did:key", err)
+	}
+
+	return singleKeyDoc(id, id+"#"+methodID, key)
+}
+
+// ecdsaKey decodes a compressed or uncompressed elliptic curve point.
+func ecdsaKey(curve elliptic.Curve, raw []byte) (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(curve, raw)
+	if x == nil {
+		size := (curve.Params().BitSize + 7) / 8
+		if len(raw) != 2*size {
+			return nil, fmt.Errorf("wrong %s key size", curve.Params().Name)
+		}
+		x, y = new(big.Int).SetBytes(raw[:size]), new(big.Int).SetBytes(raw[size:])
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve %s", curve.Params().Name)
+		}
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+type jwkDriver struct{}
+
+// NewJWKDriver returns a driver which resolves did:jwk DIDs. The DID
+// document is generated from the JSON Web Key encoded in the DID.
+func NewJWKDriver() Driver {
+	return jwkDriver{}
+}
+
+func (jwkDriver) Resolve(_ context.Context, id string) (*did.Doc, error) {
+	methodID, ok := strings.CutPrefix(id, "did:jwk:")
+	if !ok {
+		return nil, errors.New(errors.BadRequest, fmt.Sprintf("not a did:jwk: %s", id))
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(methodID)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid did:jwk", err)
+	}
+
+	var key jose.JSONWebKey
+	if err := json.Unmarshal(b, &key); err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid did:jwk", err)
+	}
+
+	if !key.IsPublic() {
+		return nil, errors.New(errors.BadRequest, "invalid did:jwk: key is not public")
+	}
+
+	return singleKeyDoc(id, id+"#0", key.Key)
+}
+
+// singleKeyDoc returns a DID document with a single JsonWebKey2020
+// verification method, which can be used for all verification relationships.
+func singleKeyDoc(id, keyID string, key interface{}) (*did.Doc, error) {
+	j, err := newJWK(key)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid DID key", err)
+	}
+
+	vm, err := did.NewVerificationMethodFromJWK(keyID, jsonWebKey2020, id, j)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid DID key", err)
+	}
+
+	return &did.Doc{
+		ID:                 id,
+		VerificationMethod: []did.VerificationMethod{*vm},
+		Authentication:     []did.Verification{*did.NewReferencedVerification(vm, did.Authentication)},
+		AssertionMethod:    []did.Verification{*did.NewReferencedVerification(vm, did.AssertionMethod)},
+	}, nil
+}
+
+// newJWK converts a public key to a JSON Web Key with the key type and
+// curve set, as they are needed by the Aries public key verifiers.
+func newJWK(key interface{}) (*jwk.JWK, error) {
+	j := &jwk.JWK{JSONWebKey: jose.JSONWebKey{Key: key}}
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		j.Kty, j.Crv = "OKP", "Ed25519"
+	case *ecdsa.PublicKey:
+		j.Kty, j.Crv = "EC", k.Curve.Params().Name
+	default:
+		return nil, fmt.Errorf("unsupported key type: %T", key)
+	}
+	return j, nil
+}
@@ -0,0 +1,46 @@
+package didresolver
+
+import (
+	"net/http"
+	"time"
+)
+
+type Option func(*Resolver)
+
+// WithHTTPClient sets the HTTP client used by the drivers
+// which resolve DIDs over the network.
+func WithHTTPClient(c *http.Client) Option {
+	return func(r *Resolver) {
+		r.httpClient = c
+	}
+}
+
+// WithDriver sets the driver of a DID method, e.g. "web",
+// replacing the built-in driver of the method.
+func WithDriver(method string, d Driver) Option {
+	return func(r *Resolver) {
+		r.drivers[method] = d
+	}
+}
+
+// WithUniversalResolver resolves DIDs of methods without driver
+// with the universal resolver at the given address.
+func WithUniversalResolver(addr string) Option {
+	return func(r *Resolver) {
+		r.universalURL = addr
+	}
+}
+
+// WithCacheTTL sets how long resolved DID documents are cached.
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(r *Resolver) {
+		r.cacheTTL = ttl
+	}
+}
+
+// WithNegativeCacheTTL sets how long DID resolution failures are cached.
+func WithNegativeCacheTTL(ttl time.Duration) Option {
+	return func(r *Resolver) {
+		r.negativeCacheTTL = ttl
+	}
+}
@@ -0,0 +1,130 @@
+package didresolver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+	"golang.org/x/sync/singleflight"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const (
+	defaultCacheTTL         = time.Hour
+	defaultNegativeCacheTTL = time.Minute
+)
+
+// Driver resolves the DIDs of a DID method to DID documents.
+type Driver interface {
+	Resolve(ctx context.Context, id string) (*did.Doc, error)
+}
+
+// Resolver resolves DIDs with the driver of their DID method. DIDs of methods
+// without driver are resolved by the universal resolver, if one is configured.
+// Resolved documents are cached, and so are DIDs which are invalid or not found,
+// but for a shorter time, so that unresolvable DIDs don't hit the network on
+// every request.
+type Resolver struct {
+	drivers      map[string]Driver
+	universalURL string
+	universal    Driver
+	httpClient   *http.Client
+
+	cache            *cache
+	cacheTTL         time.Duration
+	negativeCacheTTL time.Duration
+	group            singleflight.Group
+}
+
+func New(opts ...Option) *Resolver {
+	r := &Resolver{
+		drivers:          make(map[string]Driver),
+		httpClient:       http.DefaultClient,
+		cacheTTL:         defaultCacheTTL,
+		negativeCacheTTL: defaultNegativeCacheTTL,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	defaults := map[string]Driver{
+		"key": NewKeyDriver(),
+		"jwk": NewJWKDriver(),
+		"web": NewWebDriver(r.httpClient),
+	}
+	for method, driver := range defaults {
+		if _, ok := r.drivers[method]; !ok {
+			r.drivers[method] = driver
+		}
+	}
+
+	if r.universalURL != "" {
+		r.universal = NewUniversalDriver(r.universalURL, r.httpClient)
+	}
+
+	r.cache = newCache()
+	return r
+}
+
+// Resolve returns the DID document of the given DID.
+func (r *Resolver) Resolve(ctx context.Context, id string) (*did.Doc, error) {
+	if e, ok := r.cache.get(id); ok {
+		return e.doc, e.err
+	}
+
+	// concurrent resolutions of the same DID are made only once
+	res, err, _ := r.group.Do(id, func() (interface{}, error) {
+		doc, err := r.resolve(ctx, id)
+		if err != nil {
+			// network errors, failures of the DID host or resolver and
+			// cancellation of the request don't tell anything about the DID
+			if ctx.Err() == nil && (errors.Is(errors.NotFound, err) || errors.Is(errors.BadRequest, err)) {
+				r.cache.set(id, nil, err, r.negativeCacheTTL)
+			}
+			return nil, err
+		}
+		r.cache.set(id, doc, nil, r.cacheTTL)
+		return doc, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res.(*did.Doc), nil
+}
+
+func (r *Resolver) resolve(ctx context.Context, id string) (*did.Doc, error) {
+	parsed, err := did.Parse(id)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, fmt.Sprintf("invalid DID %q", id), err)
+	}
+
+	driver, ok := r.drivers[parsed.Method]
+	if !ok {
+		if r.universal == nil {
+			return nil, errors.New(errors.BadRequest, fmt.Sprintf("unsupported DID method %q", parsed.Method))
+		}
+		driver = r.universal
+	}
+
+	doc, err := driver.Resolve(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if doc.ID != id {
+		return nil, errors.New(errors.BadRequest, fmt.Sprintf("DID document %s doesn't belong to %s", doc.ID, id))
+	}
+
+	return doc, nil
+}
+
+// IsDID reports whether the identifier is a DID.
+func IsDID(id string) bool {
+	return strings.HasPrefix(id, "did:")
+}
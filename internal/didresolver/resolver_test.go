@@ -0,0 +1,181 @@
+package didresolver_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/vdr/fingerprint"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/didresolver"
+)
+
+func TestResolver_PublicKeyFetcher(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	didKey, keyID := fingerprint.CreateDIDKey(pub)
+
+	jwkBytes, err := json.Marshal(jose.JSONWebKey{Key: pub})
+	require.NoError(t, err)
+	didJWK := "did:jwk:" + base64.RawURLEncoding.EncodeToString(jwkBytes)
+
+	srv := httptest.NewTLSServer(nil)
+	defer srv.Close()
+	host := strings.TrimPrefix(srv.URL, "https://")
+	didWeb := "did:web:" + strings.ReplaceAll(host, ":", "%3A")
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/did.json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{
+			"@context": ["https://www.w3.org/ns/did/v1"],
+			"id": %q,
+			"verificationMethod": [{
+				"id": "%s#key-1",
+				"type": "JsonWebKey2020",
+				"controller": %q,
+				"publicKeyJwk": %s
+			}]
+		}`, didWeb, didWeb, didWeb, jwkBytes)
+	})
+
+	tests := []struct {
+		name     string
+		issuerID string
+		keyID    string
+
+		errtext string
+	}{
+		{name: "did:key", issuerID: didKey, keyID: keyID},
+		{name: "did:jwk", issuerID: didJWK, keyID: "#0"},
+		{name: "did:web with key fragment", issuerID: didWeb, keyID: "#key-1"},
+		{name: "did:web with key DID URL", issuerID: didWeb, keyID: didWeb + "#key-1"},
+		{name: "did:web key not found", issuerID: didWeb, keyID: "#key-2", errtext: "key #key-2 not found in DID document"},
+		{name: "did:web document not found", issuerID: didWeb + ":users:alice", keyID: "#key-1", errtext: "DID document not found"},
+		{name: "invalid did:key", issuerID: "did:key:invalid", errtext: "invalid did:key"},
+		{name: "unsupported DID method", issuerID: "did:example:123", errtext: "unsupported DID method"},
+	}
+
+	fetch := didresolver.New(didresolver.WithHTTPClient(srv.Client())).PublicKeyFetcher()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := fetch(test.issuerID, test.keyID)
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, key.JWK)
+			assert.Equal(t, "OKP", key.JWK.Kty)
+			assert.Equal(t, "Ed25519", key.JWK.Crv)
+			assert.Equal(t, pub, key.JWK.Public().Key)
+		})
+	}
+}
+
+func TestResolver_UniversalResolver(t *testing.T) {
+	id := "did:example:123"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/1.0/identifiers/"+id, r.URL.Path)
+		_, _ = fmt.Fprintf(w, `{
+			"didDocument": {"@context": ["https://www.w3.org/ns/did/v1"], "id": %q},
+			"didResolutionMetadata": {"contentType": "application/did+ld+json"},
+			"didDocumentMetadata": {}
+		}`, id)
+	}))
+	defer srv.Close()
+
+	r := didresolver.New(didresolver.WithUniversalResolver(srv.URL + "/"))
+	doc, err := r.Resolve(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, id, doc.ID)
+}
+
+func TestResolver_Cache(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		id := strings.TrimPrefix(r.URL.Path, "/1.0/identifiers/")
+		if id == "did:example:missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if id == "did:example:unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"@context": ["https://www.w3.org/ns/did/v1"], "id": %q}`, id)
+	}))
+	defer srv.Close()
+
+	r := didresolver.New(
+		didresolver.WithUniversalResolver(srv.URL),
+		didresolver.WithCacheTTL(time.Hour),
+		didresolver.WithNegativeCacheTTL(50*time.Millisecond),
+	)
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := r.Resolve(ctx, "did:example:123")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, int32(1), calls.Load())
+
+	for i := 0; i < 3; i++ {
+		_, err := r.Resolve(ctx, "did:example:missing")
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.NotFound, err))
+	}
+	assert.Equal(t, int32(2), calls.Load())
+
+	// failures are resolved again after the negative cache TTL
+	time.Sleep(100 * time.Millisecond)
+	_, err := r.Resolve(ctx, "did:example:missing")
+	require.Error(t, err)
+	assert.Equal(t, int32(3), calls.Load())
+
+	// transient failures are not cached
+	for i := 0; i < 3; i++ {
+		_, err := r.Resolve(ctx, "did:example:unavailable")
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.ServiceUnavailable, err))
+	}
+	assert.Equal(t, int32(6), calls.Load())
+}
+
+func TestWebURL(t *testing.T) {
+	tests := []struct {
+		did string
+		url string
+	}{
+		{did: "did:web:example.com", url: "https://example.com/.well-known/did.json"},
+		{did: "did:web:example.com:user:alice", url: "https://example.com/user/alice/did.json"},
+		{did: "did:web:localhost%3A8443", url: "https://localhost:8443/.well-known/did.json"},
+	}
+
+	for _, test := range tests {
+		u, err := didresolver.WebURL(test.did)
+		assert.NoError(t, err)
+		assert.Equal(t, test.url, u)
+	}
+
+	_, err := didresolver.WebURL("did:key:123")
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package didresolver
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+type universalDriver struct {
+	addr       string
+	httpClient *http.Client
+}
+
+// NewUniversalDriver returns a driver which resolves DIDs of any method
+// with a universal resolver, e.g. https://dev.uniresolver.io.
+func NewUniversalDriver(addr string, httpClient *http.Client) Driver {
+	return &universalDriver{
+		addr:       strings.TrimSuffix(addr, "/"),
+		httpClient: httpClient,
+	}
+}
+
+// Resolve the DID with GET {addr}/1.0/identifiers/{did}. The universal resolver
+// responds with a DID resolution result, but a plain DID document is accepted too.
+func (d *universalDriver) Resolve(ctx context.Context, id string) (*did.Doc, error) {
+	b, err := fetch(ctx, d.httpClient, d.addr+"/1.0/identifiers/"+url.PathEscape(id))
+	if err != nil {
+		return nil, err
+	}
+
+	if res, err := did.ParseDocumentResolution(b); err == nil {
+		return res.DIDDocument, nil
+	}
+
+	doc, err := did.ParseDocument(b)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid DID resolution result", err)
+	}
+
+	return doc, nil
+}
@@ -0,0 +1,97 @@
+package didresolver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/did"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// maxDocumentSize limits the size of DID documents fetched over the network.
+const maxDocumentSize = 1 << 20
+
+type webDriver struct {
+	httpClient *http.Client
+}
+
+// NewWebDriver returns a driver which resolves did:web DIDs by fetching
+// the DID document from the web domain of the DID.
+func NewWebDriver(httpClient *http.Client) Driver {
+	return &webDriver{httpClient: httpClient}
+}
+
+func (d *webDriver) Resolve(ctx context.Context, id string) (*did.Doc, error) {
+	docURL, err := WebURL(id)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, err.Error())
+	}
+
+	b, err := fetch(ctx, d.httpClient, docURL)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := did.ParseDocument(b)
+	if err != nil {
+		return nil, errors.New(errors.BadRequest, "invalid DID document", err)
+	}
+
+	return doc, nil
+}
+
+// WebURL returns the URL of the DID document of a did:web DID, e.g.
+// did:web:example.com:user resolves to https://example.com/user/did.json
+// and did:web:example.com resolves to https://example.com/.well-known/did.json.
+func WebURL(id string) (string, error) {
+	methodID, ok := strings.CutPrefix(id, "did:web:")
+	if !ok || methodID == "" {
+		return "", fmt.Errorf("not a did:web: %s", id)
+	}
+
+	parts := strings.Split(methodID, ":")
+	for i, p := range parts {
+		unescaped, err := url.PathUnescape(p)
+		if err != nil {
+			return "", fmt.Errorf("invalid did:web: %w", err)
+		}
+		parts[i] = unescaped
+	}
+
+	path := "/.well-known"
+	if len(parts) > 1 {
+		path = "/" + strings.Join(parts[1:], "/")
+	}
+
+	u := url.URL{Scheme: "https", Host: parts[0], Path: path + "/did.json"}
+	return u.String(), nil
+}
+
+// fetch returns the body of a successful GET request.
+func fetch(ctx context.Context, httpClient *http.Client, u string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/did+ld+json, application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, errors.New(errors.NotFound, "DID document not found")
+		}
+		return nil, errors.New(errors.GetKind(resp.StatusCode), fmt.Errorf("unexpected response: %s", resp.Status))
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize))
+}
@@ -0,0 +1,188 @@
+package localsigner
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-jose/go-jose/v3"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+// keyExtensions are the extensions of key files. PEM files contain
+// PKCS#8 private keys and JSON files contain private JSON web keys.
+var keyExtensions = map[string]bool{".pem": true, ".jwk": true, ".json": true}
+
+// key is a private signing key, which implements the signer of
+// linked data signature suites.
+type key struct {
+	priv crypto.Signer
+	alg  string
+	// kid is the key ID of a JSON web key
+	kid string
+}
+
+// loadKeys loads the key file or the key files of the directory with the
+// given path. Keys are named after their files without extension. Keys in
+// subdirectories are prefixed with the directory name, which is the key
+// namespace, e.g. "transit/key1" for the file "transit/key1.pem".
+func loadKeys(path string) (map[string]*key, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]*key)
+	if !info.IsDir() {
+		k, err := loadKey(path)
+		if err != nil {
+			return nil, err
+		}
+		keys[keyName(path)] = k
+		return keys, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			if err := addKey(keys, "", filepath.Join(path, e.Name())); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		files, err := os.ReadDir(filepath.Join(path, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range files {
+			if !f.IsDir() {
+				if err := addKey(keys, e.Name()+"/", filepath.Join(path, e.Name(), f.Name())); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys found in %s", path)
+	}
+	return keys, nil
+}
+
+// addKey loads the key file, if it has the extension of key files.
+func addKey(keys map[string]*key, prefix, file string) error {
+	if !keyExtensions[filepath.Ext(file)] {
+		return nil
+	}
+	k, err := loadKey(file)
+	if err != nil {
+		return err
+	}
+	keys[prefix+keyName(file)] = k
+	return nil
+}
+
+func keyName(file string) string {
+	return strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+}
+
+// loadKey parses a PKCS#8 private key in PEM format or a private JSON
+// web key. Ed25519, ECDSA P-256 and P-384 and RSA keys are supported.
+func loadKey(file string) (*key, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &key{}
+	var priv interface{}
+	if block, _ := pem.Decode(data); block != nil {
+		if block.Type != "PRIVATE KEY" {
+			return nil, fmt.Errorf("%s: unsupported PEM block %q, PKCS#8 private key is required", file, block.Type)
+		}
+		if priv, err = x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+			return nil, fmt.Errorf("%s: invalid PKCS#8 private key: %w", file, err)
+		}
+	} else {
+		var jwk jose.JSONWebKey
+		if err := jwk.UnmarshalJSON(bytes.TrimSpace(data)); err != nil {
+			return nil, fmt.Errorf("%s: invalid JSON web key: %w", file, err)
+		}
+		if jwk.IsPublic() {
+			return nil, fmt.Errorf("%s: JSON web key is not a private key", file)
+		}
+		priv, k.kid = jwk.Key, jwk.KeyID
+	}
+
+	switch p := priv.(type) {
+	case ed25519.PrivateKey:
+		k.priv = p
+	case *ecdsa.PrivateKey:
+		k.priv = p
+	case *rsa.PrivateKey:
+		k.priv = p
+	default:
+		return nil, fmt.Errorf("%s: unsupported key type %T", file, priv)
+	}
+
+	if k.alg, err = jwtvc.Algorithm(k.priv.Public()); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+	if k.alg == "ES512" {
+		return nil, fmt.Errorf("%s: unsupported curve P-521", file)
+	}
+
+	return k, nil
+}
+
+// Sign returns the JWS signature of the data. ECDSA signatures
+// are returned in the fixed-size encoding of JWS.
+func (k *key) Sign(data []byte) ([]byte, error) {
+	switch p := k.priv.(type) {
+	case ed25519.PrivateKey:
+		return ed25519.Sign(p, data), nil
+	case *ecdsa.PrivateKey:
+		var hash []byte
+		if k.alg == "ES384" {
+			h := sha512.Sum384(data)
+			hash = h[:]
+		} else {
+			h := sha256.Sum256(data)
+			hash = h[:]
+		}
+		r, s, err := ecdsa.Sign(rand.Reader, p, hash)
+		if err != nil {
+			return nil, err
+		}
+		size := (p.Curve.Params().BitSize + 7) / 8
+		sig := make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		s.FillBytes(sig[size:])
+		return sig, nil
+	case *rsa.PrivateKey:
+		h := sha256.Sum256(data)
+		return rsa.SignPSS(rand.Reader, p, crypto.SHA256, h[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash})
+	}
+	return nil, fmt.Errorf("unsupported key type %T", k.priv)
+}
+
+// Alg returns the JWS algorithm of the key.
+func (k *key) Alg() string {
+	return k.alg
+}
@@ -0,0 +1,88 @@
+package localsigner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+)
+
+// Signer signs exported presentations and JWTs in-process with private
+// keys loaded from files, as an alternative to the Signer service.
+// Presentations and credentials get JsonWebSignature2020 proofs.
+type Signer struct {
+	*credential.Prover
+	keys map[string]*key
+}
+
+// New loads the keys of the key file or key directory with the given path.
+// Keys are identified by their file names without extension. Keys stored in
+// subdirectories are used for the key namespace named like the directory,
+// the others for any namespace. The prover adds the proofs of presentations
+// and verifies imported presentations, the default prover can't verify them.
+func New(path string, prover *credential.Prover) (*Signer, error) {
+	keys, err := loadKeys(path)
+	if err != nil {
+		return nil, fmt.Errorf("error loading signing keys: %w", err)
+	}
+
+	if prover == nil {
+		prover = credential.NewProver()
+	}
+
+	return &Signer{Prover: prover, keys: keys}, nil
+}
+
+// PresentationProof adds proofs to the given presentation and to the
+// credentials without proofs it contains, using the signing key
+// identified by namespace and key.
+func (s *Signer) PresentationProof(ctx context.Context, issuer, namespace, keyName string, vp *verifiable.Presentation) (map[string]interface{}, error) {
+	k, vm, err := s.signingKey(issuer, namespace, keyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.AddProofs(vp, k, vm)
+}
+
+// SignJWT returns a compact JWT with the given type and claims, signed
+// with the signing key identified by namespace and key.
+func (s *Signer) SignJWT(ctx context.Context, issuer, namespace, keyName, typ string, claims map[string]interface{}) (string, error) {
+	k, vm, err := s.signingKey(issuer, namespace, keyName)
+	if err != nil {
+		return "", err
+	}
+
+	return jwtvc.Sign(k, typ, vm, claims)
+}
+
+// signingKey returns the key with the given name of the namespace, or of
+// any namespace, and its verification method. The verification method is
+// the key ID of JSON web keys, which is relative to the issuer unless it's
+// a DID URL, otherwise the name of the key relative to the issuer.
+func (s *Signer) signingKey(issuer, namespace, keyName string) (*key, string, error) {
+	k, ok := s.keys[namespace+"/"+keyName]
+	if !ok {
+		if k, ok = s.keys[keyName]; !ok {
+			return nil, "", errors.New(errors.NotFound, fmt.Sprintf("signing key %q not found", keyName))
+		}
+	}
+
+	kid := k.kid
+	if kid == "" {
+		kid = keyName
+	}
+	if strings.HasPrefix(kid, "did:") {
+		return k, kid, nil
+	}
+	if issuer == "" {
+		return nil, "", errors.New(errors.BadRequest, "issuer is required for signing")
+	}
+
+	return k, issuer + "#" + strings.TrimPrefix(kid, "#"), nil
+}
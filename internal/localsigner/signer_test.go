@@ -0,0 +1,245 @@
+package localsigner_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/jose/jwk"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/signature/verifier"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/util"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/localsigner"
+)
+
+const issuerDID = "did:web:issuer.example.com"
+
+var testContexts = []string{
+	"https://www.w3.org/2018/credentials/v1",
+	"https://w3id.org/security/suites/jws-2020/v1",
+}
+
+func writePEM(t *testing.T, file string, priv crypto.PrivateKey) {
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600))
+}
+
+func writeJWK(t *testing.T, file string, priv crypto.PrivateKey, kid string) {
+	data, err := json.Marshal(jose.JSONWebKey{Key: priv, KeyID: kid})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(file, data, 0600))
+}
+
+// publicKey returns the public key of a private key as key of verifiers.
+func publicKey(priv crypto.Signer) *verifier.PublicKey {
+	pub := &verifier.PublicKey{Type: "JsonWebKey2020", JWK: &jwk.JWK{JSONWebKey: jose.JSONWebKey{Key: priv.Public()}}}
+	switch p := priv.(type) {
+	case ed25519.PrivateKey:
+		pub.JWK.Kty, pub.JWK.Crv = "OKP", "Ed25519"
+	case *ecdsa.PrivateKey:
+		pub.JWK.Kty, pub.JWK.Crv = "EC", p.Curve.Params().Name
+	case *rsa.PrivateKey:
+		// RSA signatures are verified with PKCS#1 encoded keys
+		pub.JWK.Kty, pub.Value = "RSA", x509.MarshalPKCS1PublicKey(&p.PublicKey)
+	}
+	return pub
+}
+
+func TestSigner(t *testing.T) {
+	edKey, err := func() (ed25519.PrivateKey, error) {
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		return priv, err
+	}()
+	require.NoError(t, err)
+	p256, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	p384, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	writePEM(t, filepath.Join(dir, "ed25519.pem"), edKey)
+	writeJWK(t, filepath.Join(dir, "p256.jwk"), p256, "")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "transit"), 0700))
+	writePEM(t, filepath.Join(dir, "transit", "p384.pem"), p384)
+	writeJWK(t, filepath.Join(dir, "transit", "rsa.json"), rsaKey, "rsa-2024")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a key"), 0600))
+
+	tests := []struct {
+		name      string
+		namespace string
+		key       string
+		priv      crypto.Signer
+
+		alg     string
+		kid     string
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:      "Ed25519 PKCS#8 key of any namespace",
+			namespace: "transit",
+			key:       "ed25519",
+			priv:      edKey,
+			alg:       "EdDSA",
+			kid:       issuerDID + "#ed25519",
+		},
+		{
+			name: "P-256 JSON web key",
+			key:  "p256",
+			priv: p256,
+			alg:  "ES256",
+			kid:  issuerDID + "#p256",
+		},
+		{
+			name:      "P-384 PKCS#8 key of namespace",
+			namespace: "transit",
+			key:       "p384",
+			priv:      p384,
+			alg:       "ES384",
+			kid:       issuerDID + "#p384",
+		},
+		{
+			name:      "RSA JSON web key with key ID",
+			namespace: "transit",
+			key:       "rsa",
+			priv:      rsaKey,
+			alg:       "PS256",
+			kid:       issuerDID + "#rsa-2024",
+		},
+		{
+			name:    "key of other namespace",
+			key:     "p384",
+			errkind: errors.NotFound,
+			errtext: `signing key "p384" not found`,
+		},
+	}
+
+	signer, err := localsigner.New(dir, nil)
+	require.NoError(t, err)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keyFetcher := func(issuerID, keyID string) (*verifier.PublicKey, error) {
+				return publicKey(test.priv), nil
+			}
+			localVerifier := credential.NewVerifier(keyFetcher, http.DefaultClient)
+
+			vc := &verifiable.Credential{
+				Context: testContexts,
+				Types:   []string{verifiable.VCType},
+				Issuer:  verifiable.Issuer{ID: issuerDID},
+				Issued:  util.NewTime(time.Now()),
+				Subject: verifiable.Subject{ID: "did:web:participant.example.com"},
+			}
+			vp, err := verifiable.NewPresentation(verifiable.WithCredentials(vc))
+			require.NoError(t, err)
+			vp.Context = testContexts
+			vp.Holder = issuerDID
+
+			res, err := signer.PresentationProof(context.Background(), issuerDID, test.namespace, test.key, vp)
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(test.errkind, err))
+				return
+			}
+			require.NoError(t, err)
+
+			proof := res["proof"].(map[string]interface{})
+			assert.Equal(t, "JsonWebSignature2020", proof["type"])
+			assert.Equal(t, test.kid, proof["verificationMethod"])
+			assert.Equal(t, "assertionMethod", proof["proofPurpose"])
+
+			vpJSON, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.NoError(t, localVerifier.VerifyPresentation(context.Background(), vpJSON))
+
+			tok, err := signer.SignJWT(context.Background(), issuerDID, test.namespace, test.key, jwtvc.TypeJWT, map[string]interface{}{"iss": issuerDID})
+			require.NoError(t, err)
+			jws, err := jose.ParseSigned(tok)
+			require.NoError(t, err)
+			assert.Equal(t, test.alg, jws.Signatures[0].Header.Algorithm)
+			assert.Equal(t, test.kid, jws.Signatures[0].Header.KeyID)
+			payload, err := jws.Verify(test.priv.Public())
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"iss":"`+issuerDID+`"}`, string(payload))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	p521, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "key1.pem")
+	writePEM(t, keyFile, edKey)
+	publicJWK := filepath.Join(dir, "public.jwk")
+	data, err := json.Marshal(jose.JSONWebKey{Key: edKey.Public()})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(publicJWK, data, 0600))
+	p521File := filepath.Join(dir, "p521.pem")
+	writePEM(t, p521File, p521)
+	sec1File := filepath.Join(dir, "sec1.pem")
+	der, err := x509.MarshalECPrivateKey(p521)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(sec1File, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600))
+
+	tests := []struct {
+		name    string
+		path    string
+		errtext string
+	}{
+		{name: "single key file", path: keyFile},
+		{name: "key file not found", path: filepath.Join(dir, "missing.pem"), errtext: "no such file or directory"},
+		{name: "empty key directory", path: t.TempDir(), errtext: "no keys found"},
+		{name: "public JSON web key", path: publicJWK, errtext: "JSON web key is not a private key"},
+		{name: "unsupported curve", path: p521File, errtext: "unsupported curve P-521"},
+		{name: "key not in PKCS#8 format", path: sec1File, errtext: `unsupported PEM block "EC PRIVATE KEY"`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			signer, err := localsigner.New(test.path, nil)
+			if test.errtext != "" {
+				assert.Nil(t, signer)
+				assert.ErrorContains(t, err, test.errtext)
+				return
+			}
+			require.NoError(t, err)
+
+			// the key of a single file is used for any namespace
+			tok, err := signer.SignJWT(context.Background(), issuerDID, "transit", "key1", "JWT", map[string]interface{}{})
+			require.NoError(t, err)
+			jws, err := jose.ParseSigned(tok)
+			require.NoError(t, err)
+			_, err = jws.Verify(edKey.Public())
+			assert.NoError(t, err)
+		})
+	}
+}
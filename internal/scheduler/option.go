@@ -0,0 +1,24 @@
+package scheduler
+
+import "time"
+
+type Option func(*Scheduler)
+
+// WithInterval sets how often the scheduler checks for due export schedules.
+func WithInterval(interval time.Duration) Option {
+	return func(s *Scheduler) {
+		if interval > 0 {
+			s.interval = interval
+		}
+	}
+}
+
+// WithLeaseTTL sets for how long an instance keeps the lease of an export
+// schedule. While the lease is valid, other instances don't run the export.
+func WithLeaseTTL(ttl time.Duration) Option {
+	return func(s *Scheduler) {
+		if ttl > 0 {
+			s.leaseTTL = ttl
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// Package scheduler runs the exports which have a schedule before their
+// data expires from the Cache.
+//
+// Multiple instances of the service may run the scheduler at the same time.
+// Before an export is run, the scheduler acquires a lease for the export
+// schedule stored in the database, so only the instance holding the lease
+// runs the export.
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+//go:generate counterfeiter . Storage
+//go:generate counterfeiter . Exporter
+
+const (
+	defaultInterval = 30 * time.Second
+	defaultLeaseTTL = 5 * time.Minute
+)
+
+type Storage interface {
+	ExportConfigurations(ctx context.Context) ([]*storage.ExportConfiguration, error)
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+}
+
+type Exporter interface {
+	RefreshExport(ctx context.Context, exportName string) error
+}
+
+type Scheduler struct {
+	storage  Storage
+	exporter Exporter
+	holder   string
+	interval time.Duration
+	leaseTTL time.Duration
+	logger   *zap.Logger
+}
+
+// New creates a scheduler. The holder uniquely identifies the service
+// instance when acquiring leases for export schedules.
+func New(storage Storage, exporter Exporter, holder string, logger *zap.Logger, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		storage:  storage,
+		exporter: exporter,
+		holder:   holder,
+		interval: defaultInterval,
+		leaseTTL: defaultLeaseTTL,
+		logger:   logger,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Run checks for due export schedules on every interval
+// until the context is canceled.
+func (s *Scheduler) Run(ctx context.Context) error {
+	s.logger.Info("export scheduler started", zap.String("holder", s.holder), zap.Duration("interval", s.interval))
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	last := time.Now()
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("export scheduler stopped")
+			return nil
+		case now := <-ticker.C:
+			s.Check(ctx, last, now)
+			last = now
+		}
+	}
+}
+
+// Check runs the exports whose schedule is due in the time interval (from, to].
+func (s *Scheduler) Check(ctx context.Context, from, to time.Time) {
+	configs, err := s.storage.ExportConfigurations(ctx)
+	if err != nil {
+		s.logger.Error("error getting export configurations", zap.Error(err))
+		return
+	}
+
+	for _, cfg := range configs {
+		if cfg.Schedule == "" {
+			continue
+		}
+
+		logger := s.logger.With(zap.String("exportName", cfg.ExportName), zap.String("schedule", cfg.Schedule))
+
+		// exports with required parameters can only be run by requests giving them
+		if required := cfg.RequiredParameters(); len(required) > 0 {
+			logger.Debug("scheduled export with required parameters is skipped", zap.Strings("parameters", required))
+			continue
+		}
+
+		schedule, err := cron.ParseStandard(cfg.Schedule)
+		if err != nil {
+			logger.Error("invalid export schedule", zap.Error(err))
+			continue
+		}
+
+		if next := schedule.Next(from); next.After(to) {
+			continue
+		}
+
+		acquired, err := s.storage.AcquireLease(ctx, leaseName(cfg.ExportName), s.holder, s.leaseTTL)
+		if err != nil {
+			logger.Error("error acquiring export schedule lease", zap.Error(err))
+			continue
+		}
+		if !acquired {
+			logger.Debug("export schedule lease is held by another instance")
+			continue
+		}
+
+		if err := s.exporter.RefreshExport(ctx, cfg.ExportName); err != nil {
+			logger.Error("error running scheduled export", zap.Error(err))
+			continue
+		}
+
+		logger.Info("scheduled export triggered")
+	}
+}
+
+// ValidateSchedule reports an error if the given cron expression is invalid.
+func ValidateSchedule(schedule string) error {
+	_, err := cron.ParseStandard(schedule)
+	return err
+}
+
+func leaseName(exportName string) string {
+	return "schedule:" + exportName
+}
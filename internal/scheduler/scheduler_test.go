@@ -0,0 +1,115 @@
+package scheduler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/scheduler"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/scheduler/schedulerfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestScheduler_Check(t *testing.T) {
+	from := time.Date(2024, 1, 1, 10, 29, 45, 0, time.UTC)
+	to := from.Add(30 * time.Second)
+
+	configs := []*storage.ExportConfiguration{
+		{ExportName: "unscheduled"},
+		{ExportName: "due", Schedule: "*/30 * * * *"},
+		{ExportName: "notdue", Schedule: "0 * * * *"},
+		{ExportName: "invalid", Schedule: "every minute"},
+		{
+			ExportName: "parameters",
+			Schedule:   "*/30 * * * *",
+			Parameters: []*storage.ExportParameter{{Name: "depth", Type: "integer", Required: true}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		storage  *schedulerfakes.FakeStorage
+		exporter *schedulerfakes.FakeExporter
+
+		refreshed []string
+	}{
+		{
+			name: "error getting export configurations",
+			storage: &schedulerfakes.FakeStorage{
+				ExportConfigurationsStub: func(ctx context.Context) ([]*storage.ExportConfiguration, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			exporter: &schedulerfakes.FakeExporter{},
+		},
+		{
+			name: "due export is refreshed when lease is acquired",
+			storage: &schedulerfakes.FakeStorage{
+				ExportConfigurationsStub: func(ctx context.Context) ([]*storage.ExportConfiguration, error) {
+					return configs, nil
+				},
+				AcquireLeaseStub: func(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+					return true, nil
+				},
+			},
+			exporter:  &schedulerfakes.FakeExporter{},
+			refreshed: []string{"due"},
+		},
+		{
+			name: "due export is not refreshed when lease is held by another instance",
+			storage: &schedulerfakes.FakeStorage{
+				ExportConfigurationsStub: func(ctx context.Context) ([]*storage.ExportConfiguration, error) {
+					return configs, nil
+				},
+				AcquireLeaseStub: func(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+					return false, nil
+				},
+			},
+			exporter: &schedulerfakes.FakeExporter{},
+		},
+		{
+			name: "due export is not refreshed when lease cannot be acquired",
+			storage: &schedulerfakes.FakeStorage{
+				ExportConfigurationsStub: func(ctx context.Context) ([]*storage.ExportConfiguration, error) {
+					return configs, nil
+				},
+				AcquireLeaseStub: func(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+					return false, errors.New("some error")
+				},
+			},
+			exporter: &schedulerfakes.FakeExporter{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := scheduler.New(test.storage, test.exporter, "instance-1", zap.NewNop(), scheduler.WithLeaseTTL(time.Minute))
+			s.Check(context.Background(), from, to)
+
+			var refreshed []string
+			for i := 0; i < test.exporter.RefreshExportCallCount(); i++ {
+				_, name := test.exporter.RefreshExportArgsForCall(i)
+				refreshed = append(refreshed, name)
+			}
+			assert.Equal(t, test.refreshed, refreshed)
+
+			if test.storage.AcquireLeaseCallCount() > 0 {
+				assert.Equal(t, 1, test.storage.AcquireLeaseCallCount())
+				_, name, holder, ttl := test.storage.AcquireLeaseArgsForCall(0)
+				assert.Equal(t, "schedule:due", name)
+				assert.Equal(t, "instance-1", holder)
+				assert.Equal(t, time.Minute, ttl)
+			}
+		})
+	}
+}
+
+func TestValidateSchedule(t *testing.T) {
+	assert.NoError(t, scheduler.ValidateSchedule("*/30 * * * *"))
+	assert.NoError(t, scheduler.ValidateSchedule("@hourly"))
+	assert.Error(t, scheduler.ValidateSchedule("every minute"))
+}
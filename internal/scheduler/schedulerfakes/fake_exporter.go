@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package schedulerfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/scheduler"
+)
+
+type FakeExporter struct {
+	RefreshExportStub        func(context.Context, string) error
+	refreshExportMutex       sync.RWMutex
+	refreshExportArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	refreshExportReturns struct {
+		result1 error
+	}
+	refreshExportReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeExporter) RefreshExport(arg1 context.Context, arg2 string) error {
+	fake.refreshExportMutex.Lock()
+	ret, specificReturn := fake.refreshExportReturnsOnCall[len(fake.refreshExportArgsForCall)]
+	fake.refreshExportArgsForCall = append(fake.refreshExportArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.RefreshExportStub
+	fakeReturns := fake.refreshExportReturns
+	fake.recordInvocation("RefreshExport", []interface{}{arg1, arg2})
+	fake.refreshExportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeExporter) RefreshExportCallCount() int {
+	fake.refreshExportMutex.RLock()
+	defer fake.refreshExportMutex.RUnlock()
+	return len(fake.refreshExportArgsForCall)
+}
+
+func (fake *FakeExporter) RefreshExportCalls(stub func(context.Context, string) error) {
+	fake.refreshExportMutex.Lock()
+	defer fake.refreshExportMutex.Unlock()
+	fake.RefreshExportStub = stub
+}
+
+func (fake *FakeExporter) RefreshExportArgsForCall(i int) (context.Context, string) {
+	fake.refreshExportMutex.RLock()
+	defer fake.refreshExportMutex.RUnlock()
+	argsForCall := fake.refreshExportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeExporter) RefreshExportReturns(result1 error) {
+	fake.refreshExportMutex.Lock()
+	defer fake.refreshExportMutex.Unlock()
+	fake.RefreshExportStub = nil
+	fake.refreshExportReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExporter) RefreshExportReturnsOnCall(i int, result1 error) {
+	fake.refreshExportMutex.Lock()
+	defer fake.refreshExportMutex.Unlock()
+	fake.RefreshExportStub = nil
+	if fake.refreshExportReturnsOnCall == nil {
+		fake.refreshExportReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.refreshExportReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeExporter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.refreshExportMutex.RLock()
+	defer fake.refreshExportMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeExporter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ scheduler.Exporter = new(FakeExporter)
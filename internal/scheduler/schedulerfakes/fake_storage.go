@@ -0,0 +1,204 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package schedulerfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/scheduler"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+type FakeStorage struct {
+	AcquireLeaseStub        func(context.Context, string, string, time.Duration) (bool, error)
+	acquireLeaseMutex       sync.RWMutex
+	acquireLeaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}
+	acquireLeaseReturns struct {
+		result1 bool
+		result2 error
+	}
+	acquireLeaseReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ExportConfigurationsStub        func(context.Context) ([]*storage.ExportConfiguration, error)
+	exportConfigurationsMutex       sync.RWMutex
+	exportConfigurationsArgsForCall []struct {
+		arg1 context.Context
+	}
+	exportConfigurationsReturns struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}
+	exportConfigurationsReturnsOnCall map[int]struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStorage) AcquireLease(arg1 context.Context, arg2 string, arg3 string, arg4 time.Duration) (bool, error) {
+	fake.acquireLeaseMutex.Lock()
+	ret, specificReturn := fake.acquireLeaseReturnsOnCall[len(fake.acquireLeaseArgsForCall)]
+	fake.acquireLeaseArgsForCall = append(fake.acquireLeaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AcquireLeaseStub
+	fakeReturns := fake.acquireLeaseReturns
+	fake.recordInvocation("AcquireLease", []interface{}{arg1, arg2, arg3, arg4})
+	fake.acquireLeaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) AcquireLeaseCallCount() int {
+	fake.acquireLeaseMutex.RLock()
+	defer fake.acquireLeaseMutex.RUnlock()
+	return len(fake.acquireLeaseArgsForCall)
+}
+
+func (fake *FakeStorage) AcquireLeaseCalls(stub func(context.Context, string, string, time.Duration) (bool, error)) {
+	fake.acquireLeaseMutex.Lock()
+	defer fake.acquireLeaseMutex.Unlock()
+	fake.AcquireLeaseStub = stub
+}
+
+func (fake *FakeStorage) AcquireLeaseArgsForCall(i int) (context.Context, string, string, time.Duration) {
+	fake.acquireLeaseMutex.RLock()
+	defer fake.acquireLeaseMutex.RUnlock()
+	argsForCall := fake.acquireLeaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStorage) AcquireLeaseReturns(result1 bool, result2 error) {
+	fake.acquireLeaseMutex.Lock()
+	defer fake.acquireLeaseMutex.Unlock()
+	fake.AcquireLeaseStub = nil
+	fake.acquireLeaseReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) AcquireLeaseReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.acquireLeaseMutex.Lock()
+	defer fake.acquireLeaseMutex.Unlock()
+	fake.AcquireLeaseStub = nil
+	if fake.acquireLeaseReturnsOnCall == nil {
+		fake.acquireLeaseReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.acquireLeaseReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportConfigurations(arg1 context.Context) ([]*storage.ExportConfiguration, error) {
+	fake.exportConfigurationsMutex.Lock()
+	ret, specificReturn := fake.exportConfigurationsReturnsOnCall[len(fake.exportConfigurationsArgsForCall)]
+	fake.exportConfigurationsArgsForCall = append(fake.exportConfigurationsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExportConfigurationsStub
+	fakeReturns := fake.exportConfigurationsReturns
+	fake.recordInvocation("ExportConfigurations", []interface{}{arg1})
+	fake.exportConfigurationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ExportConfigurationsCallCount() int {
+	fake.exportConfigurationsMutex.RLock()
+	defer fake.exportConfigurationsMutex.RUnlock()
+	return len(fake.exportConfigurationsArgsForCall)
+}
+
+func (fake *FakeStorage) ExportConfigurationsCalls(stub func(context.Context) ([]*storage.ExportConfiguration, error)) {
+	fake.exportConfigurationsMutex.Lock()
+	defer fake.exportConfigurationsMutex.Unlock()
+	fake.ExportConfigurationsStub = stub
+}
+
+func (fake *FakeStorage) ExportConfigurationsArgsForCall(i int) context.Context {
+	fake.exportConfigurationsMutex.RLock()
+	defer fake.exportConfigurationsMutex.RUnlock()
+	argsForCall := fake.exportConfigurationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStorage) ExportConfigurationsReturns(result1 []*storage.ExportConfiguration, result2 error) {
+	fake.exportConfigurationsMutex.Lock()
+	defer fake.exportConfigurationsMutex.Unlock()
+	fake.ExportConfigurationsStub = nil
+	fake.exportConfigurationsReturns = struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportConfigurationsReturnsOnCall(i int, result1 []*storage.ExportConfiguration, result2 error) {
+	fake.exportConfigurationsMutex.Lock()
+	defer fake.exportConfigurationsMutex.Unlock()
+	fake.ExportConfigurationsStub = nil
+	if fake.exportConfigurationsReturnsOnCall == nil {
+		fake.exportConfigurationsReturnsOnCall = make(map[int]struct {
+			result1 []*storage.ExportConfiguration
+			result2 error
+		})
+	}
+	fake.exportConfigurationsReturnsOnCall[i] = struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.acquireLeaseMutex.RLock()
+	defer fake.acquireLeaseMutex.RUnlock()
+	fake.exportConfigurationsMutex.RLock()
+	defer fake.exportConfigurationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStorage) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ scheduler.Storage = new(FakeStorage)
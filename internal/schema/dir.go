@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// Dir provides the schemas stored as JSON files in a local directory.
+// Schemas are identified by their "$id" or "id" property and by their
+// file name without extension.
+type Dir map[string][]byte
+
+// NewDir loads the JSON files of the given directory.
+func NewDir(path string) (Dir, error) {
+	files, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	d := make(Dir, len(files))
+	for _, file := range files {
+		doc, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var ids struct {
+			SchemaID string `json:"$id"`
+			ID       string `json:"id"`
+		}
+		if err := json.Unmarshal(doc, &ids); err != nil {
+			return nil, fmt.Errorf("invalid schema file %s: %w", file, err)
+		}
+
+		d[strings.TrimSuffix(filepath.Base(file), ".json")] = doc
+		for _, id := range []string{ids.SchemaID, ids.ID} {
+			if id != "" {
+				d[strings.TrimSuffix(id, "#")] = doc
+			}
+		}
+	}
+
+	return d, nil
+}
+
+func (d Dir) Schema(_ context.Context, id string) ([]byte, error) {
+	doc, ok := d[strings.TrimSuffix(id, "#")]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "schema not found")
+	}
+	return doc, nil
+}
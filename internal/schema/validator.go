@@ -0,0 +1,214 @@
+// Package schema validates credentials and export data with JSON schemas,
+// which are loaded from MongoDB or local files.
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+// Types of the credentialSchema entries of credentials, which are validated.
+const (
+	TypeJSONSchema              = "JsonSchema"
+	TypeJSONSchema2023          = "JsonSchema2023"
+	TypeJSONSchemaValidator2018 = "JsonSchemaValidator2018"
+	TypeJSONSchemaCredential    = "JsonSchemaCredential"
+)
+
+const defaultCacheTTL = time.Hour
+
+// Source provides the JSON documents of schemas by their identifiers.
+// A NotFound error is returned for unknown schemas.
+type Source interface {
+	Schema(ctx context.Context, id string) ([]byte, error)
+}
+
+// Validator validates JSON data with schemas loaded from its sources.
+// Compiled schemas are cached.
+type Validator struct {
+	sources  []Source
+	cacheTTL time.Duration
+
+	mu    sync.Mutex
+	cache map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	schema  *gojsonschema.Schema
+	expires time.Time
+}
+
+// New creates a validator loading schemas from the given sources, which
+// are asked in the given order. Schemas are cached for the given TTL.
+func New(cacheTTL time.Duration, sources ...Source) *Validator {
+	if cacheTTL <= 0 {
+		cacheTTL = defaultCacheTTL
+	}
+
+	return &Validator{
+		sources:  sources,
+		cacheTTL: cacheTTL,
+		cache:    make(map[string]*cacheEntry),
+	}
+}
+
+// Validate validates the data with the schema with the given identifier.
+// Data which doesn't match the schema is reported with a BadRequest error.
+func (v *Validator) Validate(ctx context.Context, id string, data interface{}) error {
+	schema, err := v.schema(ctx, id, false)
+	if err != nil {
+		return err
+	}
+
+	return validate(schema, id, data)
+}
+
+// ValidateCredential validates the credential with the JSON schemas
+// referenced by its credentialSchema property. Schemas of other types
+// are ignored. Credentials which reference unknown schemas or don't
+// match the schemas are reported with BadRequest errors.
+func (v *Validator) ValidateCredential(ctx context.Context, cred map[string]interface{}) error {
+	for _, ref := range credentialSchemas(cred) {
+		id, _ := ref["id"].(string)
+		typ, _ := ref["type"].(string)
+
+		var fromCredential bool
+		switch typ {
+		case TypeJSONSchema, TypeJSONSchema2023, TypeJSONSchemaValidator2018:
+		case TypeJSONSchemaCredential:
+			fromCredential = true
+		default:
+			continue
+		}
+
+		if id == "" {
+			return errors.New(errors.BadRequest, "credential schema doesn't contain id")
+		}
+
+		schema, err := v.schema(ctx, id, fromCredential)
+		if err != nil {
+			if errors.Is(errors.NotFound, err) {
+				return errors.New(errors.BadRequest, fmt.Sprintf("credential schema %q is not known", id))
+			}
+			return err
+		}
+
+		if err := validate(schema, id, cred); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// schema returns the compiled schema with the given identifier. If
+// fromCredential is true, the document is a JsonSchemaCredential
+// containing the schema in its subject.
+func (v *Validator) schema(ctx context.Context, id string, fromCredential bool) (*gojsonschema.Schema, error) {
+	key := id
+	if fromCredential {
+		key = TypeJSONSchemaCredential + " " + id
+	}
+
+	v.mu.Lock()
+	entry, ok := v.cache[key]
+	v.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.schema, nil
+	}
+
+	doc, err := v.load(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromCredential {
+		if doc, err = credentialSchemaDocument(doc); err != nil {
+			return nil, errors.New(fmt.Sprintf("invalid schema credential %q", id), err)
+		}
+	}
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(doc))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("invalid schema %q", id), err)
+	}
+
+	v.mu.Lock()
+	v.cache[key] = &cacheEntry{schema: schema, expires: time.Now().Add(v.cacheTTL)}
+	v.mu.Unlock()
+
+	return schema, nil
+}
+
+func (v *Validator) load(ctx context.Context, id string) ([]byte, error) {
+	for _, source := range v.sources {
+		doc, err := source.Schema(ctx, id)
+		if err == nil {
+			return doc, nil
+		}
+		if !errors.Is(errors.NotFound, err) {
+			return nil, errors.New(fmt.Sprintf("error loading schema %q", id), err)
+		}
+	}
+	return nil, errors.New(errors.NotFound, fmt.Sprintf("schema %q not found", id))
+}
+
+func validate(schema *gojsonschema.Schema, id string, data interface{}) error {
+	res, err := schema.Validate(gojsonschema.NewGoLoader(data))
+	if err != nil {
+		return errors.New(errors.BadRequest, fmt.Sprintf("data cannot be validated with schema %q", id), err)
+	}
+
+	if !res.Valid() {
+		reasons := make([]string, 0, len(res.Errors()))
+		for _, e := range res.Errors() {
+			reasons = append(reasons, e.String())
+		}
+		return errors.New(errors.BadRequest, fmt.Sprintf("data doesn't match schema %q: %s", id, strings.Join(reasons, "; ")))
+	}
+
+	return nil
+}
+
+// credentialSchemaDocument returns the schema contained in the
+// jsonSchema property of the subject of a JsonSchemaCredential.
+func credentialSchemaDocument(doc []byte) ([]byte, error) {
+	var cred struct {
+		CredentialSubject struct {
+			JSONSchema json.RawMessage `json:"jsonSchema"`
+		} `json:"credentialSubject"`
+	}
+	if err := json.Unmarshal(doc, &cred); err != nil {
+		return nil, err
+	}
+	if len(cred.CredentialSubject.JSONSchema) == 0 {
+		return nil, fmt.Errorf("credential subject doesn't contain jsonSchema")
+	}
+	return cred.CredentialSubject.JSONSchema, nil
+}
+
+// credentialSchemas returns the credentialSchema entries of a
+// credential, which may be given as a single object or as an array.
+func credentialSchemas(cred map[string]interface{}) []map[string]interface{} {
+	switch schema := cred["credentialSchema"].(type) {
+	case map[string]interface{}:
+		return []map[string]interface{}{schema}
+	case []interface{}:
+		refs := make([]map[string]interface{}, 0, len(schema))
+		for _, s := range schema {
+			if ref, ok := s.(map[string]interface{}); ok {
+				refs = append(refs, ref)
+			}
+		}
+		return refs
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package schema_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/schema"
+)
+
+const participantSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"$id": "https://schemas.example.com/participant.json",
+	"type": "object",
+	"properties": {
+		"credentialSubject": {
+			"type": "object",
+			"properties": {"name": {"type": "string"}},
+			"required": ["name"]
+		}
+	},
+	"required": ["credentialSubject"]
+}`
+
+const participantSchemaCredential = `{
+	"id": "https://schemas.example.com/participant-credential.json",
+	"type": ["VerifiableCredential", "JsonSchemaCredential"],
+	"credentialSubject": {
+		"type": "JsonSchema",
+		"jsonSchema": ` + participantSchema + `
+	}
+}`
+
+// countingSource counts the schemas loaded from the wrapped source.
+type countingSource struct {
+	schema.Source
+	loaded int
+}
+
+func (s *countingSource) Schema(ctx context.Context, id string) ([]byte, error) {
+	s.loaded++
+	return s.Source.Schema(ctx, id)
+}
+
+func TestValidator_ValidateCredential(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "participant.json"), []byte(participantSchema), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "participant-credential.json"), []byte(participantSchemaCredential), 0o600))
+	source, err := schema.NewDir(dir)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		cred    string
+		errtext string
+	}{
+		{
+			name: "credential without schema",
+			cred: `{"credentialSubject": {}}`,
+		},
+		{
+			name: "credential with schema of other type",
+			cred: `{"credentialSchema": {"id": "https://example.com/shape", "type": "ShaclValidator2017"}, "credentialSubject": {}}`,
+		},
+		{
+			name: "credential matches schema",
+			cred: `{"credentialSchema": {"id": "https://schemas.example.com/participant.json", "type": "JsonSchema"}, "credentialSubject": {"name": "Example"}}`,
+		},
+		{
+			name:    "credential doesn't match schema",
+			cred:    `{"credentialSchema": {"id": "https://schemas.example.com/participant.json", "type": "JsonSchema"}, "credentialSubject": {"name": 1}}`,
+			errtext: `data doesn't match schema "https://schemas.example.com/participant.json": credentialSubject.name: Invalid type. Expected: string, given: integer`,
+		},
+		{
+			name: "credential matches schema of schema credential",
+			cred: `{"credentialSchema": [{"id": "https://schemas.example.com/participant-credential.json", "type": "JsonSchemaCredential"}], "credentialSubject": {"name": "Example"}}`,
+		},
+		{
+			name:    "credential doesn't match schema of schema credential",
+			cred:    `{"credentialSchema": [{"id": "https://schemas.example.com/participant-credential.json", "type": "JsonSchemaCredential"}], "credentialSubject": {}}`,
+			errtext: "credentialSubject: name is required",
+		},
+		{
+			name:    "unknown schema",
+			cred:    `{"credentialSchema": {"id": "https://schemas.example.com/unknown.json", "type": "JsonSchema"}, "credentialSubject": {}}`,
+			errtext: `credential schema "https://schemas.example.com/unknown.json" is not known`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var cred map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(test.cred), &cred))
+
+			err := schema.New(0, source).ValidateCredential(context.Background(), cred)
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(errors.BadRequest, err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidator_Validate(t *testing.T) {
+	source := &countingSource{Source: schema.Dir{"participant": []byte(participantSchema)}}
+	validator := schema.New(0, source)
+
+	err := validator.Validate(context.Background(), "participant", map[string]interface{}{
+		"credentialSubject": map[string]interface{}{"name": "Example"},
+	})
+	require.NoError(t, err)
+
+	err = validator.Validate(context.Background(), "participant", map[string]interface{}{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(errors.BadRequest, err))
+
+	// schemas are cached
+	assert.Equal(t, 1, source.loaded)
+
+	err = validator.Validate(context.Background(), "unknown", map[string]interface{}{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(errors.NotFound, err))
+}
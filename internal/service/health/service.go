@@ -6,12 +6,20 @@ import (
 	"github.com/eclipse-xfsc/trusted-info-hub/gen/health"
 )
 
+// Checker reports whether a dependency of the service is ready.
+type Checker interface {
+	Ready(ctx context.Context) error
+}
+
 type Service struct {
-	version string
+	version  string
+	checkers []Checker
 }
 
-func New(version string) *Service {
-	return &Service{version: version}
+// New creates the health service. The service is ready
+// when all checkers report their dependencies as ready.
+func New(version string, checkers ...Checker) *Service {
+	return &Service{version: version, checkers: checkers}
 }
 
 func (s *Service) Liveness(_ context.Context) (*health.HealthResponse, error) {
@@ -22,7 +30,13 @@ func (s *Service) Liveness(_ context.Context) (*health.HealthResponse, error) {
 	}, nil
 }
 
-func (s *Service) Readiness(_ context.Context) (*health.HealthResponse, error) {
+func (s *Service) Readiness(ctx context.Context) (*health.HealthResponse, error) {
+	for _, c := range s.checkers {
+		if err := c.Ready(ctx); err != nil {
+			return nil, err
+		}
+	}
+
 	return &health.HealthResponse{
 		Service: "infohub",
 		Status:  "up",
@@ -0,0 +1,128 @@
+package infohub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// authorizeExport checks that the token of the client satisfies the
+// authorization rules of the export. Exports without rules can be
+// performed by any authenticated client.
+func (s *Service) authorizeExport(ctx context.Context, exportCfg *storage.ExportConfiguration) error {
+	rules := exportCfg.Authorization
+	if rules == nil {
+		return nil
+	}
+
+	err := s.checkExportRules(ctx, exportCfg.ExportName, rules)
+	s.audit(ctx, "export", exportCfg.ExportName, err)
+	return err
+}
+
+func (s *Service) checkExportRules(ctx context.Context, exportName string, rules *storage.ExportAuthorization) error {
+	c, ok := claims.FromContext(ctx)
+	if !ok {
+		return errors.New(errors.Forbidden, "export requires an authenticated client")
+	}
+
+	for _, scope := range rules.Scopes {
+		if !c.HasScope(scope) {
+			return errors.New(errors.Forbidden, fmt.Sprintf("missing scope %q", scope))
+		}
+	}
+
+	for name, value := range rules.Claims {
+		if !c.HasClaim(name, value) {
+			return errors.New(errors.Forbidden, fmt.Sprintf("missing claim %q", name))
+		}
+	}
+
+	if rules.Policy != "" {
+		input := map[string]interface{}{
+			"exportName": exportName,
+			"claims":     c,
+		}
+		res, err := s.policy.Evaluate(ctx, rules.Policy, input, "", nil)
+		if err != nil {
+			return errors.New("error evaluating authorization policy", err)
+		}
+
+		var decision struct {
+			Allow bool `json:"allow"`
+		}
+		if err := json.Unmarshal(res, &decision); err != nil {
+			return errors.New("error decoding authorization policy result", err)
+		}
+		if !decision.Allow {
+			return errors.New(errors.Forbidden, "export is not allowed by authorization policy")
+		}
+	}
+
+	return nil
+}
+
+// authorizeAdmin checks that the token of the client grants the scope
+// required for managing export configurations. Export configurations
+// select the signing key of exports, so they can't be managed by
+// unauthenticated clients, even when authentication is disabled.
+func (s *Service) authorizeAdmin(ctx context.Context, action, exportName string) error {
+	var err error
+	if c, ok := claims.FromContext(ctx); !ok {
+		err = errors.New(errors.Forbidden, "managing export configurations requires an authenticated client")
+	} else if !c.HasScope(s.adminScope) {
+		err = errors.New(errors.Forbidden, fmt.Sprintf("missing scope %q", s.adminScope))
+	}
+
+	s.audit(ctx, action, exportName, err)
+	return err
+}
+
+// authorizeImport checks that the token of the client grants the scope
+// required for importing data, if such scope is configured.
+func (s *Service) authorizeImport(ctx context.Context) error {
+	if s.importScope == "" {
+		return nil
+	}
+
+	var err error
+	if c, ok := claims.FromContext(ctx); !ok {
+		err = errors.New(errors.Forbidden, "import requires an authenticated client")
+	} else if !c.HasScope(s.importScope) {
+		err = errors.New(errors.Forbidden, fmt.Sprintf("missing scope %q", s.importScope))
+	}
+
+	s.audit(ctx, "import", "", err)
+	return err
+}
+
+// isAdmin reports whether the client has the admin scope.
+func (s *Service) isAdmin(ctx context.Context) bool {
+	c, ok := claims.FromContext(ctx)
+	return ok && c.HasScope(s.adminScope)
+}
+
+// audit logs the authorization decision for a client action.
+func (s *Service) audit(ctx context.Context, action, resource string, err error) {
+	c, _ := claims.FromContext(ctx)
+	fields := []zap.Field{
+		zap.String("action", action),
+		zap.String("subject", c.Subject()),
+	}
+	if resource != "" {
+		fields = append(fields, zap.String("resource", resource))
+	}
+
+	logger := s.logger.Named("audit")
+	if err != nil {
+		logger.Warn("access denied", append(fields, zap.Error(err))...)
+		return
+	}
+	logger.Info("access granted", fields...)
+}
@@ -0,0 +1,161 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_Export_Authorization(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    *storage.ExportAuthorization
+		claims   claims.Claims
+		decision string
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:   "export without rules is allowed",
+			claims: claims.Claims{"sub": "client-1"},
+		},
+		{
+			name:    "export with rules requires token claims",
+			rules:   &storage.ExportAuthorization{Scopes: []string{"export"}},
+			errkind: errors.Forbidden,
+			errtext: "export requires an authenticated client",
+		},
+		{
+			name:    "missing scope",
+			rules:   &storage.ExportAuthorization{Scopes: []string{"export"}},
+			claims:  claims.Claims{"scope": "import"},
+			errkind: errors.Forbidden,
+			errtext: `missing scope "export"`,
+		},
+		{
+			name:    "claim with other value",
+			rules:   &storage.ExportAuthorization{Claims: map[string]string{"organization": "example"}},
+			claims:  claims.Claims{"organization": "other"},
+			errkind: errors.Forbidden,
+			errtext: `missing claim "organization"`,
+		},
+		{
+			name:     "export is denied by policy",
+			rules:    &storage.ExportAuthorization{Policy: "test/authz/1.0"},
+			claims:   claims.Claims{"sub": "client-1"},
+			decision: `{"allow":false}`,
+			errkind:  errors.Forbidden,
+			errtext:  "export is not allowed by authorization policy",
+		},
+		{
+			name: "all rules are satisfied",
+			rules: &storage.ExportAuthorization{
+				Scopes: []string{"export"},
+				Claims: map[string]string{"organization": "example"},
+				Policy: "test/authz/1.0",
+			},
+			claims:   claims.Claims{"scope": "export", "organization": "example"},
+			decision: `{"allow":true}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storageFake := &infohubfakes.FakeStorage{
+				ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+					return &storage.ExportConfiguration{
+						ExportName:    "testexport",
+						Policies:      map[string]interface{}{"test/test/1.0": map[string]interface{}{}},
+						Authorization: test.rules,
+					}, nil
+				},
+			}
+			policyFake := &infohubfakes.FakePolicy{
+				EvaluateStub: func(ctx context.Context, policy string, data interface{}, evaluationID string, ttl *int) ([]byte, error) {
+					if policy == "test/authz/1.0" {
+						return []byte(test.decision), nil
+					}
+					return []byte(`{}`), nil
+				},
+			}
+			cacheFake := &infohubfakes.FakeCache{
+				GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+					return nil, errors.New(errors.NotFound, "no data")
+				},
+			}
+
+			ctx := context.Background()
+			if test.claims != nil {
+				ctx = claims.NewContext(ctx, test.claims)
+			}
+
+			svc := infohub.New(storageFake, policyFake, cacheFake, nil, nil, zap.NewNop())
+			res, err := svc.Export(ctx, &goainfohub.ExportRequest{ExportName: "testexport"})
+			svc.Wait()
+
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Nil(t, res)
+				e, ok := err.(*errors.Error)
+				require.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+				assert.Equal(t, 0, storageFake.CreateExportJobCallCount())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "accepted", res.Status)
+		})
+	}
+}
+
+func TestService_Import_Authorization(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims claims.Claims
+
+		errtext string
+	}{
+		{
+			name:    "import requires token claims",
+			errtext: "import requires an authenticated client",
+		},
+		{
+			name:    "missing import scope",
+			claims:  claims.Claims{"scope": "export"},
+			errtext: `missing scope "import"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.claims != nil {
+				ctx = claims.NewContext(ctx, test.claims)
+			}
+
+			signerFake := &infohubfakes.FakeSigner{}
+			svc := infohub.New(nil, nil, nil, nil, signerFake, zap.NewNop(), infohub.WithImportScope("import"))
+			res, err := svc.Import(ctx, &goainfohub.ImportRequest{Data: []byte(`{}`)})
+			assert.Nil(t, res)
+			require.Error(t, err)
+			e, ok := err.(*errors.Error)
+			require.True(t, ok)
+			assert.Equal(t, errors.Forbidden, e.Kind)
+			assert.Contains(t, e.Error(), test.errtext)
+			assert.Equal(t, 0, signerFake.VerifyPresentationCallCount())
+		})
+	}
+}
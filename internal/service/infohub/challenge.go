@@ -0,0 +1,93 @@
+package infohub
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+const defaultChallengeTTL = 5 * time.Minute
+
+// ImportChallenge issues a single-use challenge, which must be included
+// in the proof of an imported presentation together with the domain of
+// the service, so that captured presentations cannot be imported again.
+func (s *Service) ImportChallenge(ctx context.Context) (*infohub.ChallengeResult, error) {
+	logger := s.logger.With(zap.String("operation", "importChallenge"))
+
+	if err := s.authorizeImport(ctx); err != nil {
+		logger.Error("import challenge is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		logger.Error("error generating import challenge", zap.Error(err))
+		return nil, errors.New("error generating import challenge", err)
+	}
+
+	now := time.Now()
+	challenge := &storage.ImportChallenge{
+		Challenge: base64.RawURLEncoding.EncodeToString(nonce),
+		Domain:    s.challengeDomain,
+		CreatedAt: now,
+		ExpiresAt: now.Add(s.challengeTTL),
+	}
+	if err := s.storage.CreateImportChallenge(ctx, challenge); err != nil {
+		logger.Error("error saving import challenge", zap.Error(err))
+		return nil, errors.New("error saving import challenge", err)
+	}
+
+	res := &infohub.ChallengeResult{
+		Challenge: challenge.Challenge,
+		ExpiresAt: challenge.ExpiresAt.UTC().Format(time.RFC3339),
+	}
+	if challenge.Domain != "" {
+		res.Domain = &challenge.Domain
+	}
+
+	return res, nil
+}
+
+// consumeChallenge checks that the imported presentation is bound to an unused
+// challenge issued by the service and to the domain of the service. The
+// challenge is consumed, so it cannot be used for another import.
+func (s *Service) consumeChallenge(ctx context.Context, nonce, domain string) error {
+	if nonce == "" {
+		return errors.New(errors.BadRequest, "presentation proof doesn't contain challenge")
+	}
+
+	challenge, err := s.storage.ConsumeImportChallenge(ctx, nonce)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return errors.New(errors.BadRequest, "invalid, expired or already used challenge")
+		}
+		return errors.New("error consuming import challenge", err)
+	}
+
+	if challenge.Domain != domain {
+		return errors.New(errors.BadRequest, "presentation proof domain doesn't match challenge")
+	}
+
+	return nil
+}
+
+// proofChallenge returns the challenge and domain of the proof of a
+// JSON-LD presentation. JWT encoded presentations contain them as
+// nonce and audience.
+func proofChallenge(vp *verifiable.Presentation) (string, string) {
+	for _, proof := range vp.Proofs {
+		if c, ok := proof["challenge"].(string); ok && c != "" {
+			domain, _ := proof["domain"].(string)
+			return c, domain
+		}
+	}
+	return "", ""
+}
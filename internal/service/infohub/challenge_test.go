@@ -0,0 +1,112 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_ImportChallenge(t *testing.T) {
+	storageFake := &infohubfakes.FakeStorage{}
+	svc := infohub.New(storageFake, nil, nil, nil, nil, zap.NewNop(), infohub.WithImportChallenge("infohub.example.com", time.Minute))
+
+	res, err := svc.ImportChallenge(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, res.Challenge, 43)
+	require.NotNil(t, res.Domain)
+	assert.Equal(t, "infohub.example.com", *res.Domain)
+
+	require.Equal(t, 1, storageFake.CreateImportChallengeCallCount())
+	_, challenge := storageFake.CreateImportChallengeArgsForCall(0)
+	assert.Equal(t, res.Challenge, challenge.Challenge)
+	assert.Equal(t, time.Minute, challenge.ExpiresAt.Sub(challenge.CreatedAt))
+
+	res2, err := svc.ImportChallenge(context.Background())
+	require.NoError(t, err)
+	assert.NotEqual(t, res.Challenge, res2.Challenge)
+}
+
+func TestService_Import_Challenge(t *testing.T) {
+	tests := []struct {
+		name      string
+		proof     string
+		challenge *storage.ImportChallenge
+
+		errtext string
+	}{
+		{
+			name:    "presentation without challenge",
+			proof:   `{"type": "JsonWebSignature2020"}`,
+			errtext: "presentation proof doesn't contain challenge",
+		},
+		{
+			name:    "unknown or used challenge",
+			proof:   `{"type": "JsonWebSignature2020", "challenge": "abc", "domain": "infohub.example.com"}`,
+			errtext: "invalid, expired or already used challenge",
+		},
+		{
+			name:      "challenge for other domain",
+			proof:     `{"type": "JsonWebSignature2020", "challenge": "abc", "domain": "other.example.com"}`,
+			challenge: &storage.ImportChallenge{Challenge: "abc", Domain: "infohub.example.com"},
+			errtext:   "presentation proof domain doesn't match challenge",
+		},
+		{
+			name:      "valid challenge",
+			proof:     `{"type": "JsonWebSignature2020", "challenge": "abc", "domain": "infohub.example.com"}`,
+			challenge: &storage.ImportChallenge{Challenge: "abc", Domain: "infohub.example.com"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vp, err := verifiable.ParsePresentation([]byte(`{
+				"@context": ["https://www.w3.org/2018/credentials/v1"],
+				"type": ["VerifiablePresentation"],
+				"verifiableCredential": [{"credentialSubject": {"hello": "world"}}],
+				"proof": `+test.proof+`
+			}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+			require.NoError(t, err)
+
+			storageFake := &infohubfakes.FakeStorage{
+				ConsumeImportChallengeStub: func(ctx context.Context, challenge string) (*storage.ImportChallenge, error) {
+					if test.challenge == nil || test.challenge.Challenge != challenge {
+						return nil, errors.New(errors.NotFound, "import challenge not found")
+					}
+					return test.challenge, nil
+				},
+			}
+			credentialsFake := &infohubfakes.FakeCredentials{
+				ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+					return vp, nil
+				},
+			}
+			cacheFake := &infohubfakes.FakeCache{}
+
+			svc := infohub.New(storageFake, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), infohub.WithImportChallenge("infohub.example.com", 0))
+			res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(errors.BadRequest, err))
+				assert.Equal(t, 0, cacheFake.SetCallCount())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, res.ImportIds, 1)
+			assert.Equal(t, 1, storageFake.ConsumeImportChallengeCallCount())
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package infohub
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// evaluationResult records the outcome of the policy evaluations of an export.
+type evaluationResult struct {
+	// Evaluated contains the names of the successfully evaluated policies.
+	Evaluated []string
+	// Failed contains the errors of the failed policy evaluations by policy name.
+	Failed map[string]error
+}
+
+// FailedPolicies returns the sorted names of the policies whose evaluation failed.
+func (r *evaluationResult) FailedPolicies() []string {
+	names := make([]string, 0, len(r.Failed))
+	for name := range r.Failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Err returns an error aggregating all failed evaluations or nil if
+// all policies are evaluated successfully.
+func (r *evaluationResult) Err() error {
+	if len(r.Failed) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, name := range r.FailedPolicies() {
+		errs = append(errs, fmt.Errorf("%s: %w", name, r.Failed[name]))
+	}
+
+	return errors.New(fmt.Sprintf("%d of %d policies failed", len(r.Failed), len(r.Failed)+len(r.Evaluated)), stderrors.Join(errs...))
+}
+
+// evaluatePolicies evaluates the given export policies concurrently, but with
+// no more than policyWorkers evaluations at a time. A failed evaluation does not
+// stop the evaluation of the remaining policies. The export parameters are
+// templated into the policy input. The optional done callback is called after
+// each evaluation with its result.
+func (s *Service) evaluatePolicies(ctx context.Context, exportCfg *storage.ExportConfiguration, params *exportParameters, policies []string, done func(policy string, err error)) *evaluationResult {
+	res := &evaluationResult{Failed: make(map[string]error)}
+
+	var mu sync.Mutex
+	var g errgroup.Group
+	g.SetLimit(s.policyWorkers)
+	for _, policy := range policies {
+		g.Go(func() error {
+			input, err := params.policyInput(exportCfg.Policies[policy])
+			if err == nil {
+				cacheKey := exportCacheKey(exportCfg.ExportName, policy, params)
+				_, err = s.policy.Evaluate(ctx, policy, input, cacheKey, exportCfg.CacheTTL)
+			}
+
+			mu.Lock()
+			if err != nil {
+				res.Failed[policy] = err
+			} else {
+				res.Evaluated = append(res.Evaluated, policy)
+			}
+			mu.Unlock()
+
+			if done != nil {
+				done(policy, err)
+			}
+
+			// errors are collected in the result, so that the
+			// evaluation of other policies is not interrupted
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	sort.Strings(res.Evaluated)
+	return res
+}
@@ -0,0 +1,321 @@
+package infohub
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/scheduler"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// ListExports returns all export configurations.
+func (s *Service) ListExports(ctx context.Context) ([]*infohub.ExportConfiguration, error) {
+	logger := s.logger.With(zap.String("operation", "listExports"))
+
+	if err := s.authorizeAdmin(ctx, "listExports", ""); err != nil {
+		logger.Error("export configuration management is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	configs, err := s.storage.ExportConfigurations(ctx)
+	if err != nil {
+		logger.Error("error getting export configurations", zap.Error(err))
+		return nil, err
+	}
+
+	res := make([]*infohub.ExportConfiguration, 0, len(configs))
+	for _, cfg := range configs {
+		res = append(res, toExportConfigurationResult(cfg))
+	}
+
+	return res, nil
+}
+
+// CreateExport stores a new export configuration.
+func (s *Service) CreateExport(ctx context.Context, req *infohub.ExportConfiguration) (*infohub.ExportConfiguration, error) {
+	logger := s.logger.With(
+		zap.String("operation", "createExport"),
+		zap.String("exportName", req.ExportName),
+	)
+
+	if err := s.authorizeAdmin(ctx, "createExport", req.ExportName); err != nil {
+		logger.Error("export configuration management is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	if err := validateExportConfiguration(req); err != nil {
+		logger.Error("invalid export configuration", zap.Error(err))
+		return nil, err
+	}
+
+	cfg := toStorageExportConfiguration(req)
+	if err := s.storage.CreateExportConfiguration(ctx, cfg); err != nil {
+		logger.Error("error creating export configuration", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("export configuration created")
+	return toExportConfigurationResult(cfg), nil
+}
+
+// GetExport returns the export configuration with the given name.
+func (s *Service) GetExport(ctx context.Context, req *infohub.ExportConfigurationRequest) (*infohub.ExportConfiguration, error) {
+	logger := s.logger.With(
+		zap.String("operation", "getExport"),
+		zap.String("exportName", req.ExportName),
+	)
+
+	if err := s.authorizeAdmin(ctx, "getExport", req.ExportName); err != nil {
+		logger.Error("export configuration management is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	cfg, err := s.storage.ExportConfiguration(ctx, req.ExportName)
+	if err != nil {
+		logger.Error("error getting export configuration", zap.Error(err))
+		return nil, err
+	}
+
+	return toExportConfigurationResult(cfg), nil
+}
+
+// UpdateExport replaces the export configuration with the given name.
+func (s *Service) UpdateExport(ctx context.Context, req *infohub.ExportConfiguration) (*infohub.ExportConfiguration, error) {
+	logger := s.logger.With(
+		zap.String("operation", "updateExport"),
+		zap.String("exportName", req.ExportName),
+	)
+
+	if err := s.authorizeAdmin(ctx, "updateExport", req.ExportName); err != nil {
+		logger.Error("export configuration management is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	if err := validateExportConfiguration(req); err != nil {
+		logger.Error("invalid export configuration", zap.Error(err))
+		return nil, err
+	}
+
+	cfg := toStorageExportConfiguration(req)
+	if err := s.storage.UpdateExportConfiguration(ctx, cfg); err != nil {
+		logger.Error("error updating export configuration", zap.Error(err))
+		return nil, err
+	}
+
+	logger.Info("export configuration updated")
+	return toExportConfigurationResult(cfg), nil
+}
+
+// DeleteExport removes the export configuration with the given name.
+func (s *Service) DeleteExport(ctx context.Context, req *infohub.ExportConfigurationRequest) error {
+	logger := s.logger.With(
+		zap.String("operation", "deleteExport"),
+		zap.String("exportName", req.ExportName),
+	)
+
+	if err := s.authorizeAdmin(ctx, "deleteExport", req.ExportName); err != nil {
+		logger.Error("export configuration management is not authorized", zap.Error(err))
+		return err
+	}
+
+	if err := s.storage.DeleteExportConfiguration(ctx, req.ExportName); err != nil {
+		logger.Error("error deleting export configuration", zap.Error(err))
+		return err
+	}
+
+	logger.Info("export configuration deleted")
+	return nil
+}
+
+// validateExportConfiguration validates the fields of the export
+// configuration which are not already validated by the API design.
+func validateExportConfiguration(cfg *infohub.ExportConfiguration) error {
+	if cfg.Schedule != nil {
+		if err := scheduler.ValidateSchedule(*cfg.Schedule); err != nil {
+			return errors.New(errors.BadRequest, "invalid export schedule", err)
+		}
+	}
+	params := toStorageExportParameters(cfg.Parameters)
+	if err := validateParameters(params); err != nil {
+		return errors.New(errors.BadRequest, "invalid export parameters", err)
+	}
+	if cfg.Schedule != nil {
+		exportCfg := &storage.ExportConfiguration{Parameters: params}
+		if required := exportCfg.RequiredParameters(); len(required) > 0 {
+			return errors.New(errors.BadRequest, "scheduled exports cannot have required parameters without default: "+strings.Join(required, ", "))
+		}
+	}
+	if err := validatePolicyOrder(cfg.Policies, cfg.PolicyOrder); err != nil {
+		return errors.New(errors.BadRequest, "invalid export policy order", err)
+	}
+	if cfg.Format == storage.FormatSDJWT && !singleCredential(cfg.Layout, len(cfg.Policies)) {
+		return errors.New(errors.BadRequest, "SD-JWT exports of more than one policy must use the merged layout")
+	}
+	return nil
+}
+
+// validatePolicyOrder checks that the policy order, if any,
+// lists every policy of the export exactly once.
+func validatePolicyOrder(policies map[string]interface{}, order []string) error {
+	if order == nil {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(order))
+	for _, name := range order {
+		if _, ok := policies[name]; !ok {
+			return fmt.Errorf("policy %q is not a policy of the export", name)
+		}
+		if seen[name] {
+			return fmt.Errorf("policy %q is listed more than once", name)
+		}
+		seen[name] = true
+	}
+	if len(seen) != len(policies) {
+		return fmt.Errorf("policy order must list all %d policies of the export", len(policies))
+	}
+
+	return nil
+}
+
+func toStorageExportConfiguration(cfg *infohub.ExportConfiguration) *storage.ExportConfiguration {
+	res := &storage.ExportConfiguration{
+		ExportName:           cfg.ExportName,
+		Contexts:             cfg.Contexts,
+		Policies:             cfg.Policies,
+		CacheTTL:             cfg.CacheTTL,
+		Issuer:               cfg.Issuer,
+		KeyNamespace:         cfg.KeyNamespace,
+		Key:                  cfg.Key,
+		Layout:               cfg.Layout,
+		CredentialTypes:      cfg.CredentialTypes,
+		StaleWhileRevalidate: cfg.StaleWhileRevalidate,
+		Parameters:           toStorageExportParameters(cfg.Parameters),
+		Format:               cfg.Format,
+		SelectiveDisclosure:  cfg.SelectiveDisclosure,
+		PolicyOrder:          cfg.PolicyOrder,
+	}
+	// the order of the policies is stored, so that it
+	// doesn't depend on the order of the map keys
+	res.PolicyOrder = res.PolicyNames()
+	if cfg.Authorization != nil {
+		res.Authorization = &storage.ExportAuthorization{
+			Scopes: cfg.Authorization.Scopes,
+			Claims: cfg.Authorization.Claims,
+		}
+		if cfg.Authorization.Policy != nil {
+			res.Authorization.Policy = *cfg.Authorization.Policy
+		}
+	}
+	if cfg.Schedule != nil {
+		res.Schedule = *cfg.Schedule
+	}
+	if cfg.ResultSchema != nil {
+		res.ResultSchema = *cfg.ResultSchema
+	}
+	if cfg.CredentialSchema != nil {
+		res.CredentialSchema = &storage.CredentialSchema{
+			ID:   cfg.CredentialSchema.ID,
+			Type: cfg.CredentialSchema.Type,
+		}
+	}
+	return res
+}
+
+func toExportConfigurationResult(cfg *storage.ExportConfiguration) *infohub.ExportConfiguration {
+	layout := cfg.Layout
+	if layout == "" {
+		layout = storage.LayoutCredentialPerPolicy
+	}
+	format := cfg.Format
+	if format == "" {
+		format = storage.FormatJSONLD
+	}
+
+	res := &infohub.ExportConfiguration{
+		ExportName:           cfg.ExportName,
+		Contexts:             cfg.Contexts,
+		Policies:             cfg.Policies,
+		CacheTTL:             cfg.CacheTTL,
+		Issuer:               cfg.Issuer,
+		KeyNamespace:         cfg.KeyNamespace,
+		Key:                  cfg.Key,
+		Layout:               layout,
+		CredentialTypes:      cfg.CredentialTypes,
+		StaleWhileRevalidate: cfg.StaleWhileRevalidate,
+		Parameters:           toExportParametersResult(cfg.Parameters),
+		Format:               format,
+		SelectiveDisclosure:  cfg.SelectiveDisclosure,
+		PolicyOrder:          cfg.PolicyNames(),
+	}
+	if cfg.Authorization != nil {
+		res.Authorization = &infohub.ExportAuthorization{
+			Scopes: cfg.Authorization.Scopes,
+			Claims: cfg.Authorization.Claims,
+		}
+		if cfg.Authorization.Policy != "" {
+			res.Authorization.Policy = &cfg.Authorization.Policy
+		}
+	}
+	if cfg.Schedule != "" {
+		res.Schedule = &cfg.Schedule
+	}
+	if cfg.ResultSchema != "" {
+		res.ResultSchema = &cfg.ResultSchema
+	}
+	if cfg.CredentialSchema != nil {
+		res.CredentialSchema = &infohub.CredentialSchema{
+			ID:   cfg.CredentialSchema.ID,
+			Type: cfg.CredentialSchema.Type,
+		}
+	}
+	return res
+}
+
+func toStorageExportParameters(params []*infohub.ExportParameter) []*storage.ExportParameter {
+	var res []*storage.ExportParameter
+	for _, p := range params {
+		param := &storage.ExportParameter{
+			Name:     p.Name,
+			Type:     p.Type,
+			Required: p.Required,
+			Default:  p.Default,
+		}
+		if p.Pattern != nil {
+			param.Pattern = *p.Pattern
+		}
+		if p.Description != nil {
+			param.Description = *p.Description
+		}
+		res = append(res, param)
+	}
+	return res
+}
+
+func toExportParametersResult(params []*storage.ExportParameter) []*infohub.ExportParameter {
+	var res []*infohub.ExportParameter
+	for _, p := range params {
+		param := &infohub.ExportParameter{
+			Name:     p.Name,
+			Type:     p.Type,
+			Required: p.Required,
+			Default:  p.Default,
+		}
+		if param.Type == "" {
+			param.Type = storage.ParameterString
+		}
+		if p.Pattern != "" {
+			param.Pattern = &p.Pattern
+		}
+		if p.Description != "" {
+			param.Description = &p.Description
+		}
+		res = append(res, param)
+	}
+	return res
+}
@@ -0,0 +1,411 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+var testExportConfiguration = &goainfohub.ExportConfiguration{
+	ExportName:   "testexport",
+	Contexts:     []string{"https://www.w3.org/2018/credentials/examples/v1"},
+	Policies:     map[string]interface{}{"test/test/1.0": map[string]interface{}{"hello": "test world"}},
+	CacheTTL:     ptr.Int(60),
+	Issuer:       "did:web:example.com",
+	KeyNamespace: "transit",
+	Key:          "key1",
+	Layout:       "credentialPerPolicy",
+	Format:       "jsonld",
+	PolicyOrder:  []string{"test/test/1.0"},
+}
+
+// adminCtx carries the claims of a client which may manage export configurations.
+var adminCtx = claims.NewContext(context.Background(), claims.Claims{"sub": "admin", "scope": "infohub:admin"})
+
+func TestService_ListExports(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage *infohubfakes.FakeStorage
+
+		res     []*goainfohub.ExportConfiguration
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "error getting export configurations",
+			storage: &infohubfakes.FakeStorage{
+				ExportConfigurationsStub: func(ctx context.Context) ([]*storage.ExportConfiguration, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: "some error",
+		},
+		{
+			name: "no export configurations",
+			storage: &infohubfakes.FakeStorage{
+				ExportConfigurationsStub: func(ctx context.Context) ([]*storage.ExportConfiguration, error) {
+					return nil, nil
+				},
+			},
+			res: []*goainfohub.ExportConfiguration{},
+		},
+		{
+			name: "export configurations are returned",
+			storage: &infohubfakes.FakeStorage{
+				ExportConfigurationsStub: func(ctx context.Context) ([]*storage.ExportConfiguration, error) {
+					return []*storage.ExportConfiguration{
+						{
+							ExportName:   "testexport",
+							Contexts:     []string{"https://www.w3.org/2018/credentials/examples/v1"},
+							Policies:     map[string]interface{}{"test/test/1.0": map[string]interface{}{"hello": "test world"}},
+							CacheTTL:     ptr.Int(60),
+							Issuer:       "did:web:example.com",
+							KeyNamespace: "transit",
+							Key:          "key1",
+						},
+					}, nil
+				},
+			},
+			res: []*goainfohub.ExportConfiguration{testExportConfiguration},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := infohub.New(test.storage, nil, nil, nil, nil, zap.NewNop())
+			res, err := svc.ListExports(adminCtx)
+			if err != nil {
+				assert.Nil(t, res)
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+			}
+		})
+	}
+}
+
+func TestService_CreateExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *goainfohub.ExportConfiguration
+		storage *infohubfakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "export configuration already exists",
+			storage: &infohubfakes.FakeStorage{
+				CreateExportConfigurationStub: func(ctx context.Context, cfg *storage.ExportConfiguration) error {
+					return errors.New(errors.Exist, "export configuration already exists")
+				},
+			},
+			errkind: errors.Exist,
+			errtext: "export configuration already exists",
+		},
+		{
+			name:    "export configuration with invalid schedule",
+			cfg:     withSchedule(testExportConfiguration, "every minute"),
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "invalid export schedule",
+		},
+		{
+			name: "export configuration with invalid parameter default",
+			cfg: withParameters(testExportConfiguration, &goainfohub.ExportParameter{
+				Name:    "depth",
+				Type:    "integer",
+				Default: "deep",
+			}),
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "invalid export parameters",
+		},
+		{
+			name: "scheduled export configuration with required parameter",
+			cfg: withSchedule(withParameters(testExportConfiguration, &goainfohub.ExportParameter{
+				Name:     "depth",
+				Type:     "integer",
+				Required: true,
+			}), "*/5 * * * *"),
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: `scheduled exports cannot have required parameters without default: depth`,
+		},
+		{
+			name:    "export configuration with policy order missing a policy",
+			cfg:     withPolicyOrder(testExportConfiguration, "other/test/1.0"),
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "invalid export policy order",
+		},
+		{
+			name:    "export configuration with duplicate policy in policy order",
+			cfg:     withPolicyOrder(testExportConfiguration, "test/test/1.0", "test/test/1.0"),
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "invalid export policy order",
+		},
+		{
+			name:    "SD-JWT export configuration with more than one credential",
+			cfg:     withSDJWT(testExportConfiguration, "credentialPerPolicy", "test/other/1.0"),
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "SD-JWT exports of more than one policy must use the merged layout",
+		},
+		{
+			name:    "export configuration is created",
+			storage: &infohubfakes.FakeStorage{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := test.cfg
+			if cfg == nil {
+				cfg = testExportConfiguration
+			}
+
+			svc := infohub.New(test.storage, nil, nil, nil, nil, zap.NewNop())
+			res, err := svc.CreateExport(adminCtx, cfg)
+			if err != nil {
+				assert.Nil(t, res)
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, testExportConfiguration, res)
+				assert.Equal(t, 1, test.storage.CreateExportConfigurationCallCount())
+				_, cfg := test.storage.CreateExportConfigurationArgsForCall(0)
+				assert.Equal(t, "testexport", cfg.ExportName)
+				assert.Equal(t, "did:web:example.com", cfg.Issuer)
+			}
+		})
+	}
+}
+
+func TestService_UpdateExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *goainfohub.ExportConfiguration
+		storage *infohubfakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "export configuration not found",
+			storage: &infohubfakes.FakeStorage{
+				UpdateExportConfigurationStub: func(ctx context.Context, cfg *storage.ExportConfiguration) error {
+					return errors.New(errors.NotFound, "export configuration not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "export configuration not found",
+		},
+		{
+			name:    "SD-JWT export configuration with more than one credential",
+			cfg:     withSDJWT(testExportConfiguration, "", "test/other/1.0"),
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.BadRequest,
+			errtext: "SD-JWT exports of more than one policy must use the merged layout",
+		},
+		{
+			name:    "export configuration is updated",
+			storage: &infohubfakes.FakeStorage{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := test.cfg
+			if cfg == nil {
+				cfg = testExportConfiguration
+			}
+
+			svc := infohub.New(test.storage, nil, nil, nil, nil, zap.NewNop())
+			res, err := svc.UpdateExport(adminCtx, cfg)
+			if err != nil {
+				assert.Nil(t, res)
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, testExportConfiguration, res)
+			}
+		})
+	}
+}
+
+func TestService_DeleteExport(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage *infohubfakes.FakeStorage
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "export configuration not found",
+			storage: &infohubfakes.FakeStorage{
+				DeleteExportConfigurationStub: func(ctx context.Context, name string) error {
+					return errors.New(errors.NotFound, "export configuration not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "export configuration not found",
+		},
+		{
+			name:    "export configuration is deleted",
+			storage: &infohubfakes.FakeStorage{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			svc := infohub.New(test.storage, nil, nil, nil, nil, zap.NewNop())
+			err := svc.DeleteExport(adminCtx, &goainfohub.ExportConfigurationRequest{ExportName: "testexport"})
+			if err != nil {
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+			}
+		})
+	}
+}
+
+func TestService_ExportConfigurationAuthorization(t *testing.T) {
+	tests := []struct {
+		name   string
+		claims claims.Claims
+		opts   []infohub.Option
+
+		errtext string
+	}{
+		{
+			name:    "unauthenticated client",
+			errtext: "managing export configurations requires an authenticated client",
+		},
+		{
+			name:    "client without admin scope",
+			claims:  claims.Claims{"sub": "client-1", "scope": "import export"},
+			errtext: `missing scope "infohub:admin"`,
+		},
+		{
+			name:    "client without configured admin scope",
+			claims:  claims.Claims{"sub": "client-1", "scope": "infohub:admin"},
+			opts:    []infohub.Option{infohub.WithAdminScope("exports:manage")},
+			errtext: `missing scope "exports:manage"`,
+		},
+		{
+			name:   "client with configured admin scope",
+			claims: claims.Claims{"sub": "client-1", "scope": "exports:manage"},
+			opts:   []infohub.Option{infohub.WithAdminScope("exports:manage")},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.claims != nil {
+				ctx = claims.NewContext(ctx, test.claims)
+			}
+
+			store := &infohubfakes.FakeStorage{
+				ExportConfigurationStub: func(ctx context.Context, name string) (*storage.ExportConfiguration, error) {
+					return &storage.ExportConfiguration{ExportName: name}, nil
+				},
+			}
+			svc := infohub.New(store, nil, nil, nil, nil, zap.NewNop(), test.opts...)
+			req := &goainfohub.ExportConfigurationRequest{ExportName: "testexport"}
+
+			errs := []error{}
+			_, err := svc.ListExports(ctx)
+			errs = append(errs, err)
+			_, err = svc.CreateExport(ctx, testExportConfiguration)
+			errs = append(errs, err)
+			_, err = svc.GetExport(ctx, req)
+			errs = append(errs, err)
+			_, err = svc.UpdateExport(ctx, testExportConfiguration)
+			errs = append(errs, err)
+			errs = append(errs, svc.DeleteExport(ctx, req))
+
+			for _, err := range errs {
+				if test.errtext == "" {
+					assert.NoError(t, err)
+					continue
+				}
+				assert.True(t, errors.Is(errors.Forbidden, err))
+				assert.ErrorContains(t, err, test.errtext)
+			}
+
+			if test.errtext != "" {
+				// configurations are neither read nor changed
+				assert.Zero(t, store.ExportConfigurationsCallCount())
+				assert.Zero(t, store.CreateExportConfigurationCallCount())
+				assert.Zero(t, store.ExportConfigurationCallCount())
+				assert.Zero(t, store.UpdateExportConfigurationCallCount())
+				assert.Zero(t, store.DeleteExportConfigurationCallCount())
+			}
+		})
+	}
+}
+
+func withSchedule(cfg *goainfohub.ExportConfiguration, schedule string) *goainfohub.ExportConfiguration {
+	res := *cfg
+	res.Schedule = &schedule
+	return &res
+}
+
+func withParameters(cfg *goainfohub.ExportConfiguration, params ...*goainfohub.ExportParameter) *goainfohub.ExportConfiguration {
+	res := *cfg
+	res.Parameters = params
+	return &res
+}
+
+func withPolicyOrder(cfg *goainfohub.ExportConfiguration, order ...string) *goainfohub.ExportConfiguration {
+	res := *cfg
+	res.PolicyOrder = order
+	return &res
+}
+
+func withSDJWT(cfg *goainfohub.ExportConfiguration, layout string, policies ...string) *goainfohub.ExportConfiguration {
+	res := *cfg
+	res.Format = "sd-jwt"
+	res.Layout = layout
+	res.Policies = make(map[string]interface{}, len(cfg.Policies)+len(policies))
+	for name, input := range cfg.Policies {
+		res.Policies[name] = input
+	}
+	for _, name := range policies {
+		res.Policies[name] = nil
+	}
+	res.PolicyOrder = nil
+	return &res
+}
@@ -0,0 +1,191 @@
+package infohub
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// acceptedFormats maps the media types which can be requested with the
+// Accept header to export formats. Unsigned JSON can't be requested and
+// is only exported when it is the format of the export configuration.
+var acceptedFormats = map[string]string{
+	"application/ld+json":    storage.FormatJSONLD,
+	"application/vp+ld+json": storage.FormatJSONLD,
+	jwtvc.MediaTypeVPJWT:     storage.FormatJWT,
+	jwtvc.MediaTypeVCSDJWT:   storage.FormatSDJWT,
+	jwtvc.MediaTypeDCSDJWT:   storage.FormatSDJWT,
+}
+
+// formatContentTypes are the media types of the JWT based export formats.
+var formatContentTypes = map[string]string{
+	storage.FormatJWT:   jwtvc.MediaTypeVPJWT,
+	storage.FormatSDJWT: jwtvc.MediaTypeVCSDJWT,
+}
+
+// exportFormat returns the format of an export. The supported media type
+// with the highest quality in the Accept header is preferred, otherwise
+// the format of the export configuration is used. SD-JWT can't be requested
+// for exports of more than one credential.
+func exportFormat(exportCfg *storage.ExportConfiguration, accept *string) string {
+	if accept != nil {
+		var format string
+		var quality float64
+		for _, part := range strings.Split(*accept, ",") {
+			mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+			if err != nil {
+				continue
+			}
+			f, ok := acceptedFormats[mediaType]
+			if !ok || (f == storage.FormatSDJWT && !singleCredential(exportCfg.Layout, len(exportCfg.Policies))) {
+				continue
+			}
+			q := 1.0
+			if v, ok := params["q"]; ok {
+				if q, err = strconv.ParseFloat(v, 64); err != nil {
+					continue
+				}
+			}
+			if q > quality {
+				format, quality = f, q
+			}
+		}
+		if format != "" {
+			return format
+		}
+	}
+
+	if exportCfg.Format != "" {
+		return exportCfg.Format
+	}
+	return storage.FormatJSONLD
+}
+
+// contentType returns the media type of the export result in the given
+// format. JSON results don't have a specific media type.
+func contentType(format string) *string {
+	if ct, ok := formatContentTypes[format]; ok {
+		return &ct
+	}
+	return nil
+}
+
+// encodeExport returns the export data in the given format. Except for
+// unsigned JSON, the policy results are wrapped in credentials which are
+// signed with the key of the export configuration.
+func (s *Service) encodeExport(ctx context.Context, exportCfg *storage.ExportConfiguration, format string, policyResults map[string][]byte) (interface{}, error) {
+	if format == storage.FormatJSON {
+		results := make(map[string]interface{}, len(policyResults))
+		for policy, res := range policyResults {
+			var v interface{}
+			if err := json.Unmarshal(res, &v); err != nil {
+				return nil, err
+			}
+			results[policy] = v
+		}
+		return results, nil
+	}
+
+	subjects, err := exportSubjects(exportCfg, policyResults)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == storage.FormatSDJWT && len(subjects) != 1 {
+		return nil, errors.New(errors.Internal, "SD-JWT export contains more than one credential")
+	}
+
+	presentation, err := s.exportPresentation(exportCfg, subjects)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case storage.FormatJSONLD:
+		// add proofs to the verifiable presentation
+		return s.signer.PresentationProof(ctx, exportCfg.Issuer, exportCfg.KeyNamespace, exportCfg.Key, presentation)
+	case storage.FormatJWT:
+		return s.presentationJWT(ctx, exportCfg, presentation)
+	case storage.FormatSDJWT:
+		return s.credentialSDJWT(ctx, exportCfg, presentation.Credentials()[0])
+	}
+
+	return nil, errors.New(errors.Internal, "unknown export format "+strconv.Quote(format))
+}
+
+// presentationJWT returns a VP-JWT of the presentation
+// containing its credentials as VC-JWTs.
+func (s *Service) presentationJWT(ctx context.Context, exportCfg *storage.ExportConfiguration, presentation *verifiable.Presentation) (string, error) {
+	var credentials []string
+	for _, vc := range presentation.Credentials() {
+		cred, err := toMap(vc)
+		if err != nil {
+			return "", err
+		}
+		jwt, err := s.signer.SignJWT(ctx, exportCfg.Issuer, exportCfg.KeyNamespace, exportCfg.Key, jwtvc.TypeJWT, jwtvc.CredentialClaims(cred))
+		if err != nil {
+			return "", err
+		}
+		credentials = append(credentials, jwt)
+	}
+
+	vp, err := toMap(presentation)
+	if err != nil {
+		return "", err
+	}
+
+	return s.signer.SignJWT(ctx, exportCfg.Issuer, exportCfg.KeyNamespace, exportCfg.Key, jwtvc.TypeJWT, jwtvc.PresentationClaims(vp, credentials))
+}
+
+// credentialSDJWT returns an SD-JWT VC of the credential, in which the
+// claims configured in the export are selectively disclosable. All
+// disclosures are appended, as the export discloses all claims to its
+// consumer, who can then present them selectively.
+func (s *Service) credentialSDJWT(ctx context.Context, exportCfg *storage.ExportConfiguration, vc interface{}) (string, error) {
+	cred, err := toMap(vc)
+	if err != nil {
+		return "", err
+	}
+
+	vct := exportCfg.ExportName
+	if c, ok := vc.(*verifiable.Credential); ok && jwtvc.VCType(c.Types) != "" {
+		vct = jwtvc.VCType(c.Types)
+	}
+
+	claims, err := jwtvc.SDJWTClaims(cred, vct)
+	if err != nil {
+		return "", err
+	}
+	disclosures, err := jwtvc.SelectiveDisclosure(claims, exportCfg.SelectiveDisclosure)
+	if err != nil {
+		return "", err
+	}
+
+	jwt, err := s.signer.SignJWT(ctx, exportCfg.Issuer, exportCfg.KeyNamespace, exportCfg.Key, jwtvc.TypeSDJWT, claims)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(append([]string{jwt}, disclosures...), "~") + "~", nil
+}
+
+// toMap returns the JSON representation of a credential or presentation.
+func toMap(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
@@ -0,0 +1,261 @@
+package infohub_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_Export_Format(t *testing.T) {
+	policies := map[string]interface{}{
+		"test/a/1.0": map[string]interface{}{},
+		"test/b/1.0": map[string]interface{}{},
+	}
+	tests := []struct {
+		name                string
+		accept              string
+		format              string
+		layout              string
+		selectiveDisclosure []string
+
+		contentType string
+		signed      int
+		result      interface{}
+		errkind     errors.Kind
+		errtext     string
+	}{
+		{
+			name:   "JSON-LD presentation by default",
+			result: map[string]interface{}{"id": "did:web:example.com"},
+		},
+		{
+			name:   "JSON-LD presentation is requested",
+			accept: "application/vp+ld+json",
+			format: storage.FormatJWT,
+			result: map[string]interface{}{"id": "did:web:example.com"},
+		},
+		{
+			name:        "VC-JWT presentation is requested",
+			accept:      "application/vp+jwt",
+			contentType: "application/vp+jwt",
+			signed:      3,
+			result:      "vp.jwt",
+		},
+		{
+			name:        "VC-JWT presentation is configured",
+			accept:      "application/json",
+			format:      storage.FormatJWT,
+			contentType: "application/vp+jwt",
+			signed:      3,
+			result:      "vp.jwt",
+		},
+		{
+			name:        "media type with highest quality is preferred",
+			accept:      "application/vp+jwt;q=0.5, application/vc+sd-jwt",
+			layout:      storage.LayoutMerged,
+			contentType: "application/vc+sd-jwt",
+			signed:      1,
+			result:      "sd.jwt~",
+		},
+		{
+			name:        "SD-JWT is not accepted for more than one credential",
+			accept:      "application/vc+sd-jwt, application/vp+jwt;q=0.5",
+			contentType: "application/vp+jwt",
+			signed:      3,
+			result:      "vp.jwt",
+		},
+		{
+			name:   "SD-JWT is not requested for more than one credential",
+			accept: "application/vc+sd-jwt",
+			result: map[string]interface{}{"id": "did:web:example.com"},
+		},
+		{
+			name:   "unsigned JSON is configured",
+			format: storage.FormatJSON,
+			result: map[string]interface{}{
+				"test/a/1.0": map[string]interface{}{"name": "a"},
+				"test/b/1.0": map[string]interface{}{"name": "b"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storageFake := &infohubfakes.FakeStorage{
+				ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+					return &storage.ExportConfiguration{
+						ExportName:          "testexport",
+						Policies:            policies,
+						Layout:              test.layout,
+						Format:              test.format,
+						SelectiveDisclosure: test.selectiveDisclosure,
+					}, nil
+				},
+			}
+			cacheFake := &infohubfakes.FakeCache{
+				GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+					name := strings.TrimPrefix(strings.TrimSuffix(key, "/1.0"), "testexport:test/")
+					return []byte(`{"name":"` + name + `"}`), nil
+				},
+			}
+			signerFake := newJWTSignerFake()
+
+			req := &goainfohub.ExportRequest{ExportName: "testexport"}
+			if test.accept != "" {
+				req.Accept = &test.accept
+			}
+
+			svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop())
+			res, err := svc.Export(context.Background(), req)
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(test.errkind, err))
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.result, res.Result)
+			assert.Equal(t, test.signed, signerFake.SignJWTCallCount())
+			if test.contentType != "" {
+				require.NotNil(t, res.ContentType)
+				assert.Equal(t, test.contentType, *res.ContentType)
+			} else {
+				assert.Nil(t, res.ContentType)
+			}
+		})
+	}
+}
+
+func TestService_Export_JWT(t *testing.T) {
+	storageFake := &infohubfakes.FakeStorage{
+		ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+			return &storage.ExportConfiguration{
+				ExportName:      "testexport",
+				Policies:        map[string]interface{}{"test/test/1.0": map[string]interface{}{}},
+				Issuer:          "did:web:issuer.example.com",
+				KeyNamespace:    "transit",
+				Key:             "key1",
+				CredentialTypes: []string{"ComplianceCredential"},
+				Format:          storage.FormatJWT,
+			}, nil
+		},
+	}
+	cacheFake := &infohubfakes.FakeCache{
+		GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+			return []byte(`{"id":"did:web:participant.example.com","allow":true}`), nil
+		},
+	}
+	signerFake := newJWTSignerFake()
+
+	svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop())
+	res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+	require.NoError(t, err)
+	assert.Equal(t, "vp.jwt", res.Result)
+
+	require.Equal(t, 2, signerFake.SignJWTCallCount())
+	_, issuer, namespace, key, typ, claims := signerFake.SignJWTArgsForCall(0)
+	assert.Equal(t, "did:web:issuer.example.com", issuer)
+	assert.Equal(t, "transit", namespace)
+	assert.Equal(t, "key1", key)
+	assert.Equal(t, "JWT", typ)
+	assert.Equal(t, "did:web:issuer.example.com", claims["iss"])
+	assert.Equal(t, "did:web:participant.example.com", claims["sub"])
+	vc := claims["vc"].(map[string]interface{})
+	assert.Equal(t, []interface{}{"VerifiableCredential", "ComplianceCredential"}, vc["type"])
+
+	_, _, _, _, typ, claims = signerFake.SignJWTArgsForCall(1)
+	assert.Equal(t, "JWT", typ)
+	assert.Equal(t, "did:web:issuer.example.com", claims["iss"])
+	vp := claims["vp"].(map[string]interface{})
+	assert.Equal(t, []string{"vc.jwt"}, vp["verifiableCredential"])
+}
+
+func TestService_Export_SDJWT(t *testing.T) {
+	storageFake := &infohubfakes.FakeStorage{
+		ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+			return &storage.ExportConfiguration{
+				ExportName:          "testexport",
+				Policies:            map[string]interface{}{"test/test/1.0": map[string]interface{}{}},
+				Issuer:              "did:web:issuer.example.com",
+				CredentialTypes:     []string{"https://credentials.example.com/compliance"},
+				Format:              storage.FormatSDJWT,
+				SelectiveDisclosure: []string{"allow", "address.city", "unknown"},
+			}, nil
+		},
+	}
+	cacheFake := &infohubfakes.FakeCache{
+		GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+			return []byte(`{"id":"did:web:participant.example.com","allow":true,"address":{"city":"Berlin"}}`), nil
+		},
+	}
+	signerFake := newJWTSignerFake()
+
+	svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop())
+	res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+	require.NoError(t, err)
+	assert.Equal(t, "application/vc+sd-jwt", *res.ContentType)
+
+	require.Equal(t, 1, signerFake.SignJWTCallCount())
+	_, _, _, _, typ, claims := signerFake.SignJWTArgsForCall(0)
+	assert.Equal(t, "vc+sd-jwt", typ)
+	assert.Equal(t, "https://credentials.example.com/compliance", claims["vct"])
+	assert.Equal(t, "did:web:issuer.example.com", claims["iss"])
+	assert.Equal(t, "did:web:participant.example.com", claims["sub"])
+	assert.Equal(t, "test/test/1.0", claims["infohub:policy"])
+	assert.Equal(t, "sha-256", claims["_sd_alg"])
+	assert.NotContains(t, claims, "allow")
+	assert.Len(t, claims["_sd"], 1)
+	address := claims["address"].(map[string]interface{})
+	assert.NotContains(t, address, "city")
+	assert.Len(t, address["_sd"], 1)
+
+	parts := strings.Split(res.Result.(string), "~")
+	require.Len(t, parts, 4)
+	assert.Equal(t, "sd.jwt", parts[0])
+	assert.Equal(t, "", parts[3])
+
+	var disclosed []string
+	for _, d := range parts[1:3] {
+		data, err := base64.RawURLEncoding.DecodeString(d)
+		require.NoError(t, err)
+		var disclosure []interface{}
+		require.NoError(t, json.Unmarshal(data, &disclosure))
+		require.Len(t, disclosure, 3)
+		disclosed = append(disclosed, disclosure[1].(string))
+	}
+	assert.ElementsMatch(t, []string{"allow", "city"}, disclosed)
+}
+
+// newJWTSignerFake returns a signer fake, which signs presentations and
+// returns JWTs named after the kind of the signed claims.
+func newJWTSignerFake() *infohubfakes.FakeSigner {
+	return &infohubfakes.FakeSigner{
+		PresentationProofStub: func(ctx context.Context, issuer string, namespace string, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
+			return map[string]interface{}{"id": "did:web:example.com"}, nil
+		},
+		SignJWTStub: func(ctx context.Context, issuer string, namespace string, key string, typ string, claims map[string]interface{}) (string, error) {
+			switch {
+			case claims["vp"] != nil:
+				return "vp.jwt", nil
+			case typ == "vc+sd-jwt":
+				return "sd.jwt", nil
+			}
+			return "vc.jwt", nil
+		},
+	}
+}
@@ -0,0 +1,114 @@
+package infohub
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// idempotentImport performs an import request with an idempotency key only
+// once. The key is claimed before the import, so that concurrent requests
+// with the same key are rejected, and the result of the import is stored
+// and returned for repeated requests. Idempotency keys are scoped by client.
+func (s *Service) idempotentImport(ctx context.Context, req *infohub.ImportRequest, logger *zap.Logger) (*infohub.ImportResult, error) {
+	c, _ := claims.FromContext(ctx)
+	id := c.ClientID() + ":" + *req.IdempotencyKey
+	logger = logger.With(zap.String("idempotencyKey", *req.IdempotencyKey))
+
+	requestHash, err := importRequestHash(req)
+	if err != nil {
+		logger.Error("error computing import request hash", zap.Error(err))
+		return nil, errors.New("error computing import request hash", err)
+	}
+
+	err = s.storage.CreateIdempotentRequest(ctx, &storage.IdempotentRequest{
+		ID:          id,
+		RequestHash: requestHash,
+		CreatedAt:   time.Now(),
+	})
+	if err != nil {
+		if errors.Is(errors.Exist, err) {
+			return s.repeatedImport(ctx, id, requestHash, logger)
+		}
+		logger.Error("error saving idempotent request", zap.Error(err))
+		return nil, errors.New("error saving idempotent request", err)
+	}
+
+	res, err := s.importData(ctx, req, logger)
+	if err != nil {
+		// failed requests can be repeated with the same key
+		s.releaseIdempotentRequest(ctx, id, logger)
+		return nil, err
+	}
+
+	result, err := json.Marshal(res)
+	if err == nil {
+		err = s.storage.CompleteIdempotentRequest(ctx, id, result)
+	}
+	if err != nil {
+		logger.Error("error saving result of idempotent request", zap.Error(err))
+		s.releaseIdempotentRequest(ctx, id, logger)
+	}
+
+	return res, nil
+}
+
+// repeatedImport returns the stored result of an import request
+// which is repeated with the same idempotency key.
+func (s *Service) repeatedImport(ctx context.Context, id, requestHash string, logger *zap.Logger) (*infohub.ImportResult, error) {
+	previous, err := s.storage.IdempotentRequest(ctx, id)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			// the previous request failed in the meantime
+			return nil, errors.New(errors.Exist, "import request with the same idempotency key is in progress")
+		}
+		logger.Error("error getting idempotent request", zap.Error(err))
+		return nil, errors.New("error getting idempotent request", err)
+	}
+
+	if previous.RequestHash != requestHash {
+		return nil, errors.New(errors.BadRequest, "idempotency key is already used for a different import request")
+	}
+
+	if !previous.Completed {
+		return nil, errors.New(errors.Exist, "import request with the same idempotency key is in progress")
+	}
+
+	var res infohub.ImportResult
+	if err := json.Unmarshal(previous.Result, &res); err != nil {
+		logger.Error("error decoding result of idempotent request", zap.Error(err))
+		return nil, errors.New("error decoding result of idempotent request", err)
+	}
+
+	return &res, nil
+}
+
+func (s *Service) releaseIdempotentRequest(ctx context.Context, id string, logger *zap.Logger) {
+	if err := s.storage.DeleteIdempotentRequest(ctx, id); err != nil {
+		logger.Error("error removing idempotent request", zap.Error(err))
+	}
+}
+
+// importRequestHash returns the hash of the data and parameters of an
+// import request, which identifies requests repeated with the same content.
+func importRequestHash(req *infohub.ImportRequest) (string, error) {
+	params := *req
+	params.IdempotencyKey = nil
+
+	b, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
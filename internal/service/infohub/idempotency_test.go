@@ -0,0 +1,133 @@
+package infohub_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_Import_IdempotencyKey(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"verifiableCredential": [{"credentialSubject": {"hello": "world"}}]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	idempotencyKey := "key-1"
+	newRequest := func(data string) *goainfohub.ImportRequest {
+		return &goainfohub.ImportRequest{Data: []byte(data), IdempotencyKey: &idempotencyKey}
+	}
+	credentialsFake := &infohubfakes.FakeCredentials{}
+	credentialsFake.ParsePresentationReturns(vp, nil)
+
+	t.Run("first request is imported and its result is stored", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{}
+		cacheFake := &infohubfakes.FakeCache{}
+		svc := infohub.New(storageFake, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop())
+
+		res, err := svc.Import(context.Background(), newRequest(`{}`))
+		require.NoError(t, err)
+		require.Len(t, res.ImportIds, 1)
+		assert.Equal(t, 1, cacheFake.SetCallCount())
+
+		require.Equal(t, 1, storageFake.CreateIdempotentRequestCallCount())
+		_, req := storageFake.CreateIdempotentRequestArgsForCall(0)
+		assert.Equal(t, ":key-1", req.ID)
+		assert.NotEmpty(t, req.RequestHash)
+		assert.False(t, req.Completed)
+
+		require.Equal(t, 1, storageFake.CompleteIdempotentRequestCallCount())
+		_, id, result := storageFake.CompleteIdempotentRequestArgsForCall(0)
+		assert.Equal(t, ":key-1", id)
+		var stored goainfohub.ImportResult
+		require.NoError(t, json.Unmarshal(result, &stored))
+		assert.Equal(t, res.ImportIds, stored.ImportIds)
+	})
+
+	t.Run("failed request can be repeated", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{}
+		verifierFake := &infohubfakes.FakeVerifier{}
+		verifierFake.VerifyPresentationReturns(errors.New(errors.BadRequest, "invalid presentation proof"))
+		svc := infohub.New(storageFake, nil, nil, credentialsFake, nil, zap.NewNop(), infohub.WithVerifier(verifierFake))
+
+		_, err := svc.Import(context.Background(), newRequest(`{}`))
+		require.Error(t, err)
+		assert.Equal(t, 0, storageFake.CompleteIdempotentRequestCallCount())
+		require.Equal(t, 1, storageFake.DeleteIdempotentRequestCallCount())
+		_, id := storageFake.DeleteIdempotentRequestArgsForCall(0)
+		assert.Equal(t, ":key-1", id)
+	})
+
+	// the request hash of the first request is used for the repeated requests
+	firstStorage := &infohubfakes.FakeStorage{}
+	_, err = infohub.New(firstStorage, nil, &infohubfakes.FakeCache{}, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop()).
+		Import(context.Background(), newRequest(`{}`))
+	require.NoError(t, err)
+	_, first := firstStorage.CreateIdempotentRequestArgsForCall(0)
+
+	tests := []struct {
+		name     string
+		data     string
+		previous *storage.IdempotentRequest
+		errkind  errors.Kind
+		errtext  string
+		ids      []string
+	}{
+		{
+			name:     "completed request returns stored result",
+			data:     `{}`,
+			previous: &storage.IdempotentRequest{RequestHash: first.RequestHash, Completed: true, Result: []byte(`{"ImportIds":["key"],"Credentials":[]}`)},
+			ids:      []string{"key"},
+		},
+		{
+			name:     "request in progress is rejected",
+			data:     `{}`,
+			previous: &storage.IdempotentRequest{RequestHash: first.RequestHash},
+			errkind:  errors.Exist,
+			errtext:  "import request with the same idempotency key is in progress",
+		},
+		{
+			name:     "key of a different request is rejected",
+			data:     `{"other":"data"}`,
+			previous: &storage.IdempotentRequest{RequestHash: first.RequestHash, Completed: true},
+			errkind:  errors.BadRequest,
+			errtext:  "idempotency key is already used for a different import request",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storageFake := &infohubfakes.FakeStorage{}
+			storageFake.CreateIdempotentRequestReturns(errors.New(errors.Exist, "idempotent request already exists"))
+			storageFake.IdempotentRequestReturns(test.previous, nil)
+			cacheFake := &infohubfakes.FakeCache{}
+			svc := infohub.New(storageFake, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop())
+
+			res, err := svc.Import(context.Background(), newRequest(test.data))
+			assert.Equal(t, 0, cacheFake.SetCallCount())
+			assert.Equal(t, 0, storageFake.DeleteIdempotentRequestCallCount())
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Nil(t, res)
+				assert.True(t, errors.Is(test.errkind, err))
+				assert.Contains(t, err.Error(), test.errtext)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.ids, res.ImportIds)
+		})
+	}
+}
@@ -0,0 +1,508 @@
+package infohub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// Import statuses of credentials.
+const (
+	importStatusImported  = "imported"
+	importStatusDuplicate = "duplicate"
+	importStatusFailed    = "failed"
+)
+
+// Import the given data wrapped as Verifiable Presentation into the Cache.
+// Requests with an idempotency key are only performed once.
+func (s *Service) Import(ctx context.Context, req *infohub.ImportRequest) (*infohub.ImportResult, error) {
+	logger := s.logger.With(zap.String("operation", "import"))
+
+	if err := s.authorizeImport(ctx); err != nil {
+		logger.Error("import is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	if req.IdempotencyKey != nil && *req.IdempotencyKey != "" {
+		return s.idempotentImport(ctx, req, logger)
+	}
+
+	return s.importData(ctx, req, logger)
+}
+
+func (s *Service) importData(ctx context.Context, req *infohub.ImportRequest, logger *zap.Logger) (*infohub.ImportResult, error) {
+	target, err := s.importTarget(ctx, req)
+	if err != nil {
+		logger.Error("invalid import target", zap.Error(err))
+		return nil, err
+	}
+
+	vp, err := s.parsePresentation(ctx, req.Data, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.challengeRequired {
+		if err := s.consumeChallenge(ctx, vp.challenge, vp.domain); err != nil {
+			logger.Error("invalid import challenge", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	// all credentials are checked before any of them is imported, so
+	// that a presentation with rejected credentials is not partially imported
+	if target.key != "" && len(vp.credentials) > 1 {
+		return nil, errors.New(errors.BadRequest, "key can only be given for a presentation with a single credential")
+	}
+
+	c, _ := claims.FromContext(ctx)
+
+	var (
+		entries  []*importEntry
+		keys     = make(map[string]bool)
+		rejected []string
+	)
+	for i, vc := range vp.credentials {
+		cred, err := s.importedCredential(ctx, vc)
+		if err != nil {
+			if !errors.Is(errors.BadRequest, err) {
+				logger.Error("error parsing verifiable credential", zap.Error(err))
+				return nil, err
+			}
+			rejected = append(rejected, rejection(i, nil, err))
+			continue
+		}
+
+		if err := s.checkCredential(ctx, cred); err != nil {
+			if !errors.Is(errors.BadRequest, err) {
+				logger.Error("error checking verifiable credential", zap.Error(err))
+				return nil, err
+			}
+			rejected = append(rejected, rejection(i, cred, err))
+			continue
+		}
+
+		key, err := target.cacheKey(cred)
+		if err != nil {
+			rejected = append(rejected, rejection(i, cred, err))
+			continue
+		}
+		if keys[key] {
+			rejected = append(rejected, rejection(i, cred, fmt.Errorf("duplicate cache key %q", key)))
+			continue
+		}
+		keys[key] = true
+
+		subject, err := s.validateSubject(ctx, target, vp.holder, cred)
+		if err != nil {
+			if !errors.Is(errors.BadRequest, err) {
+				logger.Error("error validating imported data", zap.Error(err))
+				return nil, err
+			}
+			rejected = append(rejected, rejection(i, cred, err))
+			continue
+		}
+
+		entry := &importEntry{index: i, cred: cred, key: key, subject: subject}
+		if s.registry != nil {
+			hash, err := s.hasher.Hash(cred)
+			if err != nil {
+				logger.Error("error computing credential hash", zap.Error(err))
+				return nil, errors.New("error computing credential hash", err)
+			}
+			entry.record = &storage.ImportRecord{
+				ID:           uuid.NewString(),
+				Key:          key,
+				Namespace:    target.namespace,
+				Scope:        target.scope,
+				Hash:         hash,
+				CredentialID: credentialID(cred),
+				Issuer:       credential.Issuer(cred),
+				Holder:       vp.holder,
+				SubjectID:    subjectID(cred),
+				Importer:     c.ClientID(),
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if len(rejected) > 0 {
+		logger.Warn("verifiable credentials are rejected", zap.Strings("rejected", rejected))
+		return nil, errors.New(errors.BadRequest, "verifiable credentials are rejected: "+strings.Join(rejected, "; "))
+	}
+
+	// separate data entries are wrapped in separate verifiable credentials;
+	// each one of them must be placed separately in the cache
+	res := &infohub.ImportResult{}
+	var overwritten []*overwrittenEntry
+	for _, entry := range entries {
+		imported := &infohub.ImportedCredential{
+			Index:  entry.index,
+			Key:    entry.key,
+			Status: importStatusImported,
+		}
+		if id := credentialID(entry.cred); id != "" {
+			imported.CredentialID = &id
+		}
+
+		// credentials which are already imported are not written again
+		// and the key of their previous import is returned
+		previous, err := s.previousImport(ctx, target, entry)
+		if err == nil && previous != nil {
+			imported.Key, imported.Status = previous.Key, importStatusDuplicate
+			imported.ID = &previous.ID
+			res.ImportIds = append(res.ImportIds, previous.Key)
+			res.Credentials = append(res.Credentials, imported)
+			continue
+		}
+		// atomic imports keep the data they overwrite, so that it
+		// can be restored if the import is rolled back
+		var overwrites *overwrittenEntry
+		if err == nil && req.Atomic {
+			overwrites, err = s.overwrittenEntry(ctx, target, entry.key)
+		}
+		if err == nil {
+			err = s.saveEntry(ctx, target, entry, overwrites)
+		}
+
+		if err != nil {
+			logger.Error("error saving imported data to cache", zap.Int("index", entry.index), zap.Error(err))
+			if req.Atomic {
+				return nil, s.rollbackImport(ctx, target, overwritten, entry.index, err)
+			}
+			msg := err.Error()
+			imported.Status, imported.Error = importStatusFailed, &msg
+		} else {
+			if overwrites != nil {
+				overwritten = append(overwritten, overwrites)
+			}
+			res.ImportIds = append(res.ImportIds, entry.key)
+			if entry.record != nil {
+				imported.ID = &entry.record.ID
+			}
+		}
+		res.Credentials = append(res.Credentials, imported)
+	}
+
+	return res, nil
+}
+
+// importPresentation is an imported presentation with the challenge
+// and domain which bind it to an import request.
+type importPresentation struct {
+	holder      string
+	credentials []interface{}
+	challenge   string
+	domain      string
+}
+
+// parsePresentation verifies and parses the imported presentation, which
+// is given as JSON-LD presentation or as JWT encoded presentation or credential.
+func (s *Service) parsePresentation(ctx context.Context, data []byte, logger *zap.Logger) (*importPresentation, error) {
+	if jwtvc.IsJWT(data) {
+		if s.jwt == nil {
+			logger.Error("import of JWT encoded presentations is not configured")
+			return nil, errors.New(errors.BadRequest, "JWT encoded presentations are not supported")
+		}
+
+		vp, err := s.jwt.ParsePresentation(ctx, data)
+		if err != nil {
+			logger.Error("error parsing JWT encoded presentation", zap.Error(err))
+			return nil, err
+		}
+
+		res := &importPresentation{holder: vp.Holder, challenge: vp.Nonce, domain: vp.Audience}
+		for _, cred := range vp.Credentials {
+			res.credentials = append(res.credentials, cred)
+		}
+		return res, nil
+	}
+
+	if err := s.verifier.VerifyPresentation(ctx, data); err != nil {
+		logger.Error("error verifying presentation", zap.Error(err))
+		return nil, err
+	}
+
+	vp, err := s.credentials.ParsePresentation(data)
+	if err != nil {
+		logger.Error("error parsing verifiable presentation", zap.Error(err))
+		return nil, err
+	}
+
+	challenge, domain := proofChallenge(vp)
+	return &importPresentation{
+		holder:      vp.Holder,
+		credentials: vp.Credentials(),
+		challenge:   challenge,
+		domain:      domain,
+	}, nil
+}
+
+// importedCredential returns the credential of a presentation as map.
+// JWT encoded credentials are verified and normalised by the JWT parser.
+func (s *Service) importedCredential(ctx context.Context, cred interface{}) (map[string]interface{}, error) {
+	switch c := cred.(type) {
+	case map[string]interface{}:
+		if !jwtvc.IsEnveloped(c) {
+			return c, nil
+		}
+	case string:
+	case *verifiable.Credential:
+		// JWT credentials of JSON-LD presentations are decoded by the parser
+		if c.JWT == "" {
+			return nil, errors.New(errors.BadRequest, "verifiable presentation contains unknown credential type")
+		}
+		cred = c.JWT
+	default:
+		return nil, errors.New(errors.BadRequest, "verifiable presentation contains unknown credential type")
+	}
+
+	if s.jwt == nil {
+		return nil, errors.New(errors.BadRequest, "JWT encoded credentials are not supported")
+	}
+
+	return s.jwt.ParseCredential(ctx, cred)
+}
+
+// previousImport returns the record of a previous import of the credential
+// into the same Cache namespace and scope. Credentials with random keys are
+// duplicates if they are imported with any key, otherwise only if they are
+// imported with the same key. Nil is returned if the credential is not
+// imported yet.
+func (s *Service) previousImport(ctx context.Context, target *importTarget, entry *importEntry) (*storage.ImportRecord, error) {
+	if s.registry == nil {
+		return nil, nil
+	}
+
+	var key string
+	if target.key != "" || target.keyFrom != keyFromRandom {
+		key = entry.key
+	}
+
+	record, err := s.registry.FindImportRecord(ctx, entry.record.Hash, target.namespace, target.scope, key)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return nil, nil
+		}
+		return nil, errors.New("error checking previous imports", err)
+	}
+
+	return record, nil
+}
+
+// saveEntry places the subject of an imported credential in the Cache
+// and records the import in the registry. If the import cannot be recorded,
+// the given overwritten data is restored, otherwise the data is removed.
+func (s *Service) saveEntry(ctx context.Context, target *importTarget, entry *importEntry, previous *overwrittenEntry) error {
+	subjectBytes, err := json.Marshal(entry.subject)
+	if err != nil {
+		return errors.New("error encoding subject to json", err)
+	}
+
+	if err := s.cache.Set(ctx, entry.key, target.namespace, target.scope, subjectBytes); err != nil {
+		return err
+	}
+
+	if entry.record == nil {
+		return nil
+	}
+
+	entry.record.CreatedAt = time.Now()
+	record, err := s.registry.SaveImportRecord(ctx, entry.record)
+	if err != nil {
+		// data which is not recorded would not be detected as duplicate
+		if previous != nil {
+			if rerr := s.restoreEntry(ctx, target, previous); rerr != nil {
+				s.logger.Error("error restoring data overwritten by import", zap.String("key", entry.key), zap.Error(rerr))
+			}
+		} else if derr := s.cache.Delete(ctx, entry.key, target.namespace, target.scope); derr != nil {
+			s.logger.Error("error removing unrecorded data from cache", zap.String("key", entry.key), zap.Error(derr))
+		}
+		return errors.New("error saving import record", err)
+	}
+	// data imported again with the same key keeps the ID of its record
+	entry.record = record
+
+	return nil
+}
+
+// overwrittenEntry is the Cache value and import record of a key before
+// it is written by an atomic import. They are nil if the key didn't exist.
+type overwrittenEntry struct {
+	key    string
+	value  []byte
+	record *storage.ImportRecord
+}
+
+// overwrittenEntry returns the data which is overwritten by
+// importing a credential with the given key.
+func (s *Service) overwrittenEntry(ctx context.Context, target *importTarget, key string) (*overwrittenEntry, error) {
+	res := &overwrittenEntry{key: key}
+
+	value, err := s.cache.Get(ctx, key, target.namespace, target.scope)
+	if err != nil && !errors.Is(errors.NotFound, err) {
+		return nil, errors.New("error getting data overwritten by import", err)
+	}
+	if err == nil {
+		res.value = value
+	}
+
+	if s.registry != nil {
+		record, err := s.registry.KeyImportRecord(ctx, key, target.namespace, target.scope)
+		if err != nil && !errors.Is(errors.NotFound, err) {
+			return nil, errors.New("error getting import record overwritten by import", err)
+		}
+		if err == nil {
+			res.record = record
+		}
+	}
+
+	return res, nil
+}
+
+// rollbackImport restores the Cache entries and import records overwritten
+// by an atomic import which failed with the given error at the credential
+// with the given index. Keys which didn't exist before the import are
+// removed. The returned error reports the failure and whether the rollback
+// succeeded.
+func (s *Service) rollbackImport(ctx context.Context, target *importTarget, overwritten []*overwrittenEntry, index int, cause error) error {
+	var failed []string
+	for _, previous := range overwritten {
+		if err := s.restoreEntry(ctx, target, previous); err != nil {
+			s.logger.Error("error restoring data overwritten by import", zap.String("key", previous.key), zap.Error(err))
+			failed = append(failed, previous.key)
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.New(fmt.Sprintf("error importing credential %d, import is not rolled back for keys: %s", index, strings.Join(failed, ", ")), cause)
+	}
+	return errors.New(fmt.Sprintf("error importing credential %d, import is rolled back", index), cause)
+}
+
+// restoreEntry puts back the Cache value and import record of a key
+// overwritten by an import, or removes them if they didn't exist.
+func (s *Service) restoreEntry(ctx context.Context, target *importTarget, previous *overwrittenEntry) error {
+	var err error
+	if previous.value != nil {
+		err = s.cache.Set(ctx, previous.key, target.namespace, target.scope, previous.value)
+	} else {
+		err = s.cache.Delete(ctx, previous.key, target.namespace, target.scope)
+	}
+	if err != nil || s.registry == nil {
+		return err
+	}
+
+	if previous.record != nil {
+		_, err = s.registry.SaveImportRecord(ctx, previous.record)
+	} else {
+		err = s.registry.DeleteImportRecord(ctx, previous.key, target.namespace, target.scope)
+	}
+	return err
+}
+
+// importEntry is a credential accepted for import together with its
+// Cache key, the subject which is imported and its import record,
+// if imports are recorded.
+type importEntry struct {
+	index   int
+	cred    map[string]interface{}
+	key     string
+	subject map[string]interface{}
+	record  *storage.ImportRecord
+}
+
+// checkCredential checks that an imported credential can be accepted.
+// Credentials which are not acceptable are reported with BadRequest errors
+// stating the reason of their rejection.
+func (s *Service) checkCredential(ctx context.Context, cred map[string]interface{}) error {
+	if cred["credentialSubject"] == nil {
+		return errors.New(errors.BadRequest, "verifiable credential doesn't contain subject")
+	}
+
+	if _, ok := cred["credentialSubject"].(map[string]interface{}); !ok {
+		return errors.New(errors.BadRequest, "verifiable credential subject is not a map object")
+	}
+
+	if err := credential.CheckValidity(cred, time.Now()); err != nil {
+		return err
+	}
+
+	if s.importSchemas && s.schemas != nil {
+		if err := s.schemas.ValidateCredential(ctx, cred); err != nil {
+			return err
+		}
+	}
+
+	if err := s.checkIssuer(ctx, cred); err != nil {
+		return err
+	}
+
+	if s.status != nil {
+		return s.status.CheckStatus(ctx, cred)
+	}
+
+	return nil
+}
+
+// checkIssuer checks that the issuer of the credential is trusted by the
+// configured trust anchors. Without trust anchors any issuer is accepted.
+func (s *Service) checkIssuer(ctx context.Context, cred map[string]interface{}) error {
+	if s.trust == nil {
+		return nil
+	}
+
+	issuer := credential.Issuer(cred)
+	if issuer == "" {
+		return errors.New(errors.BadRequest, "verifiable credential doesn't contain issuer")
+	}
+
+	trusted, err := s.trust.IsTrustedIssuer(ctx, issuer)
+	if err != nil {
+		return errors.New("error checking trusted issuers", err)
+	}
+	if !trusted {
+		return errors.New(errors.BadRequest, fmt.Sprintf("issuer %q is not trusted", issuer))
+	}
+
+	return nil
+}
+
+// credentialID returns the id of a credential, if it has one.
+func credentialID(cred map[string]interface{}) string {
+	id, _ := cred["id"].(string)
+	return id
+}
+
+// subjectID returns the id of the subject of a credential, if it has one.
+func subjectID(cred map[string]interface{}) string {
+	subject, _ := cred["credentialSubject"].(map[string]interface{})
+	id, _ := subject["id"].(string)
+	return id
+}
+
+// rejection describes why the credential at the given position
+// in the presentation is rejected.
+func rejection(index int, cred map[string]interface{}, err error) string {
+	reason := err.Error()
+	if e, ok := err.(*errors.Error); ok {
+		reason = e.Message
+	}
+
+	if id := credentialID(cred); id != "" {
+		return fmt.Sprintf("credential %d (%s): %s", index, id, reason)
+	}
+	return fmt.Sprintf("credential %d: %s", index, reason)
+}
@@ -0,0 +1,107 @@
+package infohub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+)
+
+// ImportPolicies configures the policies which validate imported data.
+// Policies are formatted as 'group/policy/version'. The policy of a
+// credential type takes precedence over the policy of a namespace, which
+// takes precedence over the default policy.
+type ImportPolicies struct {
+	// Default is the policy of imports without a more specific policy.
+	Default string
+	// Namespaces maps Cache namespaces to their policies.
+	Namespaces map[string]string
+	// Types maps credential types to their policies.
+	Types map[string]string
+}
+
+// policy returns the policy which validates the credential imported
+// into the given namespace, or an empty string if there is none.
+func (p *ImportPolicies) policy(namespace string, cred map[string]interface{}) string {
+	for _, typ := range credentialTypes(cred) {
+		if policy := p.Types[typ]; policy != "" {
+			return policy
+		}
+	}
+	if policy := p.Namespaces[namespace]; policy != "" {
+		return policy
+	}
+	return p.Default
+}
+
+// importDecision is the result of an import policy.
+type importDecision struct {
+	Allow bool `json:"allow"`
+	// Reason optionally explains why the data is not allowed.
+	Reason string `json:"reason"`
+	// Subject optionally replaces the imported credential subject.
+	Subject map[string]interface{} `json:"subject"`
+}
+
+// validateSubject evaluates the import policy of the credential with its
+// subject, issuer and the holder of the presentation as input. The data is
+// accepted only if the policy allows it. The returned subject is the one
+// which is imported: the subject of the credential or the subject
+// transformed by the policy.
+func (s *Service) validateSubject(ctx context.Context, target *importTarget, holder string, cred map[string]interface{}) (map[string]interface{}, error) {
+	subject, _ := cred["credentialSubject"].(map[string]interface{})
+
+	policy := s.importPolicies.policy(target.namespace, cred)
+	if policy == "" {
+		return subject, nil
+	}
+
+	input := map[string]interface{}{
+		"subject":   subject,
+		"issuer":    credential.Issuer(cred),
+		"holder":    holder,
+		"namespace": target.namespace,
+		"types":     credentialTypes(cred),
+	}
+	res, err := s.policy.Evaluate(ctx, policy, input, "", nil)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("error evaluating import policy %q", policy), err)
+	}
+
+	var decision importDecision
+	if err := json.Unmarshal(res, &decision); err != nil {
+		return nil, errors.New(fmt.Sprintf("error decoding result of import policy %q", policy), err)
+	}
+	if !decision.Allow {
+		msg := fmt.Sprintf("data is not allowed by import policy %q", policy)
+		if decision.Reason != "" {
+			msg += ": " + decision.Reason
+		}
+		return nil, errors.New(errors.BadRequest, msg)
+	}
+
+	if decision.Subject != nil {
+		return decision.Subject, nil
+	}
+	return subject, nil
+}
+
+// credentialTypes returns the types of a credential, which
+// may be given as a single string or as an array.
+func credentialTypes(cred map[string]interface{}) []string {
+	switch typ := cred["type"].(type) {
+	case string:
+		return []string{typ}
+	case []interface{}:
+		types := make([]string, 0, len(typ))
+		for _, t := range typ {
+			if s, ok := t.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
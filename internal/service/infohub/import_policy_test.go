@@ -0,0 +1,163 @@
+package infohub_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+)
+
+func TestService_Import_Policies(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"holder": "did:web:holder.example.com",
+		"verifiableCredential": [{
+			"type": ["VerifiableCredential", "LegalParticipant"],
+			"issuer": "did:web:issuer.example.com",
+			"credentialSubject": {"name": "Example"}
+		}]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	policies := infohub.ImportPolicies{
+		Default:    "import/default/1.0",
+		Namespaces: map[string]string{"participants": "import/participants/1.0"},
+		Types:      map[string]string{"LegalParticipant": "import/legal/1.0"},
+	}
+
+	tests := []struct {
+		name      string
+		policies  infohub.ImportPolicies
+		namespace string
+		result    string
+		evalErr   error
+
+		policy  string
+		subject string
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "data is imported without policy",
+			subject: `{"name": "Example"}`,
+		},
+		{
+			name:     "default policy allows data",
+			policies: infohub.ImportPolicies{Default: "import/default/1.0"},
+			result:   `{"allow": true}`,
+			policy:   "import/default/1.0",
+			subject:  `{"name": "Example"}`,
+		},
+		{
+			name:      "namespace policy takes precedence over default policy",
+			policies:  infohub.ImportPolicies{Default: policies.Default, Namespaces: policies.Namespaces},
+			namespace: "participants",
+			result:    `{"allow": true}`,
+			policy:    "import/participants/1.0",
+			subject:   `{"name": "Example"}`,
+		},
+		{
+			name:      "type policy takes precedence over namespace policy",
+			policies:  policies,
+			namespace: "participants",
+			result:    `{"allow": true}`,
+			policy:    "import/legal/1.0",
+			subject:   `{"name": "Example"}`,
+		},
+		{
+			name:     "subject transformed by policy is imported",
+			policies: policies,
+			result:   `{"allow": true, "subject": {"legalName": "Example"}}`,
+			policy:   "import/legal/1.0",
+			subject:  `{"legalName": "Example"}`,
+		},
+		{
+			name:     "data is rejected by policy",
+			policies: policies,
+			result:   `{"allow": false, "reason": "missing registration number"}`,
+			policy:   "import/legal/1.0",
+			errkind:  errors.BadRequest,
+			errtext:  `credential 0: data is not allowed by import policy "import/legal/1.0": missing registration number`,
+		},
+		{
+			name:     "policy result without decision rejects data",
+			policies: policies,
+			result:   `{}`,
+			policy:   "import/legal/1.0",
+			errkind:  errors.BadRequest,
+			errtext:  `data is not allowed by import policy "import/legal/1.0"`,
+		},
+		{
+			name:     "error evaluating policy",
+			policies: policies,
+			evalErr:  errors.New(errors.ServiceUnavailable, "policy service is unavailable"),
+			policy:   "import/legal/1.0",
+			errkind:  errors.ServiceUnavailable,
+			errtext:  `error evaluating import policy "import/legal/1.0"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			credentialsFake := &infohubfakes.FakeCredentials{}
+			credentialsFake.ParsePresentationReturns(vp, nil)
+			policyFake := &infohubfakes.FakePolicy{}
+			policyFake.EvaluateReturns([]byte(test.result), test.evalErr)
+			cacheFake := &infohubfakes.FakeCache{}
+
+			svc := infohub.New(nil, policyFake, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(),
+				infohub.WithImportPolicies(test.policies),
+				infohub.WithImportNamespaces(map[string][]string{"*": {"participants"}}),
+			)
+			req := &goainfohub.ImportRequest{Data: []byte(`{}`)}
+			if test.namespace != "" {
+				req.Namespace = &test.namespace
+			}
+			res, err := svc.Import(context.Background(), req)
+
+			if test.policy == "" {
+				assert.Equal(t, 0, policyFake.EvaluateCallCount())
+			} else {
+				require.Equal(t, 1, policyFake.EvaluateCallCount())
+				_, policy, input, evaluationID, ttl := policyFake.EvaluateArgsForCall(0)
+				assert.Equal(t, test.policy, policy)
+				assert.Empty(t, evaluationID)
+				assert.Nil(t, ttl)
+
+				inputJSON, err := json.Marshal(input)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{
+					"subject": {"name": "Example"},
+					"issuer": "did:web:issuer.example.com",
+					"holder": "did:web:holder.example.com",
+					"namespace": "`+test.namespace+`",
+					"types": ["VerifiableCredential", "LegalParticipant"]
+				}`, string(inputJSON))
+			}
+
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(test.errkind, err))
+				assert.Equal(t, 0, cacheFake.SetCallCount())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, 1, cacheFake.SetCallCount())
+			_, _, _, _, value := cacheFake.SetArgsForCall(0)
+			assert.JSONEq(t, test.subject, string(value))
+		})
+	}
+}
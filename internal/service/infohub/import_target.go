@@ -0,0 +1,117 @@
+package infohub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+)
+
+// Sources of the Cache keys of imported credentials.
+const (
+	keyFromRandom       = "random"
+	keyFromCredentialID = "credentialId"
+	keyFromSubjectID    = "subjectId"
+)
+
+// anyClient configures the namespaces which all clients may import into.
+const anyClient = "*"
+
+// importTarget specifies where in the Cache the imported data is placed.
+type importTarget struct {
+	namespace string
+	scope     string
+	key       string
+	keyFrom   string
+}
+
+// importTarget returns the Cache location requested for the import. Data
+// can always be imported into the default namespace with random keys, while
+// other namespaces must be allowed for the client. The default namespace
+// holds the export data, so keys can only be chosen by the client for
+// imports into an allowed namespace, or they could replace export data.
+func (s *Service) importTarget(ctx context.Context, req *infohub.ImportRequest) (*importTarget, error) {
+	target := &importTarget{keyFrom: req.KeyFrom}
+	if req.Namespace != nil {
+		target.namespace = *req.Namespace
+	}
+	if req.Scope != nil {
+		target.scope = *req.Scope
+	}
+	if req.Key != nil {
+		target.key = *req.Key
+	}
+	if target.keyFrom == "" {
+		target.keyFrom = keyFromRandom
+	}
+
+	if target.key != "" && target.keyFrom != keyFromRandom {
+		return nil, errors.New(errors.BadRequest, "key and keyFrom cannot be given together")
+	}
+
+	if target.namespace == "" && (target.key != "" || target.keyFrom != keyFromRandom) {
+		err := errors.New(errors.Forbidden, "keys can only be chosen for imports into an allowed namespace")
+		s.audit(ctx, "import", target.namespace, err)
+		return nil, err
+	}
+
+	if target.namespace != "" {
+		err := s.authorizeNamespace(ctx, target.namespace)
+		s.audit(ctx, "import", target.namespace, err)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return target, nil
+}
+
+func (s *Service) authorizeNamespace(ctx context.Context, namespace string) error {
+	c, _ := claims.FromContext(ctx)
+	for _, client := range []string{c.ClientID(), anyClient} {
+		for _, ns := range s.importNamespaces[client] {
+			if ns == namespace {
+				return nil
+			}
+		}
+	}
+	return errors.New(errors.Forbidden, fmt.Sprintf("import into namespace %q is not allowed", namespace))
+}
+
+// importNamespacesOf returns the namespaces which the client may import
+// into, including the default namespace.
+func (s *Service) importNamespacesOf(ctx context.Context) []string {
+	c, _ := claims.FromContext(ctx)
+	namespaces := []string{""}
+	namespaces = append(namespaces, s.importNamespaces[c.ClientID()]...)
+	namespaces = append(namespaces, s.importNamespaces[anyClient]...)
+	return namespaces
+}
+
+// cacheKey returns the Cache key of an imported credential.
+func (t *importTarget) cacheKey(cred map[string]interface{}) (string, error) {
+	if t.key != "" {
+		return t.key, nil
+	}
+
+	switch t.keyFrom {
+	case keyFromCredentialID:
+		id := credentialID(cred)
+		if id == "" {
+			return "", errors.New(errors.BadRequest, "verifiable credential doesn't contain id")
+		}
+		return id, nil
+	case keyFromSubjectID:
+		id := subjectID(cred)
+		if id == "" {
+			return "", errors.New(errors.BadRequest, "verifiable credential subject doesn't contain id")
+		}
+		return id, nil
+	default:
+		return uuid.NewString(), nil
+	}
+}
@@ -0,0 +1,167 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+)
+
+func TestService_Import_Target(t *testing.T) {
+	namespaces := map[string][]string{
+		"client-1": {"participants"},
+		"*":        {"public"},
+	}
+	ptr := func(s string) *string { return &s }
+
+	tests := []struct {
+		name        string
+		credentials string
+		claims      claims.Claims
+		req         *goainfohub.ImportRequest
+
+		errkind   errors.Kind
+		errtext   string
+		namespace string
+		scope     string
+		keys      []string
+	}{
+		{
+			name:        "namespace allowed for the client",
+			credentials: `{"credentialSubject": {"hello": "world"}}`,
+			claims:      claims.Claims{"azp": "client-1"},
+			req:         &goainfohub.ImportRequest{Namespace: ptr("participants"), Scope: ptr("compliance"), Key: ptr("participant-1")},
+			namespace:   "participants",
+			scope:       "compliance",
+			keys:        []string{"participant-1"},
+		},
+		{
+			name:        "namespace allowed for all clients",
+			credentials: `{"credentialSubject": {"hello": "world"}}`,
+			req:         &goainfohub.ImportRequest{Namespace: ptr("public"), Key: ptr("key-1")},
+			namespace:   "public",
+			keys:        []string{"key-1"},
+		},
+		{
+			name:        "namespace not allowed for the client",
+			credentials: `{"credentialSubject": {"hello": "world"}}`,
+			claims:      claims.Claims{"azp": "client-2"},
+			req:         &goainfohub.ImportRequest{Namespace: ptr("participants")},
+			errkind:     errors.Forbidden,
+			errtext:     `import into namespace "participants" is not allowed`,
+		},
+		{
+			name:        "key of export data in the default namespace",
+			credentials: `{"credentialSubject": {"hello": "world"}}`,
+			claims:      claims.Claims{"azp": "client-1"},
+			req:         &goainfohub.ImportRequest{Key: ptr("myexport:example/example/1.0")},
+			errkind:     errors.Forbidden,
+			errtext:     "keys can only be chosen for imports into an allowed namespace",
+		},
+		{
+			name:        "keys from subject ids in the default namespace",
+			credentials: `{"credentialSubject": {"id": "myexport:example/example/1.0"}}`,
+			req:         &goainfohub.ImportRequest{KeyFrom: "subjectId"},
+			errkind:     errors.Forbidden,
+			errtext:     "keys can only be chosen for imports into an allowed namespace",
+		},
+		{
+			name:        "key and keyFrom given together",
+			credentials: `{"credentialSubject": {"hello": "world"}}`,
+			req:         &goainfohub.ImportRequest{Key: ptr("key-1"), KeyFrom: "subjectId"},
+			errkind:     errors.BadRequest,
+			errtext:     "key and keyFrom cannot be given together",
+		},
+		{
+			name:        "key given for multiple credentials",
+			credentials: `{"credentialSubject": {"hello": "world"}}, {"credentialSubject": {"hello": "world"}}`,
+			req:         &goainfohub.ImportRequest{Namespace: ptr("public"), Key: ptr("key-1")},
+			errkind:     errors.BadRequest,
+			errtext:     "key can only be given for a presentation with a single credential",
+		},
+		{
+			name:        "keys from credential ids",
+			credentials: `{"id": "urn:uuid:1", "credentialSubject": {"hello": "world"}}, {"id": "urn:uuid:2", "credentialSubject": {"hello": "world"}}`,
+			req:         &goainfohub.ImportRequest{Namespace: ptr("public"), KeyFrom: "credentialId"},
+			namespace:   "public",
+			keys:        []string{"urn:uuid:1", "urn:uuid:2"},
+		},
+		{
+			name:        "keys from subject ids",
+			credentials: `{"credentialSubject": {"id": "did:web:1.example.com"}}, {"credentialSubject": {"id": "did:web:2.example.com"}}`,
+			req:         &goainfohub.ImportRequest{Namespace: ptr("public"), KeyFrom: "subjectId"},
+			namespace:   "public",
+			keys:        []string{"did:web:1.example.com", "did:web:2.example.com"},
+		},
+		{
+			name:        "credentials without subject ids",
+			credentials: `{"credentialSubject": {"id": "did:web:1.example.com"}}, {"credentialSubject": {"hello": "world"}}`,
+			req:         &goainfohub.ImportRequest{Namespace: ptr("public"), KeyFrom: "subjectId"},
+			errkind:     errors.BadRequest,
+			errtext:     "credential 1: verifiable credential subject doesn't contain id",
+		},
+		{
+			name:        "credentials with duplicate keys",
+			credentials: `{"credentialSubject": {"id": "did:web:1.example.com"}}, {"credentialSubject": {"id": "did:web:1.example.com"}}`,
+			req:         &goainfohub.ImportRequest{Namespace: ptr("public"), KeyFrom: "subjectId"},
+			errkind:     errors.BadRequest,
+			errtext:     `credential 1: duplicate cache key "did:web:1.example.com"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vp, err := verifiable.ParsePresentation([]byte(`{
+				"@context": ["https://www.w3.org/2018/credentials/v1"],
+				"type": ["VerifiablePresentation"],
+				"verifiableCredential": [`+test.credentials+`]
+			}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+			require.NoError(t, err)
+
+			credentialsFake := &infohubfakes.FakeCredentials{
+				ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+					return vp, nil
+				},
+			}
+			cacheFake := &infohubfakes.FakeCache{}
+
+			ctx := context.Background()
+			if test.claims != nil {
+				ctx = claims.NewContext(ctx, test.claims)
+			}
+
+			svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), infohub.WithImportNamespaces(namespaces))
+			test.req.Data = []byte(`{}`)
+			res, err := svc.Import(ctx, test.req)
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				e, ok := err.(*errors.Error)
+				require.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Equal(t, 0, cacheFake.SetCallCount())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.keys, res.ImportIds)
+			require.Equal(t, len(test.keys), cacheFake.SetCallCount())
+			for i, key := range test.keys {
+				_, k, namespace, scope, _ := cacheFake.SetArgsForCall(i)
+				assert.Equal(t, key, k)
+				assert.Equal(t, test.namespace, namespace)
+				assert.Equal(t, test.scope, scope)
+			}
+		})
+	}
+}
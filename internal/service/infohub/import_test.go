@@ -0,0 +1,532 @@
+package infohub_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_Import_WithVerifier(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"verifiableCredential": [{"credentialSubject": {"hello": "world"}}]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	t.Run("presentation is verified by the verifier instead of the signer", func(t *testing.T) {
+		signerFake := &infohubfakes.FakeSigner{}
+		verifierFake := &infohubfakes.FakeVerifier{}
+		credentialsFake := &infohubfakes.FakeCredentials{
+			ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+				return vp, nil
+			},
+		}
+		cacheFake := &infohubfakes.FakeCache{}
+
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, signerFake, zap.NewNop(), infohub.WithVerifier(verifierFake))
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+		require.NoError(t, err)
+		assert.Len(t, res.ImportIds, 1)
+
+		assert.Equal(t, 1, verifierFake.VerifyPresentationCallCount())
+		assert.Equal(t, 0, signerFake.VerifyPresentationCallCount())
+		_, _, _, _, value := cacheFake.SetArgsForCall(0)
+		assert.JSONEq(t, `{"hello":"world"}`, string(value))
+	})
+
+	t.Run("presentation with invalid proof is rejected", func(t *testing.T) {
+		verifierFake := &infohubfakes.FakeVerifier{
+			VerifyPresentationStub: func(ctx context.Context, b []byte) error {
+				return errors.New(errors.BadRequest, "invalid presentation proof")
+			},
+		}
+		cacheFake := &infohubfakes.FakeCache{}
+
+		svc := infohub.New(nil, nil, cacheFake, nil, nil, zap.NewNop(), infohub.WithVerifier(verifierFake))
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+		assert.Nil(t, res)
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.BadRequest, err))
+		assert.Equal(t, 0, cacheFake.SetCallCount())
+	})
+}
+
+func TestService_Import_TrustAnchors(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"verifiableCredential": [
+			{"id": "urn:uuid:1", "issuer": "did:web:trusted.example.com", "credentialSubject": {"hello": "world"}},
+			{"id": "urn:uuid:2", "issuer": {"id": "did:web:untrusted.example.com"}, "credentialSubject": {"hello": "world"}},
+			{"credentialSubject": {"hello": "world"}}
+		]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		trust *infohubfakes.FakeTrustAnchors
+
+		errkind errors.Kind
+		errtext []string
+	}{
+		{
+			name: "credentials of untrusted issuers are rejected",
+			trust: &infohubfakes.FakeTrustAnchors{
+				IsTrustedIssuerStub: func(ctx context.Context, issuer string) (bool, error) {
+					return issuer == "did:web:trusted.example.com", nil
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: []string{
+				`credential 1 (urn:uuid:2): issuer "did:web:untrusted.example.com" is not trusted`,
+				`credential 2: verifiable credential doesn't contain issuer`,
+			},
+		},
+		{
+			name: "error checking trust anchors",
+			trust: &infohubfakes.FakeTrustAnchors{
+				IsTrustedIssuerStub: func(ctx context.Context, issuer string) (bool, error) {
+					return false, errors.New("some error")
+				},
+			},
+			errkind: errors.Unknown,
+			errtext: []string{"error checking trusted issuers"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			credentialsFake := &infohubfakes.FakeCredentials{
+				ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+					return vp, nil
+				},
+			}
+			cacheFake := &infohubfakes.FakeCache{}
+
+			svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), infohub.WithTrustAnchors(test.trust))
+			res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+			assert.Nil(t, res)
+			require.Error(t, err)
+			e, ok := err.(*errors.Error)
+			require.True(t, ok)
+			assert.Equal(t, test.errkind, e.Kind)
+			for _, text := range test.errtext {
+				assert.Contains(t, err.Error(), text)
+			}
+			assert.NotContains(t, err.Error(), "urn:uuid:1")
+			assert.Equal(t, 0, cacheFake.SetCallCount())
+		})
+	}
+
+	t.Run("credentials of trusted issuers are imported", func(t *testing.T) {
+		trustedVP, err := verifiable.ParsePresentation([]byte(`{
+			"@context": ["https://www.w3.org/2018/credentials/v1"],
+			"type": ["VerifiablePresentation"],
+			"verifiableCredential": [
+				{"issuer": "did:web:trusted.example.com", "credentialSubject": {"hello": "world"}},
+				{"issuer": {"id": "did:web:trusted.example.com"}, "credentialSubject": {"hello": "world"}}
+			]
+		}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+		require.NoError(t, err)
+
+		credentialsFake := &infohubfakes.FakeCredentials{
+			ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+				return trustedVP, nil
+			},
+		}
+		trustFake := &infohubfakes.FakeTrustAnchors{
+			IsTrustedIssuerStub: func(ctx context.Context, issuer string) (bool, error) {
+				return issuer == "did:web:trusted.example.com", nil
+			},
+		}
+		cacheFake := &infohubfakes.FakeCache{}
+
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), infohub.WithTrustAnchors(trustFake))
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+		require.NoError(t, err)
+		assert.Len(t, res.ImportIds, 2)
+		assert.Equal(t, 2, trustFake.IsTrustedIssuerCallCount())
+		assert.Equal(t, 2, cacheFake.SetCallCount())
+	})
+}
+
+func TestService_Import_Validity(t *testing.T) {
+	tests := []struct {
+		name   string
+		cred   string
+		status *infohubfakes.FakeStatusChecker
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:    "expired credential is rejected",
+			cred:    `{"expirationDate": "2020-01-01T00:00:00Z", "credentialSubject": {"hello": "world"}}`,
+			status:  &infohubfakes.FakeStatusChecker{},
+			errkind: errors.BadRequest,
+			errtext: "credential 0: credential expired at 2020-01-01T00:00:00Z",
+		},
+		{
+			name:    "credential issued in the future is rejected",
+			cred:    `{"issuanceDate": "2999-01-01T00:00:00Z", "credentialSubject": {"hello": "world"}}`,
+			status:  &infohubfakes.FakeStatusChecker{},
+			errkind: errors.BadRequest,
+			errtext: "credential 0: credential is not valid before 2999-01-01T00:00:00Z",
+		},
+		{
+			name: "revoked credential is rejected",
+			cred: `{"credentialSubject": {"hello": "world"}}`,
+			status: &infohubfakes.FakeStatusChecker{
+				CheckStatusStub: func(ctx context.Context, cred map[string]interface{}) error {
+					return errors.New(errors.BadRequest, "credential is revoked")
+				},
+			},
+			errkind: errors.BadRequest,
+			errtext: "credential 0: credential is revoked",
+		},
+		{
+			name: "credential status cannot be checked",
+			cred: `{"credentialSubject": {"hello": "world"}}`,
+			status: &infohubfakes.FakeStatusChecker{
+				CheckStatusStub: func(ctx context.Context, cred map[string]interface{}) error {
+					return errors.New(errors.ServiceUnavailable, "credential status cannot be checked")
+				},
+			},
+			errkind: errors.ServiceUnavailable,
+			errtext: "credential status cannot be checked",
+		},
+		{
+			name:   "valid credential is imported",
+			cred:   `{"issuanceDate": "2020-01-01T00:00:00Z", "expirationDate": "2999-01-01T00:00:00Z", "credentialSubject": {"hello": "world"}}`,
+			status: &infohubfakes.FakeStatusChecker{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			vp, err := verifiable.ParsePresentation([]byte(`{
+				"@context": ["https://www.w3.org/2018/credentials/v1"],
+				"type": ["VerifiablePresentation"],
+				"verifiableCredential": [`+test.cred+`]
+			}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+			require.NoError(t, err)
+
+			credentialsFake := &infohubfakes.FakeCredentials{
+				ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+					return vp, nil
+				},
+			}
+			cacheFake := &infohubfakes.FakeCache{}
+
+			svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), infohub.WithStatusChecker(test.status))
+			res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+			if test.errtext == "" {
+				require.NoError(t, err)
+				assert.Len(t, res.ImportIds, 1)
+				assert.Equal(t, 1, test.status.CheckStatusCallCount())
+				return
+			}
+
+			assert.Nil(t, res)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.errtext)
+			e, ok := err.(*errors.Error)
+			require.True(t, ok)
+			assert.Equal(t, test.errkind, e.Kind)
+			assert.Equal(t, 0, cacheFake.SetCallCount())
+		})
+	}
+}
+
+// publicNamespace allows all clients to import into the "public" namespace.
+var publicNamespace = infohub.WithImportNamespaces(map[string][]string{"*": {"public"}})
+
+func TestService_Import_Results(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"verifiableCredential": [
+			{"id": "urn:uuid:1", "credentialSubject": {"id": "did:web:1.example.com"}},
+			{"id": "urn:uuid:2", "credentialSubject": {"id": "did:web:2.example.com"}},
+			{"credentialSubject": {"id": "did:web:3.example.com"}}
+		]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	credentialsFake := &infohubfakes.FakeCredentials{
+		ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+			return vp, nil
+		},
+	}
+	// the second credential cannot be saved
+	newCache := func(deleteErr error) *infohubfakes.FakeCache {
+		return &infohubfakes.FakeCache{
+			SetStub: func(ctx context.Context, key, namespace, scope string, value []byte) error {
+				if key == "did:web:2.example.com" {
+					return errors.New(errors.ServiceUnavailable, "cache is unavailable")
+				}
+				return nil
+			},
+			DeleteStub: func(ctx context.Context, key, namespace, scope string) error {
+				return deleteErr
+			},
+		}
+	}
+
+	t.Run("result of each credential is returned", func(t *testing.T) {
+		cacheFake := newCache(nil)
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), publicNamespace)
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`), Namespace: ptr.String("public"), KeyFrom: "subjectId"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"did:web:1.example.com", "did:web:3.example.com"}, res.ImportIds)
+		require.Len(t, res.Credentials, 3)
+
+		assert.Equal(t, 0, res.Credentials[0].Index)
+		assert.Equal(t, "urn:uuid:1", *res.Credentials[0].CredentialID)
+		assert.Equal(t, "did:web:1.example.com", res.Credentials[0].Key)
+		assert.Equal(t, "imported", res.Credentials[0].Status)
+		assert.Nil(t, res.Credentials[0].Error)
+
+		assert.Equal(t, 1, res.Credentials[1].Index)
+		assert.Equal(t, "failed", res.Credentials[1].Status)
+		require.NotNil(t, res.Credentials[1].Error)
+		assert.Contains(t, *res.Credentials[1].Error, "cache is unavailable")
+
+		assert.Equal(t, 2, res.Credentials[2].Index)
+		assert.Nil(t, res.Credentials[2].CredentialID)
+		assert.Equal(t, "imported", res.Credentials[2].Status)
+
+		assert.Equal(t, 0, cacheFake.DeleteCallCount())
+	})
+
+	t.Run("atomic import is rolled back", func(t *testing.T) {
+		cacheFake := newCache(nil)
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), publicNamespace)
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`), Namespace: ptr.String("public"), KeyFrom: "subjectId", Atomic: true})
+		assert.Nil(t, res)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error importing credential 1, import is rolled back")
+		assert.True(t, errors.Is(errors.ServiceUnavailable, err))
+
+		// the third credential is not imported after the failure
+		assert.Equal(t, 2, cacheFake.SetCallCount())
+		require.Equal(t, 1, cacheFake.DeleteCallCount())
+		_, key, _, _ := cacheFake.DeleteArgsForCall(0)
+		assert.Equal(t, "did:web:1.example.com", key)
+	})
+
+	t.Run("failed rollback is reported", func(t *testing.T) {
+		cacheFake := newCache(errors.New("some error"))
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), publicNamespace)
+		_, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`), Namespace: ptr.String("public"), KeyFrom: "subjectId", Atomic: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "import is not rolled back for keys: did:web:1.example.com")
+	})
+}
+
+func TestService_Import_Duplicates(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"verifiableCredential": [
+			{"id": "urn:uuid:1", "credentialSubject": {"id": "did:web:1.example.com"}},
+			{"id": "urn:uuid:2", "credentialSubject": {"id": "did:web:2.example.com"}}
+		]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	credentialsFake := &infohubfakes.FakeCredentials{
+		ParsePresentationStub: func(b []byte) (*verifiable.Presentation, error) {
+			return vp, nil
+		},
+	}
+	hasherFake := &infohubfakes.FakeCredentialHasher{
+		HashStub: func(cred map[string]interface{}) (string, error) {
+			return cred["id"].(string), nil
+		},
+	}
+	// the first credential is already imported with the key "previous-key"
+	newRegistry := func() *infohubfakes.FakeImportRegistry {
+		return &infohubfakes.FakeImportRegistry{
+			SaveImportRecordStub: saveImportRecord,
+			FindImportRecordStub: func(ctx context.Context, hash, namespace, scope, key string) (*storage.ImportRecord, error) {
+				if hash == "urn:uuid:1" && (key == "" || key == "previous-key") {
+					return &storage.ImportRecord{Key: "previous-key", Hash: hash}, nil
+				}
+				return nil, errors.New(errors.NotFound, "import record not found")
+			},
+		}
+	}
+
+	t.Run("duplicate credential is not imported again", func(t *testing.T) {
+		cacheFake := &infohubfakes.FakeCache{}
+		registryFake := newRegistry()
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(),
+			infohub.WithImportRegistry(registryFake),
+			infohub.WithCredentialHasher(hasherFake),
+		)
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+		require.NoError(t, err)
+
+		require.Len(t, res.Credentials, 2)
+		assert.Equal(t, "duplicate", res.Credentials[0].Status)
+		assert.Equal(t, "previous-key", res.Credentials[0].Key)
+		assert.Equal(t, "imported", res.Credentials[1].Status)
+		assert.Equal(t, []string{"previous-key", res.Credentials[1].Key}, res.ImportIds)
+
+		require.Equal(t, 1, cacheFake.SetCallCount())
+		require.Equal(t, 1, registryFake.SaveImportRecordCallCount())
+		_, record := registryFake.SaveImportRecordArgsForCall(0)
+		assert.Equal(t, "urn:uuid:2", record.Hash)
+		assert.Equal(t, res.Credentials[1].Key, record.Key)
+		assert.NotEmpty(t, record.ID)
+	})
+
+	t.Run("credential imported with another key is not a duplicate", func(t *testing.T) {
+		cacheFake := &infohubfakes.FakeCache{}
+		registryFake := newRegistry()
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(),
+			infohub.WithImportRegistry(registryFake),
+			infohub.WithCredentialHasher(hasherFake),
+			publicNamespace,
+		)
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`), Namespace: ptr.String("public"), KeyFrom: "credentialId"})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"urn:uuid:1", "urn:uuid:2"}, res.ImportIds)
+		assert.Equal(t, 2, cacheFake.SetCallCount())
+		_, _, _, _, key := registryFake.FindImportRecordArgsForCall(0)
+		assert.Equal(t, "urn:uuid:1", key)
+	})
+
+	t.Run("data is removed from cache if the import is not recorded", func(t *testing.T) {
+		cacheFake := &infohubfakes.FakeCache{}
+		registryFake := newRegistry()
+		registryFake.SaveImportRecordReturns(nil, errors.New("some error"))
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(),
+			infohub.WithImportRegistry(registryFake),
+			infohub.WithCredentialHasher(hasherFake),
+		)
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"previous-key"}, res.ImportIds)
+		assert.Equal(t, "failed", res.Credentials[1].Status)
+		require.Equal(t, 1, cacheFake.DeleteCallCount())
+		_, key, _, _ := cacheFake.DeleteArgsForCall(0)
+		assert.Equal(t, res.Credentials[1].Key, key)
+	})
+
+	t.Run("atomic rollback keeps previous imports", func(t *testing.T) {
+		vp, err := verifiable.ParsePresentation([]byte(`{
+			"@context": ["https://www.w3.org/2018/credentials/v1"],
+			"type": ["VerifiablePresentation"],
+			"verifiableCredential": [
+				{"id": "urn:uuid:1", "credentialSubject": {"id": "did:web:1.example.com"}},
+				{"id": "urn:uuid:2", "credentialSubject": {"id": "did:web:2.example.com"}},
+				{"id": "urn:uuid:3", "credentialSubject": {"id": "did:web:3.example.com"}}
+			]
+		}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+		require.NoError(t, err)
+
+		credentialsFake := &infohubfakes.FakeCredentials{}
+		credentialsFake.ParsePresentationReturns(vp, nil)
+		cacheFake := &infohubfakes.FakeCache{
+			SetStub: func(ctx context.Context, key, namespace, scope string, value []byte) error {
+				if strings.Contains(string(value), "did:web:3.example.com") {
+					return errors.New("some error")
+				}
+				return nil
+			},
+		}
+		registryFake := newRegistry()
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(),
+			infohub.WithImportRegistry(registryFake),
+			infohub.WithCredentialHasher(hasherFake),
+		)
+		_, err = svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`), Atomic: true})
+		require.Error(t, err)
+
+		// only the second credential was written by the request
+		_, written, _, _, _ := cacheFake.SetArgsForCall(0)
+		require.Equal(t, 1, cacheFake.DeleteCallCount())
+		_, key, _, _ := cacheFake.DeleteArgsForCall(0)
+		assert.Equal(t, written, key)
+		require.Equal(t, 1, registryFake.DeleteImportRecordCallCount())
+		_, key, _, _ = registryFake.DeleteImportRecordArgsForCall(0)
+		assert.Equal(t, written, key)
+	})
+
+	t.Run("atomic rollback restores overwritten data", func(t *testing.T) {
+		vp, err := verifiable.ParsePresentation([]byte(`{
+			"@context": ["https://www.w3.org/2018/credentials/v1"],
+			"type": ["VerifiablePresentation"],
+			"verifiableCredential": [
+				{"id": "urn:uuid:2", "credentialSubject": {"id": "did:web:2.example.com"}},
+				{"id": "urn:uuid:3", "credentialSubject": {"id": "did:web:3.example.com"}}
+			]
+		}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+		require.NoError(t, err)
+
+		credentialsFake := &infohubfakes.FakeCredentials{}
+		credentialsFake.ParsePresentationReturns(vp, nil)
+		// the second credential overwrites data imported before,
+		// the third one cannot be saved
+		cacheFake := &infohubfakes.FakeCache{
+			GetStub: func(ctx context.Context, key, namespace, scope string) ([]byte, error) {
+				if key == "did:web:2.example.com" {
+					return []byte(`{"previous":true}`), nil
+				}
+				return nil, errors.New(errors.NotFound)
+			},
+			SetStub: func(ctx context.Context, key, namespace, scope string, value []byte) error {
+				if key == "did:web:3.example.com" {
+					return errors.New("some error")
+				}
+				return nil
+			},
+		}
+		previous := &storage.ImportRecord{ID: "previous-id", Key: "did:web:2.example.com", Namespace: "public", Hash: "previous-hash"}
+		registryFake := newRegistry()
+		registryFake.KeyImportRecordStub = func(ctx context.Context, key, namespace, scope string) (*storage.ImportRecord, error) {
+			if key == previous.Key {
+				return previous, nil
+			}
+			return nil, errors.New(errors.NotFound, "import record not found")
+		}
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(),
+			infohub.WithImportRegistry(registryFake),
+			infohub.WithCredentialHasher(hasherFake),
+			publicNamespace,
+		)
+		_, err = svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`), Namespace: ptr.String("public"), KeyFrom: "subjectId", Atomic: true})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error importing credential 1, import is rolled back")
+
+		// the previous data and record are written back instead of being removed
+		require.Equal(t, 3, cacheFake.SetCallCount())
+		_, key, _, _, value := cacheFake.SetArgsForCall(2)
+		assert.Equal(t, "did:web:2.example.com", key)
+		assert.JSONEq(t, `{"previous":true}`, string(value))
+		assert.Equal(t, 0, cacheFake.DeleteCallCount())
+		require.Equal(t, 2, registryFake.SaveImportRecordCallCount())
+		_, record := registryFake.SaveImportRecordArgsForCall(1)
+		assert.Equal(t, previous, record)
+		assert.Equal(t, 0, registryFake.DeleteImportRecordCallCount())
+	})
+}
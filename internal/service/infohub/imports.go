@@ -0,0 +1,165 @@
+package infohub
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// ListImports returns the records of imported credentials, most recent
+// imports first. Clients get the records of their own imports into the
+// namespaces which they may import into, while administrators get all
+// records.
+func (s *Service) ListImports(ctx context.Context, req *infohub.ImportRecordsRequest) ([]*infohub.ImportRecord, error) {
+	logger := s.logger.With(zap.String("operation", "listImports"))
+
+	if err := s.authorizeImport(ctx); err != nil {
+		logger.Error("listing imports is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	if s.registry == nil {
+		return nil, errImportRegistry
+	}
+
+	filter := &storage.ImportRecordFilter{Limit: int64(req.Limit)}
+	if req.Issuer != nil {
+		filter.Issuer = *req.Issuer
+	}
+	if req.Holder != nil {
+		filter.Holder = *req.Holder
+	}
+	if req.SubjectID != nil {
+		filter.SubjectID = *req.SubjectID
+	}
+	if !s.isAdmin(ctx) {
+		// records are filtered in the query, so that the limit applies to them
+		c, _ := claims.FromContext(ctx)
+		importer := c.ClientID()
+		filter.Importer = &importer
+		filter.Namespaces = s.importNamespacesOf(ctx)
+	}
+
+	records, err := s.registry.ImportRecords(ctx, filter)
+	if err != nil {
+		logger.Error("error getting import records", zap.Error(err))
+		return nil, errors.New("error getting import records", err)
+	}
+
+	res := make([]*infohub.ImportRecord, 0, len(records))
+	for _, record := range records {
+		res = append(res, toImportRecordResult(record))
+	}
+
+	return res, nil
+}
+
+// GetImport returns the record of an imported credential.
+func (s *Service) GetImport(ctx context.Context, req *infohub.ImportRecordRequest) (*infohub.ImportRecord, error) {
+	logger := s.logger.With(
+		zap.String("operation", "getImport"),
+		zap.String("id", req.ID),
+	)
+
+	record, err := s.importRecord(ctx, req.ID)
+	if err != nil {
+		logger.Error("error getting import record", zap.Error(err))
+		return nil, err
+	}
+
+	return toImportRecordResult(record), nil
+}
+
+// DeleteImport removes an imported credential subject from the Cache
+// together with its import record. The record is kept if the data cannot
+// be removed from the Cache, so that the deletion can be repeated.
+func (s *Service) DeleteImport(ctx context.Context, req *infohub.ImportRecordRequest) error {
+	logger := s.logger.With(
+		zap.String("operation", "deleteImport"),
+		zap.String("id", req.ID),
+	)
+
+	record, err := s.importRecord(ctx, req.ID)
+	if err != nil {
+		logger.Error("error getting import record", zap.Error(err))
+		return err
+	}
+
+	if err := s.cache.Delete(ctx, record.Key, record.Namespace, record.Scope); err != nil {
+		logger.Error("error removing imported data from cache", zap.Error(err))
+		return errors.New("error removing imported data from cache", err)
+	}
+
+	if err := s.registry.DeleteImportRecord(ctx, record.Key, record.Namespace, record.Scope); err != nil {
+		logger.Error("error removing import record", zap.Error(err))
+		return errors.New("error removing import record", err)
+	}
+
+	logger.Info("imported data is deleted")
+	return nil
+}
+
+var errImportRegistry = errors.New(errors.NotFound, "imports are not recorded")
+
+// importRecord returns the import record with the given ID, if the client
+// imported the recorded data and may import into its namespace, or if the
+// client is an administrator.
+func (s *Service) importRecord(ctx context.Context, id string) (*storage.ImportRecord, error) {
+	if err := s.authorizeImport(ctx); err != nil {
+		return nil, err
+	}
+
+	if s.registry == nil {
+		return nil, errImportRegistry
+	}
+
+	record, err := s.registry.ImportRecord(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.isAdmin(ctx) {
+		return record, nil
+	}
+
+	if c, _ := claims.FromContext(ctx); record.Importer != c.ClientID() {
+		return nil, errors.New(errors.Forbidden, "import record belongs to another client")
+	}
+	if record.Namespace != "" {
+		if err := s.authorizeNamespace(ctx, record.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	return record, nil
+}
+
+func toImportRecordResult(record *storage.ImportRecord) *infohub.ImportRecord {
+	return &infohub.ImportRecord{
+		ID:           record.ID,
+		Key:          record.Key,
+		Namespace:    optional(record.Namespace),
+		Scope:        optional(record.Scope),
+		CredentialID: optional(record.CredentialID),
+		Issuer:       optional(record.Issuer),
+		Holder:       optional(record.Holder),
+		SubjectID:    optional(record.SubjectID),
+		Hash:         record.Hash,
+		Importer:     optional(record.Importer),
+		ImportedAt:   record.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// optional returns a pointer to the value, or nil for an empty value.
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
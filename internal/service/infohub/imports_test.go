@@ -0,0 +1,271 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+var testImportRecord = &storage.ImportRecord{
+	ID:        "record-1",
+	Key:       "key-1",
+	Namespace: "participants",
+	Hash:      "hash-1",
+	Issuer:    "did:web:issuer.example.com",
+	SubjectID: "did:web:subject.example.com",
+	Importer:  "client-a",
+	CreatedAt: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+}
+
+func TestService_Import_Record(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"holder": "did:web:holder.example.com",
+		"verifiableCredential": [{
+			"id": "urn:uuid:1",
+			"issuer": {"id": "did:web:issuer.example.com"},
+			"credentialSubject": {"id": "did:web:subject.example.com"}
+		}]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	credentialsFake := &infohubfakes.FakeCredentials{}
+	credentialsFake.ParsePresentationReturns(vp, nil)
+	registryFake := &infohubfakes.FakeImportRegistry{
+		SaveImportRecordStub: saveImportRecord,
+	}
+	registryFake.FindImportRecordReturns(nil, errors.New(errors.NotFound, "import record not found"))
+
+	ctx := claims.NewContext(context.Background(), claims.Claims{"client_id": "client-a"})
+	svc := infohub.New(nil, nil, &infohubfakes.FakeCache{}, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), infohub.WithImportRegistry(registryFake), infohub.WithImportNamespaces(map[string][]string{"client-a": {"participants"}}))
+	res, err := svc.Import(ctx, &goainfohub.ImportRequest{Data: []byte(`{}`)})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, registryFake.SaveImportRecordCallCount())
+	_, record := registryFake.SaveImportRecordArgsForCall(0)
+	require.Len(t, res.Credentials, 1)
+	require.NotNil(t, res.Credentials[0].ID)
+	assert.Equal(t, record.ID, *res.Credentials[0].ID)
+	assert.Equal(t, res.Credentials[0].Key, record.Key)
+	assert.Equal(t, "urn:uuid:1", record.CredentialID)
+	assert.Equal(t, "did:web:issuer.example.com", record.Issuer)
+	assert.Equal(t, "did:web:holder.example.com", record.Holder)
+	assert.Equal(t, "did:web:subject.example.com", record.SubjectID)
+	assert.Equal(t, "client-a", record.Importer)
+	assert.NotEmpty(t, record.Hash)
+	assert.False(t, record.CreatedAt.IsZero())
+
+	// data imported again with the same key keeps the ID of its record
+	registryFake.SaveImportRecordStub = func(ctx context.Context, record *storage.ImportRecord) (*storage.ImportRecord, error) {
+		stored := *record
+		stored.ID = "record-1"
+		return &stored, nil
+	}
+	res, err = svc.Import(ctx, &goainfohub.ImportRequest{Data: []byte(`{}`), Namespace: ptr.String("participants"), Key: ptr.String("key-1")})
+	require.NoError(t, err)
+	require.Equal(t, 2, registryFake.SaveImportRecordCallCount())
+	_, record = registryFake.SaveImportRecordArgsForCall(1)
+	assert.NotEqual(t, "record-1", record.ID)
+	require.Len(t, res.Credentials, 1)
+	require.NotNil(t, res.Credentials[0].ID)
+	assert.Equal(t, "record-1", *res.Credentials[0].ID)
+}
+
+// saveImportRecord stores the import record as it is.
+func saveImportRecord(_ context.Context, record *storage.ImportRecord) (*storage.ImportRecord, error) {
+	return record, nil
+}
+
+func TestService_ListImports(t *testing.T) {
+	issuer := "did:web:issuer.example.com"
+	clientA := "client-a"
+
+	tests := []struct {
+		name   string
+		claims claims.Claims
+
+		filter *storage.ImportRecordFilter
+	}{
+		{
+			name:   "client gets records of its imports into allowed namespaces",
+			claims: claims.Claims{"client_id": "client-a"},
+			filter: &storage.ImportRecordFilter{
+				Issuer:     issuer,
+				Importer:   &clientA,
+				Namespaces: []string{"", "participants", "public"},
+				Limit:      10,
+			},
+		},
+		{
+			name:   "administrator gets all records",
+			claims: claims.Claims{"client_id": "admin", "scope": "infohub:admin"},
+			filter: &storage.ImportRecordFilter{Issuer: issuer, Limit: 10},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registryFake := &infohubfakes.FakeImportRegistry{}
+			registryFake.ImportRecordsReturns([]*storage.ImportRecord{testImportRecord}, nil)
+
+			ctx := claims.NewContext(context.Background(), test.claims)
+			svc := infohub.New(nil, nil, nil, nil, nil, zap.NewNop(),
+				infohub.WithImportRegistry(registryFake),
+				infohub.WithImportNamespaces(map[string][]string{"client-a": {"participants"}, "*": {"public"}}),
+			)
+
+			res, err := svc.ListImports(ctx, &goainfohub.ImportRecordsRequest{Issuer: &issuer, Limit: 10})
+			require.NoError(t, err)
+
+			// records are filtered by the query, so that the limit applies to them
+			_, filter := registryFake.ImportRecordsArgsForCall(0)
+			assert.Equal(t, test.filter, filter)
+
+			require.Len(t, res, 1)
+			assert.Equal(t, &goainfohub.ImportRecord{
+				ID:         "record-1",
+				Key:        "key-1",
+				Namespace:  &testImportRecord.Namespace,
+				Issuer:     &testImportRecord.Issuer,
+				SubjectID:  &testImportRecord.SubjectID,
+				Hash:       "hash-1",
+				Importer:   &testImportRecord.Importer,
+				ImportedAt: "2024-06-01T12:00:00Z",
+			}, res[0])
+		})
+	}
+}
+
+func TestService_DeleteImport(t *testing.T) {
+	tests := []struct {
+		name     string
+		claims   claims.Claims
+		registry *infohubfakes.FakeImportRegistry
+		cache    *infohubfakes.FakeCache
+
+		errkind       errors.Kind
+		errtext       string
+		recordDeleted bool
+	}{
+		{
+			name: "import record not found",
+			registry: &infohubfakes.FakeImportRegistry{
+				ImportRecordStub: func(ctx context.Context, id string) (*storage.ImportRecord, error) {
+					return nil, errors.New(errors.NotFound, "import record not found")
+				},
+			},
+			cache:   &infohubfakes.FakeCache{},
+			errkind: errors.NotFound,
+			errtext: "import record not found",
+		},
+		{
+			name:   "record of another client",
+			claims: claims.Claims{"client_id": "client-b"},
+			registry: &infohubfakes.FakeImportRegistry{
+				ImportRecordStub: func(ctx context.Context, id string) (*storage.ImportRecord, error) {
+					return testImportRecord, nil
+				},
+			},
+			cache:   &infohubfakes.FakeCache{},
+			errkind: errors.Forbidden,
+			errtext: "import record belongs to another client",
+		},
+		{
+			name:   "namespace is not allowed for the client",
+			claims: claims.Claims{"client_id": "client-b"},
+			registry: &infohubfakes.FakeImportRegistry{
+				ImportRecordStub: func(ctx context.Context, id string) (*storage.ImportRecord, error) {
+					record := *testImportRecord
+					record.Importer = "client-b"
+					return &record, nil
+				},
+			},
+			cache:   &infohubfakes.FakeCache{},
+			errkind: errors.Forbidden,
+			errtext: `import into namespace "participants" is not allowed`,
+		},
+		{
+			name:   "administrator removes record of another client",
+			claims: claims.Claims{"client_id": "admin", "scope": "infohub:admin"},
+			registry: &infohubfakes.FakeImportRegistry{
+				ImportRecordStub: func(ctx context.Context, id string) (*storage.ImportRecord, error) {
+					return testImportRecord, nil
+				},
+			},
+			cache:         &infohubfakes.FakeCache{},
+			recordDeleted: true,
+		},
+		{
+			name:   "error removing data from cache keeps the record",
+			claims: claims.Claims{"client_id": "client-a"},
+			registry: &infohubfakes.FakeImportRegistry{
+				ImportRecordStub: func(ctx context.Context, id string) (*storage.ImportRecord, error) {
+					return testImportRecord, nil
+				},
+			},
+			cache: &infohubfakes.FakeCache{
+				DeleteStub: func(ctx context.Context, key, namespace, scope string) error {
+					return errors.New(errors.ServiceUnavailable, "cache is unavailable")
+				},
+			},
+			errkind: errors.ServiceUnavailable,
+			errtext: "error removing imported data from cache",
+		},
+		{
+			name:   "imported data and record are removed",
+			claims: claims.Claims{"client_id": "client-a"},
+			registry: &infohubfakes.FakeImportRegistry{
+				ImportRecordStub: func(ctx context.Context, id string) (*storage.ImportRecord, error) {
+					return testImportRecord, nil
+				},
+			},
+			cache:         &infohubfakes.FakeCache{},
+			recordDeleted: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			if test.claims != nil {
+				ctx = claims.NewContext(ctx, test.claims)
+			}
+
+			svc := infohub.New(nil, nil, test.cache, nil, nil, zap.NewNop(),
+				infohub.WithImportRegistry(test.registry),
+				infohub.WithImportNamespaces(map[string][]string{"client-a": {"participants"}}),
+			)
+			err := svc.DeleteImport(ctx, &goainfohub.ImportRecordRequest{ID: "record-1"})
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(test.errkind, err))
+			} else {
+				require.NoError(t, err)
+				_, key, namespace, scope := test.cache.DeleteArgsForCall(0)
+				assert.Equal(t, []string{"key-1", "participants", ""}, []string{key, namespace, scope})
+			}
+
+			require.Equal(t, test.recordDeleted, test.registry.DeleteImportRecordCallCount() == 1)
+			if test.recordDeleted {
+				_, key, namespace, _ := test.registry.DeleteImportRecordArgsForCall(0)
+				assert.Equal(t, "key-1", key)
+				assert.Equal(t, "participants", namespace)
+			}
+		})
+	}
+}
@@ -4,11 +4,41 @@ package infohubfakes
 import (
 	"sync"
 
-	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 )
 
 type FakeCredentials struct {
+	NewCredentialStub        func([]string, map[string]interface{}, ...credential.CredentialOption) (*verifiable.Credential, error)
+	newCredentialMutex       sync.RWMutex
+	newCredentialArgsForCall []struct {
+		arg1 []string
+		arg2 map[string]interface{}
+		arg3 []credential.CredentialOption
+	}
+	newCredentialReturns struct {
+		result1 *verifiable.Credential
+		result2 error
+	}
+	newCredentialReturnsOnCall map[int]struct {
+		result1 *verifiable.Credential
+		result2 error
+	}
+	NewPresentationStub        func([]string, ...*verifiable.Credential) (*verifiable.Presentation, error)
+	newPresentationMutex       sync.RWMutex
+	newPresentationArgsForCall []struct {
+		arg1 []string
+		arg2 []*verifiable.Credential
+	}
+	newPresentationReturns struct {
+		result1 *verifiable.Presentation
+		result2 error
+	}
+	newPresentationReturnsOnCall map[int]struct {
+		result1 *verifiable.Presentation
+		result2 error
+	}
 	ParsePresentationStub        func([]byte) (*verifiable.Presentation, error)
 	parsePresentationMutex       sync.RWMutex
 	parsePresentationArgsForCall []struct {
@@ -26,6 +56,147 @@ type FakeCredentials struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCredentials) NewCredential(arg1 []string, arg2 map[string]interface{}, arg3 ...credential.CredentialOption) (*verifiable.Credential, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.newCredentialMutex.Lock()
+	ret, specificReturn := fake.newCredentialReturnsOnCall[len(fake.newCredentialArgsForCall)]
+	fake.newCredentialArgsForCall = append(fake.newCredentialArgsForCall, struct {
+		arg1 []string
+		arg2 map[string]interface{}
+		arg3 []credential.CredentialOption
+	}{arg1Copy, arg2, arg3})
+	stub := fake.NewCredentialStub
+	fakeReturns := fake.newCredentialReturns
+	fake.recordInvocation("NewCredential", []interface{}{arg1Copy, arg2, arg3})
+	fake.newCredentialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCredentials) NewCredentialCallCount() int {
+	fake.newCredentialMutex.RLock()
+	defer fake.newCredentialMutex.RUnlock()
+	return len(fake.newCredentialArgsForCall)
+}
+
+func (fake *FakeCredentials) NewCredentialCalls(stub func([]string, map[string]interface{}, ...credential.CredentialOption) (*verifiable.Credential, error)) {
+	fake.newCredentialMutex.Lock()
+	defer fake.newCredentialMutex.Unlock()
+	fake.NewCredentialStub = stub
+}
+
+func (fake *FakeCredentials) NewCredentialArgsForCall(i int) ([]string, map[string]interface{}, []credential.CredentialOption) {
+	fake.newCredentialMutex.RLock()
+	defer fake.newCredentialMutex.RUnlock()
+	argsForCall := fake.newCredentialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCredentials) NewCredentialReturns(result1 *verifiable.Credential, result2 error) {
+	fake.newCredentialMutex.Lock()
+	defer fake.newCredentialMutex.Unlock()
+	fake.NewCredentialStub = nil
+	fake.newCredentialReturns = struct {
+		result1 *verifiable.Credential
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredentials) NewCredentialReturnsOnCall(i int, result1 *verifiable.Credential, result2 error) {
+	fake.newCredentialMutex.Lock()
+	defer fake.newCredentialMutex.Unlock()
+	fake.NewCredentialStub = nil
+	if fake.newCredentialReturnsOnCall == nil {
+		fake.newCredentialReturnsOnCall = make(map[int]struct {
+			result1 *verifiable.Credential
+			result2 error
+		})
+	}
+	fake.newCredentialReturnsOnCall[i] = struct {
+		result1 *verifiable.Credential
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredentials) NewPresentation(arg1 []string, arg2 ...*verifiable.Credential) (*verifiable.Presentation, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.newPresentationMutex.Lock()
+	ret, specificReturn := fake.newPresentationReturnsOnCall[len(fake.newPresentationArgsForCall)]
+	fake.newPresentationArgsForCall = append(fake.newPresentationArgsForCall, struct {
+		arg1 []string
+		arg2 []*verifiable.Credential
+	}{arg1Copy, arg2})
+	stub := fake.NewPresentationStub
+	fakeReturns := fake.newPresentationReturns
+	fake.recordInvocation("NewPresentation", []interface{}{arg1Copy, arg2})
+	fake.newPresentationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCredentials) NewPresentationCallCount() int {
+	fake.newPresentationMutex.RLock()
+	defer fake.newPresentationMutex.RUnlock()
+	return len(fake.newPresentationArgsForCall)
+}
+
+func (fake *FakeCredentials) NewPresentationCalls(stub func([]string, ...*verifiable.Credential) (*verifiable.Presentation, error)) {
+	fake.newPresentationMutex.Lock()
+	defer fake.newPresentationMutex.Unlock()
+	fake.NewPresentationStub = stub
+}
+
+func (fake *FakeCredentials) NewPresentationArgsForCall(i int) ([]string, []*verifiable.Credential) {
+	fake.newPresentationMutex.RLock()
+	defer fake.newPresentationMutex.RUnlock()
+	argsForCall := fake.newPresentationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCredentials) NewPresentationReturns(result1 *verifiable.Presentation, result2 error) {
+	fake.newPresentationMutex.Lock()
+	defer fake.newPresentationMutex.Unlock()
+	fake.NewPresentationStub = nil
+	fake.newPresentationReturns = struct {
+		result1 *verifiable.Presentation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCredentials) NewPresentationReturnsOnCall(i int, result1 *verifiable.Presentation, result2 error) {
+	fake.newPresentationMutex.Lock()
+	defer fake.newPresentationMutex.Unlock()
+	fake.NewPresentationStub = nil
+	if fake.newPresentationReturnsOnCall == nil {
+		fake.newPresentationReturnsOnCall = make(map[int]struct {
+			result1 *verifiable.Presentation
+			result2 error
+		})
+	}
+	fake.newPresentationReturnsOnCall[i] = struct {
+		result1 *verifiable.Presentation
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCredentials) ParsePresentation(arg1 []byte) (*verifiable.Presentation, error) {
 	var arg1Copy []byte
 	if arg1 != nil {
@@ -98,6 +269,10 @@ func (fake *FakeCredentials) ParsePresentationReturnsOnCall(i int, result1 *veri
 func (fake *FakeCredentials) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.newCredentialMutex.RLock()
+	defer fake.newCredentialMutex.RUnlock()
+	fake.newPresentationMutex.RLock()
+	defer fake.newPresentationMutex.RUnlock()
 	fake.parsePresentationMutex.RLock()
 	defer fake.parsePresentationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
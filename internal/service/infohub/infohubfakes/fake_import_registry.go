@@ -0,0 +1,534 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package infohubfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+type FakeImportRegistry struct {
+	DeleteImportRecordStub        func(context.Context, string, string, string) error
+	deleteImportRecordMutex       sync.RWMutex
+	deleteImportRecordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	deleteImportRecordReturns struct {
+		result1 error
+	}
+	deleteImportRecordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FindImportRecordStub        func(context.Context, string, string, string, string) (*storage.ImportRecord, error)
+	findImportRecordMutex       sync.RWMutex
+	findImportRecordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}
+	findImportRecordReturns struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	findImportRecordReturnsOnCall map[int]struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	ImportRecordStub        func(context.Context, string) (*storage.ImportRecord, error)
+	importRecordMutex       sync.RWMutex
+	importRecordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	importRecordReturns struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	importRecordReturnsOnCall map[int]struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	ImportRecordsStub        func(context.Context, *storage.ImportRecordFilter) ([]*storage.ImportRecord, error)
+	importRecordsMutex       sync.RWMutex
+	importRecordsArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storage.ImportRecordFilter
+	}
+	importRecordsReturns struct {
+		result1 []*storage.ImportRecord
+		result2 error
+	}
+	importRecordsReturnsOnCall map[int]struct {
+		result1 []*storage.ImportRecord
+		result2 error
+	}
+	KeyImportRecordStub        func(context.Context, string, string, string) (*storage.ImportRecord, error)
+	keyImportRecordMutex       sync.RWMutex
+	keyImportRecordArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	keyImportRecordReturns struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	keyImportRecordReturnsOnCall map[int]struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	SaveImportRecordStub        func(context.Context, *storage.ImportRecord) (*storage.ImportRecord, error)
+	saveImportRecordMutex       sync.RWMutex
+	saveImportRecordArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storage.ImportRecord
+	}
+	saveImportRecordReturns struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	saveImportRecordReturnsOnCall map[int]struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeImportRegistry) DeleteImportRecord(arg1 context.Context, arg2 string, arg3 string, arg4 string) error {
+	fake.deleteImportRecordMutex.Lock()
+	ret, specificReturn := fake.deleteImportRecordReturnsOnCall[len(fake.deleteImportRecordArgsForCall)]
+	fake.deleteImportRecordArgsForCall = append(fake.deleteImportRecordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteImportRecordStub
+	fakeReturns := fake.deleteImportRecordReturns
+	fake.recordInvocation("DeleteImportRecord", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteImportRecordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeImportRegistry) DeleteImportRecordCallCount() int {
+	fake.deleteImportRecordMutex.RLock()
+	defer fake.deleteImportRecordMutex.RUnlock()
+	return len(fake.deleteImportRecordArgsForCall)
+}
+
+func (fake *FakeImportRegistry) DeleteImportRecordCalls(stub func(context.Context, string, string, string) error) {
+	fake.deleteImportRecordMutex.Lock()
+	defer fake.deleteImportRecordMutex.Unlock()
+	fake.DeleteImportRecordStub = stub
+}
+
+func (fake *FakeImportRegistry) DeleteImportRecordArgsForCall(i int) (context.Context, string, string, string) {
+	fake.deleteImportRecordMutex.RLock()
+	defer fake.deleteImportRecordMutex.RUnlock()
+	argsForCall := fake.deleteImportRecordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeImportRegistry) DeleteImportRecordReturns(result1 error) {
+	fake.deleteImportRecordMutex.Lock()
+	defer fake.deleteImportRecordMutex.Unlock()
+	fake.DeleteImportRecordStub = nil
+	fake.deleteImportRecordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImportRegistry) DeleteImportRecordReturnsOnCall(i int, result1 error) {
+	fake.deleteImportRecordMutex.Lock()
+	defer fake.deleteImportRecordMutex.Unlock()
+	fake.DeleteImportRecordStub = nil
+	if fake.deleteImportRecordReturnsOnCall == nil {
+		fake.deleteImportRecordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteImportRecordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeImportRegistry) FindImportRecord(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string) (*storage.ImportRecord, error) {
+	fake.findImportRecordMutex.Lock()
+	ret, specificReturn := fake.findImportRecordReturnsOnCall[len(fake.findImportRecordArgsForCall)]
+	fake.findImportRecordArgsForCall = append(fake.findImportRecordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.FindImportRecordStub
+	fakeReturns := fake.findImportRecordReturns
+	fake.recordInvocation("FindImportRecord", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.findImportRecordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportRegistry) FindImportRecordCallCount() int {
+	fake.findImportRecordMutex.RLock()
+	defer fake.findImportRecordMutex.RUnlock()
+	return len(fake.findImportRecordArgsForCall)
+}
+
+func (fake *FakeImportRegistry) FindImportRecordCalls(stub func(context.Context, string, string, string, string) (*storage.ImportRecord, error)) {
+	fake.findImportRecordMutex.Lock()
+	defer fake.findImportRecordMutex.Unlock()
+	fake.FindImportRecordStub = stub
+}
+
+func (fake *FakeImportRegistry) FindImportRecordArgsForCall(i int) (context.Context, string, string, string, string) {
+	fake.findImportRecordMutex.RLock()
+	defer fake.findImportRecordMutex.RUnlock()
+	argsForCall := fake.findImportRecordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeImportRegistry) FindImportRecordReturns(result1 *storage.ImportRecord, result2 error) {
+	fake.findImportRecordMutex.Lock()
+	defer fake.findImportRecordMutex.Unlock()
+	fake.FindImportRecordStub = nil
+	fake.findImportRecordReturns = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) FindImportRecordReturnsOnCall(i int, result1 *storage.ImportRecord, result2 error) {
+	fake.findImportRecordMutex.Lock()
+	defer fake.findImportRecordMutex.Unlock()
+	fake.FindImportRecordStub = nil
+	if fake.findImportRecordReturnsOnCall == nil {
+		fake.findImportRecordReturnsOnCall = make(map[int]struct {
+			result1 *storage.ImportRecord
+			result2 error
+		})
+	}
+	fake.findImportRecordReturnsOnCall[i] = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) ImportRecord(arg1 context.Context, arg2 string) (*storage.ImportRecord, error) {
+	fake.importRecordMutex.Lock()
+	ret, specificReturn := fake.importRecordReturnsOnCall[len(fake.importRecordArgsForCall)]
+	fake.importRecordArgsForCall = append(fake.importRecordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ImportRecordStub
+	fakeReturns := fake.importRecordReturns
+	fake.recordInvocation("ImportRecord", []interface{}{arg1, arg2})
+	fake.importRecordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportRegistry) ImportRecordCallCount() int {
+	fake.importRecordMutex.RLock()
+	defer fake.importRecordMutex.RUnlock()
+	return len(fake.importRecordArgsForCall)
+}
+
+func (fake *FakeImportRegistry) ImportRecordCalls(stub func(context.Context, string) (*storage.ImportRecord, error)) {
+	fake.importRecordMutex.Lock()
+	defer fake.importRecordMutex.Unlock()
+	fake.ImportRecordStub = stub
+}
+
+func (fake *FakeImportRegistry) ImportRecordArgsForCall(i int) (context.Context, string) {
+	fake.importRecordMutex.RLock()
+	defer fake.importRecordMutex.RUnlock()
+	argsForCall := fake.importRecordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImportRegistry) ImportRecordReturns(result1 *storage.ImportRecord, result2 error) {
+	fake.importRecordMutex.Lock()
+	defer fake.importRecordMutex.Unlock()
+	fake.ImportRecordStub = nil
+	fake.importRecordReturns = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) ImportRecordReturnsOnCall(i int, result1 *storage.ImportRecord, result2 error) {
+	fake.importRecordMutex.Lock()
+	defer fake.importRecordMutex.Unlock()
+	fake.ImportRecordStub = nil
+	if fake.importRecordReturnsOnCall == nil {
+		fake.importRecordReturnsOnCall = make(map[int]struct {
+			result1 *storage.ImportRecord
+			result2 error
+		})
+	}
+	fake.importRecordReturnsOnCall[i] = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) ImportRecords(arg1 context.Context, arg2 *storage.ImportRecordFilter) ([]*storage.ImportRecord, error) {
+	fake.importRecordsMutex.Lock()
+	ret, specificReturn := fake.importRecordsReturnsOnCall[len(fake.importRecordsArgsForCall)]
+	fake.importRecordsArgsForCall = append(fake.importRecordsArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storage.ImportRecordFilter
+	}{arg1, arg2})
+	stub := fake.ImportRecordsStub
+	fakeReturns := fake.importRecordsReturns
+	fake.recordInvocation("ImportRecords", []interface{}{arg1, arg2})
+	fake.importRecordsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportRegistry) ImportRecordsCallCount() int {
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	return len(fake.importRecordsArgsForCall)
+}
+
+func (fake *FakeImportRegistry) ImportRecordsCalls(stub func(context.Context, *storage.ImportRecordFilter) ([]*storage.ImportRecord, error)) {
+	fake.importRecordsMutex.Lock()
+	defer fake.importRecordsMutex.Unlock()
+	fake.ImportRecordsStub = stub
+}
+
+func (fake *FakeImportRegistry) ImportRecordsArgsForCall(i int) (context.Context, *storage.ImportRecordFilter) {
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	argsForCall := fake.importRecordsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImportRegistry) ImportRecordsReturns(result1 []*storage.ImportRecord, result2 error) {
+	fake.importRecordsMutex.Lock()
+	defer fake.importRecordsMutex.Unlock()
+	fake.ImportRecordsStub = nil
+	fake.importRecordsReturns = struct {
+		result1 []*storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) ImportRecordsReturnsOnCall(i int, result1 []*storage.ImportRecord, result2 error) {
+	fake.importRecordsMutex.Lock()
+	defer fake.importRecordsMutex.Unlock()
+	fake.ImportRecordsStub = nil
+	if fake.importRecordsReturnsOnCall == nil {
+		fake.importRecordsReturnsOnCall = make(map[int]struct {
+			result1 []*storage.ImportRecord
+			result2 error
+		})
+	}
+	fake.importRecordsReturnsOnCall[i] = struct {
+		result1 []*storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) KeyImportRecord(arg1 context.Context, arg2 string, arg3 string, arg4 string) (*storage.ImportRecord, error) {
+	fake.keyImportRecordMutex.Lock()
+	ret, specificReturn := fake.keyImportRecordReturnsOnCall[len(fake.keyImportRecordArgsForCall)]
+	fake.keyImportRecordArgsForCall = append(fake.keyImportRecordArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.KeyImportRecordStub
+	fakeReturns := fake.keyImportRecordReturns
+	fake.recordInvocation("KeyImportRecord", []interface{}{arg1, arg2, arg3, arg4})
+	fake.keyImportRecordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportRegistry) KeyImportRecordCallCount() int {
+	fake.keyImportRecordMutex.RLock()
+	defer fake.keyImportRecordMutex.RUnlock()
+	return len(fake.keyImportRecordArgsForCall)
+}
+
+func (fake *FakeImportRegistry) KeyImportRecordCalls(stub func(context.Context, string, string, string) (*storage.ImportRecord, error)) {
+	fake.keyImportRecordMutex.Lock()
+	defer fake.keyImportRecordMutex.Unlock()
+	fake.KeyImportRecordStub = stub
+}
+
+func (fake *FakeImportRegistry) KeyImportRecordArgsForCall(i int) (context.Context, string, string, string) {
+	fake.keyImportRecordMutex.RLock()
+	defer fake.keyImportRecordMutex.RUnlock()
+	argsForCall := fake.keyImportRecordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeImportRegistry) KeyImportRecordReturns(result1 *storage.ImportRecord, result2 error) {
+	fake.keyImportRecordMutex.Lock()
+	defer fake.keyImportRecordMutex.Unlock()
+	fake.KeyImportRecordStub = nil
+	fake.keyImportRecordReturns = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) KeyImportRecordReturnsOnCall(i int, result1 *storage.ImportRecord, result2 error) {
+	fake.keyImportRecordMutex.Lock()
+	defer fake.keyImportRecordMutex.Unlock()
+	fake.KeyImportRecordStub = nil
+	if fake.keyImportRecordReturnsOnCall == nil {
+		fake.keyImportRecordReturnsOnCall = make(map[int]struct {
+			result1 *storage.ImportRecord
+			result2 error
+		})
+	}
+	fake.keyImportRecordReturnsOnCall[i] = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) SaveImportRecord(arg1 context.Context, arg2 *storage.ImportRecord) (*storage.ImportRecord, error) {
+	fake.saveImportRecordMutex.Lock()
+	ret, specificReturn := fake.saveImportRecordReturnsOnCall[len(fake.saveImportRecordArgsForCall)]
+	fake.saveImportRecordArgsForCall = append(fake.saveImportRecordArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storage.ImportRecord
+	}{arg1, arg2})
+	stub := fake.SaveImportRecordStub
+	fakeReturns := fake.saveImportRecordReturns
+	fake.recordInvocation("SaveImportRecord", []interface{}{arg1, arg2})
+	fake.saveImportRecordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeImportRegistry) SaveImportRecordCallCount() int {
+	fake.saveImportRecordMutex.RLock()
+	defer fake.saveImportRecordMutex.RUnlock()
+	return len(fake.saveImportRecordArgsForCall)
+}
+
+func (fake *FakeImportRegistry) SaveImportRecordCalls(stub func(context.Context, *storage.ImportRecord) (*storage.ImportRecord, error)) {
+	fake.saveImportRecordMutex.Lock()
+	defer fake.saveImportRecordMutex.Unlock()
+	fake.SaveImportRecordStub = stub
+}
+
+func (fake *FakeImportRegistry) SaveImportRecordArgsForCall(i int) (context.Context, *storage.ImportRecord) {
+	fake.saveImportRecordMutex.RLock()
+	defer fake.saveImportRecordMutex.RUnlock()
+	argsForCall := fake.saveImportRecordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeImportRegistry) SaveImportRecordReturns(result1 *storage.ImportRecord, result2 error) {
+	fake.saveImportRecordMutex.Lock()
+	defer fake.saveImportRecordMutex.Unlock()
+	fake.SaveImportRecordStub = nil
+	fake.saveImportRecordReturns = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) SaveImportRecordReturnsOnCall(i int, result1 *storage.ImportRecord, result2 error) {
+	fake.saveImportRecordMutex.Lock()
+	defer fake.saveImportRecordMutex.Unlock()
+	fake.SaveImportRecordStub = nil
+	if fake.saveImportRecordReturnsOnCall == nil {
+		fake.saveImportRecordReturnsOnCall = make(map[int]struct {
+			result1 *storage.ImportRecord
+			result2 error
+		})
+	}
+	fake.saveImportRecordReturnsOnCall[i] = struct {
+		result1 *storage.ImportRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeImportRegistry) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteImportRecordMutex.RLock()
+	defer fake.deleteImportRecordMutex.RUnlock()
+	fake.findImportRecordMutex.RLock()
+	defer fake.findImportRecordMutex.RUnlock()
+	fake.importRecordMutex.RLock()
+	defer fake.importRecordMutex.RUnlock()
+	fake.importRecordsMutex.RLock()
+	defer fake.importRecordsMutex.RUnlock()
+	fake.keyImportRecordMutex.RLock()
+	defer fake.keyImportRecordMutex.RUnlock()
+	fake.saveImportRecordMutex.RLock()
+	defer fake.saveImportRecordMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeImportRegistry) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ infohub.ImportRegistry = new(FakeImportRegistry)
@@ -0,0 +1,206 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package infohubfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+)
+
+type FakeJWTParser struct {
+	ParseCredentialStub        func(context.Context, interface{}) (map[string]interface{}, error)
+	parseCredentialMutex       sync.RWMutex
+	parseCredentialArgsForCall []struct {
+		arg1 context.Context
+		arg2 interface{}
+	}
+	parseCredentialReturns struct {
+		result1 map[string]interface{}
+		result2 error
+	}
+	parseCredentialReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+		result2 error
+	}
+	ParsePresentationStub        func(context.Context, []byte) (*jwtvc.Presentation, error)
+	parsePresentationMutex       sync.RWMutex
+	parsePresentationArgsForCall []struct {
+		arg1 context.Context
+		arg2 []byte
+	}
+	parsePresentationReturns struct {
+		result1 *jwtvc.Presentation
+		result2 error
+	}
+	parsePresentationReturnsOnCall map[int]struct {
+		result1 *jwtvc.Presentation
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeJWTParser) ParseCredential(arg1 context.Context, arg2 interface{}) (map[string]interface{}, error) {
+	fake.parseCredentialMutex.Lock()
+	ret, specificReturn := fake.parseCredentialReturnsOnCall[len(fake.parseCredentialArgsForCall)]
+	fake.parseCredentialArgsForCall = append(fake.parseCredentialArgsForCall, struct {
+		arg1 context.Context
+		arg2 interface{}
+	}{arg1, arg2})
+	stub := fake.ParseCredentialStub
+	fakeReturns := fake.parseCredentialReturns
+	fake.recordInvocation("ParseCredential", []interface{}{arg1, arg2})
+	fake.parseCredentialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJWTParser) ParseCredentialCallCount() int {
+	fake.parseCredentialMutex.RLock()
+	defer fake.parseCredentialMutex.RUnlock()
+	return len(fake.parseCredentialArgsForCall)
+}
+
+func (fake *FakeJWTParser) ParseCredentialCalls(stub func(context.Context, interface{}) (map[string]interface{}, error)) {
+	fake.parseCredentialMutex.Lock()
+	defer fake.parseCredentialMutex.Unlock()
+	fake.ParseCredentialStub = stub
+}
+
+func (fake *FakeJWTParser) ParseCredentialArgsForCall(i int) (context.Context, interface{}) {
+	fake.parseCredentialMutex.RLock()
+	defer fake.parseCredentialMutex.RUnlock()
+	argsForCall := fake.parseCredentialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJWTParser) ParseCredentialReturns(result1 map[string]interface{}, result2 error) {
+	fake.parseCredentialMutex.Lock()
+	defer fake.parseCredentialMutex.Unlock()
+	fake.ParseCredentialStub = nil
+	fake.parseCredentialReturns = struct {
+		result1 map[string]interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTParser) ParseCredentialReturnsOnCall(i int, result1 map[string]interface{}, result2 error) {
+	fake.parseCredentialMutex.Lock()
+	defer fake.parseCredentialMutex.Unlock()
+	fake.ParseCredentialStub = nil
+	if fake.parseCredentialReturnsOnCall == nil {
+		fake.parseCredentialReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+			result2 error
+		})
+	}
+	fake.parseCredentialReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTParser) ParsePresentation(arg1 context.Context, arg2 []byte) (*jwtvc.Presentation, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.parsePresentationMutex.Lock()
+	ret, specificReturn := fake.parsePresentationReturnsOnCall[len(fake.parsePresentationArgsForCall)]
+	fake.parsePresentationArgsForCall = append(fake.parsePresentationArgsForCall, struct {
+		arg1 context.Context
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.ParsePresentationStub
+	fakeReturns := fake.parsePresentationReturns
+	fake.recordInvocation("ParsePresentation", []interface{}{arg1, arg2Copy})
+	fake.parsePresentationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJWTParser) ParsePresentationCallCount() int {
+	fake.parsePresentationMutex.RLock()
+	defer fake.parsePresentationMutex.RUnlock()
+	return len(fake.parsePresentationArgsForCall)
+}
+
+func (fake *FakeJWTParser) ParsePresentationCalls(stub func(context.Context, []byte) (*jwtvc.Presentation, error)) {
+	fake.parsePresentationMutex.Lock()
+	defer fake.parsePresentationMutex.Unlock()
+	fake.ParsePresentationStub = stub
+}
+
+func (fake *FakeJWTParser) ParsePresentationArgsForCall(i int) (context.Context, []byte) {
+	fake.parsePresentationMutex.RLock()
+	defer fake.parsePresentationMutex.RUnlock()
+	argsForCall := fake.parsePresentationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJWTParser) ParsePresentationReturns(result1 *jwtvc.Presentation, result2 error) {
+	fake.parsePresentationMutex.Lock()
+	defer fake.parsePresentationMutex.Unlock()
+	fake.ParsePresentationStub = nil
+	fake.parsePresentationReturns = struct {
+		result1 *jwtvc.Presentation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTParser) ParsePresentationReturnsOnCall(i int, result1 *jwtvc.Presentation, result2 error) {
+	fake.parsePresentationMutex.Lock()
+	defer fake.parsePresentationMutex.Unlock()
+	fake.ParsePresentationStub = nil
+	if fake.parsePresentationReturnsOnCall == nil {
+		fake.parsePresentationReturnsOnCall = make(map[int]struct {
+			result1 *jwtvc.Presentation
+			result2 error
+		})
+	}
+	fake.parsePresentationReturnsOnCall[i] = struct {
+		result1 *jwtvc.Presentation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJWTParser) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.parseCredentialMutex.RLock()
+	defer fake.parseCredentialMutex.RUnlock()
+	fake.parsePresentationMutex.RLock()
+	defer fake.parsePresentationMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeJWTParser) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ infohub.JWTParser = new(FakeJWTParser)
@@ -0,0 +1,192 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package infohubfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+)
+
+type FakeSchemaValidator struct {
+	ValidateStub        func(context.Context, string, interface{}) error
+	validateMutex       sync.RWMutex
+	validateArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 interface{}
+	}
+	validateReturns struct {
+		result1 error
+	}
+	validateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateCredentialStub        func(context.Context, map[string]interface{}) error
+	validateCredentialMutex       sync.RWMutex
+	validateCredentialArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]interface{}
+	}
+	validateCredentialReturns struct {
+		result1 error
+	}
+	validateCredentialReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSchemaValidator) Validate(arg1 context.Context, arg2 string, arg3 interface{}) error {
+	fake.validateMutex.Lock()
+	ret, specificReturn := fake.validateReturnsOnCall[len(fake.validateArgsForCall)]
+	fake.validateArgsForCall = append(fake.validateArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 interface{}
+	}{arg1, arg2, arg3})
+	stub := fake.ValidateStub
+	fakeReturns := fake.validateReturns
+	fake.recordInvocation("Validate", []interface{}{arg1, arg2, arg3})
+	fake.validateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSchemaValidator) ValidateCallCount() int {
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	return len(fake.validateArgsForCall)
+}
+
+func (fake *FakeSchemaValidator) ValidateCalls(stub func(context.Context, string, interface{}) error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = stub
+}
+
+func (fake *FakeSchemaValidator) ValidateArgsForCall(i int) (context.Context, string, interface{}) {
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	argsForCall := fake.validateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeSchemaValidator) ValidateReturns(result1 error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = nil
+	fake.validateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSchemaValidator) ValidateReturnsOnCall(i int, result1 error) {
+	fake.validateMutex.Lock()
+	defer fake.validateMutex.Unlock()
+	fake.ValidateStub = nil
+	if fake.validateReturnsOnCall == nil {
+		fake.validateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSchemaValidator) ValidateCredential(arg1 context.Context, arg2 map[string]interface{}) error {
+	fake.validateCredentialMutex.Lock()
+	ret, specificReturn := fake.validateCredentialReturnsOnCall[len(fake.validateCredentialArgsForCall)]
+	fake.validateCredentialArgsForCall = append(fake.validateCredentialArgsForCall, struct {
+		arg1 context.Context
+		arg2 map[string]interface{}
+	}{arg1, arg2})
+	stub := fake.ValidateCredentialStub
+	fakeReturns := fake.validateCredentialReturns
+	fake.recordInvocation("ValidateCredential", []interface{}{arg1, arg2})
+	fake.validateCredentialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSchemaValidator) ValidateCredentialCallCount() int {
+	fake.validateCredentialMutex.RLock()
+	defer fake.validateCredentialMutex.RUnlock()
+	return len(fake.validateCredentialArgsForCall)
+}
+
+func (fake *FakeSchemaValidator) ValidateCredentialCalls(stub func(context.Context, map[string]interface{}) error) {
+	fake.validateCredentialMutex.Lock()
+	defer fake.validateCredentialMutex.Unlock()
+	fake.ValidateCredentialStub = stub
+}
+
+func (fake *FakeSchemaValidator) ValidateCredentialArgsForCall(i int) (context.Context, map[string]interface{}) {
+	fake.validateCredentialMutex.RLock()
+	defer fake.validateCredentialMutex.RUnlock()
+	argsForCall := fake.validateCredentialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSchemaValidator) ValidateCredentialReturns(result1 error) {
+	fake.validateCredentialMutex.Lock()
+	defer fake.validateCredentialMutex.Unlock()
+	fake.ValidateCredentialStub = nil
+	fake.validateCredentialReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSchemaValidator) ValidateCredentialReturnsOnCall(i int, result1 error) {
+	fake.validateCredentialMutex.Lock()
+	defer fake.validateCredentialMutex.Unlock()
+	fake.ValidateCredentialStub = nil
+	if fake.validateCredentialReturnsOnCall == nil {
+		fake.validateCredentialReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCredentialReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSchemaValidator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.validateMutex.RLock()
+	defer fake.validateMutex.RUnlock()
+	fake.validateCredentialMutex.RLock()
+	defer fake.validateCredentialMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSchemaValidator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ infohub.SchemaValidator = new(FakeSchemaValidator)
@@ -6,26 +6,45 @@ import (
 	"sync"
 
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 )
 
 type FakeSigner struct {
-	CreatePresentationStub        func(context.Context, string, string, string, []map[string]interface{}) (map[string]interface{}, error)
-	createPresentationMutex       sync.RWMutex
-	createPresentationArgsForCall []struct {
+	PresentationProofStub        func(context.Context, string, string, string, *verifiable.Presentation) (map[string]interface{}, error)
+	presentationProofMutex       sync.RWMutex
+	presentationProofArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
 		arg4 string
-		arg5 []map[string]interface{}
+		arg5 *verifiable.Presentation
 	}
-	createPresentationReturns struct {
+	presentationProofReturns struct {
 		result1 map[string]interface{}
 		result2 error
 	}
-	createPresentationReturnsOnCall map[int]struct {
+	presentationProofReturnsOnCall map[int]struct {
 		result1 map[string]interface{}
 		result2 error
 	}
+	SignJWTStub        func(context.Context, string, string, string, string, map[string]interface{}) (string, error)
+	signJWTMutex       sync.RWMutex
+	signJWTArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 map[string]interface{}
+	}
+	signJWTReturns struct {
+		result1 string
+		result2 error
+	}
+	signJWTReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	VerifyPresentationStub        func(context.Context, []byte) error
 	verifyPresentationMutex       sync.RWMutex
 	verifyPresentationArgsForCall []struct {
@@ -42,25 +61,20 @@ type FakeSigner struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeSigner) CreatePresentation(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 []map[string]interface{}) (map[string]interface{}, error) {
-	var arg5Copy []map[string]interface{}
-	if arg5 != nil {
-		arg5Copy = make([]map[string]interface{}, len(arg5))
-		copy(arg5Copy, arg5)
-	}
-	fake.createPresentationMutex.Lock()
-	ret, specificReturn := fake.createPresentationReturnsOnCall[len(fake.createPresentationArgsForCall)]
-	fake.createPresentationArgsForCall = append(fake.createPresentationArgsForCall, struct {
+func (fake *FakeSigner) PresentationProof(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 *verifiable.Presentation) (map[string]interface{}, error) {
+	fake.presentationProofMutex.Lock()
+	ret, specificReturn := fake.presentationProofReturnsOnCall[len(fake.presentationProofArgsForCall)]
+	fake.presentationProofArgsForCall = append(fake.presentationProofArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 		arg3 string
 		arg4 string
-		arg5 []map[string]interface{}
-	}{arg1, arg2, arg3, arg4, arg5Copy})
-	stub := fake.CreatePresentationStub
-	fakeReturns := fake.createPresentationReturns
-	fake.recordInvocation("CreatePresentation", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
-	fake.createPresentationMutex.Unlock()
+		arg5 *verifiable.Presentation
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.PresentationProofStub
+	fakeReturns := fake.presentationProofReturns
+	fake.recordInvocation("PresentationProof", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.presentationProofMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
@@ -70,51 +84,120 @@ func (fake *FakeSigner) CreatePresentation(arg1 context.Context, arg2 string, ar
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeSigner) CreatePresentationCallCount() int {
-	fake.createPresentationMutex.RLock()
-	defer fake.createPresentationMutex.RUnlock()
-	return len(fake.createPresentationArgsForCall)
+func (fake *FakeSigner) PresentationProofCallCount() int {
+	fake.presentationProofMutex.RLock()
+	defer fake.presentationProofMutex.RUnlock()
+	return len(fake.presentationProofArgsForCall)
 }
 
-func (fake *FakeSigner) CreatePresentationCalls(stub func(context.Context, string, string, string, []map[string]interface{}) (map[string]interface{}, error)) {
-	fake.createPresentationMutex.Lock()
-	defer fake.createPresentationMutex.Unlock()
-	fake.CreatePresentationStub = stub
+func (fake *FakeSigner) PresentationProofCalls(stub func(context.Context, string, string, string, *verifiable.Presentation) (map[string]interface{}, error)) {
+	fake.presentationProofMutex.Lock()
+	defer fake.presentationProofMutex.Unlock()
+	fake.PresentationProofStub = stub
 }
 
-func (fake *FakeSigner) CreatePresentationArgsForCall(i int) (context.Context, string, string, string, []map[string]interface{}) {
-	fake.createPresentationMutex.RLock()
-	defer fake.createPresentationMutex.RUnlock()
-	argsForCall := fake.createPresentationArgsForCall[i]
+func (fake *FakeSigner) PresentationProofArgsForCall(i int) (context.Context, string, string, string, *verifiable.Presentation) {
+	fake.presentationProofMutex.RLock()
+	defer fake.presentationProofMutex.RUnlock()
+	argsForCall := fake.presentationProofArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeSigner) CreatePresentationReturns(result1 map[string]interface{}, result2 error) {
-	fake.createPresentationMutex.Lock()
-	defer fake.createPresentationMutex.Unlock()
-	fake.CreatePresentationStub = nil
-	fake.createPresentationReturns = struct {
+func (fake *FakeSigner) PresentationProofReturns(result1 map[string]interface{}, result2 error) {
+	fake.presentationProofMutex.Lock()
+	defer fake.presentationProofMutex.Unlock()
+	fake.PresentationProofStub = nil
+	fake.presentationProofReturns = struct {
 		result1 map[string]interface{}
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeSigner) CreatePresentationReturnsOnCall(i int, result1 map[string]interface{}, result2 error) {
-	fake.createPresentationMutex.Lock()
-	defer fake.createPresentationMutex.Unlock()
-	fake.CreatePresentationStub = nil
-	if fake.createPresentationReturnsOnCall == nil {
-		fake.createPresentationReturnsOnCall = make(map[int]struct {
+func (fake *FakeSigner) PresentationProofReturnsOnCall(i int, result1 map[string]interface{}, result2 error) {
+	fake.presentationProofMutex.Lock()
+	defer fake.presentationProofMutex.Unlock()
+	fake.PresentationProofStub = nil
+	if fake.presentationProofReturnsOnCall == nil {
+		fake.presentationProofReturnsOnCall = make(map[int]struct {
 			result1 map[string]interface{}
 			result2 error
 		})
 	}
-	fake.createPresentationReturnsOnCall[i] = struct {
+	fake.presentationProofReturnsOnCall[i] = struct {
 		result1 map[string]interface{}
 		result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeSigner) SignJWT(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string, arg6 map[string]interface{}) (string, error) {
+	fake.signJWTMutex.Lock()
+	ret, specificReturn := fake.signJWTReturnsOnCall[len(fake.signJWTArgsForCall)]
+	fake.signJWTArgsForCall = append(fake.signJWTArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+		arg6 map[string]interface{}
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.SignJWTStub
+	fakeReturns := fake.signJWTReturns
+	fake.recordInvocation("SignJWT", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.signJWTMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeSigner) SignJWTCallCount() int {
+	fake.signJWTMutex.RLock()
+	defer fake.signJWTMutex.RUnlock()
+	return len(fake.signJWTArgsForCall)
+}
+
+func (fake *FakeSigner) SignJWTCalls(stub func(context.Context, string, string, string, string, map[string]interface{}) (string, error)) {
+	fake.signJWTMutex.Lock()
+	defer fake.signJWTMutex.Unlock()
+	fake.SignJWTStub = stub
+}
+
+func (fake *FakeSigner) SignJWTArgsForCall(i int) (context.Context, string, string, string, string, map[string]interface{}) {
+	fake.signJWTMutex.RLock()
+	defer fake.signJWTMutex.RUnlock()
+	argsForCall := fake.signJWTArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeSigner) SignJWTReturns(result1 string, result2 error) {
+	fake.signJWTMutex.Lock()
+	defer fake.signJWTMutex.Unlock()
+	fake.SignJWTStub = nil
+	fake.signJWTReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSigner) SignJWTReturnsOnCall(i int, result1 string, result2 error) {
+	fake.signJWTMutex.Lock()
+	defer fake.signJWTMutex.Unlock()
+	fake.SignJWTStub = nil
+	if fake.signJWTReturnsOnCall == nil {
+		fake.signJWTReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.signJWTReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSigner) VerifyPresentation(arg1 context.Context, arg2 []byte) error {
 	var arg2Copy []byte
 	if arg2 != nil {
@@ -185,8 +268,10 @@ func (fake *FakeSigner) VerifyPresentationReturnsOnCall(i int, result1 error) {
 func (fake *FakeSigner) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
-	fake.createPresentationMutex.RLock()
-	defer fake.createPresentationMutex.RUnlock()
+	fake.presentationProofMutex.RLock()
+	defer fake.presentationProofMutex.RUnlock()
+	fake.signJWTMutex.RLock()
+	defer fake.signJWTMutex.RUnlock()
 	fake.verifyPresentationMutex.RLock()
 	defer fake.verifyPresentationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package infohubfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+)
+
+type FakeStatusChecker struct {
+	CheckStatusStub        func(context.Context, map[string]interface{}) error
+	checkStatusMutex       sync.RWMutex
+	checkStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 map[string]interface{}
+	}
+	checkStatusReturns struct {
+		result1 error
+	}
+	checkStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStatusChecker) CheckStatus(arg1 context.Context, arg2 map[string]interface{}) error {
+	fake.checkStatusMutex.Lock()
+	ret, specificReturn := fake.checkStatusReturnsOnCall[len(fake.checkStatusArgsForCall)]
+	fake.checkStatusArgsForCall = append(fake.checkStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 map[string]interface{}
+	}{arg1, arg2})
+	stub := fake.CheckStatusStub
+	fakeReturns := fake.checkStatusReturns
+	fake.recordInvocation("CheckStatus", []interface{}{arg1, arg2})
+	fake.checkStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStatusChecker) CheckStatusCallCount() int {
+	fake.checkStatusMutex.RLock()
+	defer fake.checkStatusMutex.RUnlock()
+	return len(fake.checkStatusArgsForCall)
+}
+
+func (fake *FakeStatusChecker) CheckStatusCalls(stub func(context.Context, map[string]interface{}) error) {
+	fake.checkStatusMutex.Lock()
+	defer fake.checkStatusMutex.Unlock()
+	fake.CheckStatusStub = stub
+}
+
+func (fake *FakeStatusChecker) CheckStatusArgsForCall(i int) (context.Context, map[string]interface{}) {
+	fake.checkStatusMutex.RLock()
+	defer fake.checkStatusMutex.RUnlock()
+	argsForCall := fake.checkStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStatusChecker) CheckStatusReturns(result1 error) {
+	fake.checkStatusMutex.Lock()
+	defer fake.checkStatusMutex.Unlock()
+	fake.CheckStatusStub = nil
+	fake.checkStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusChecker) CheckStatusReturnsOnCall(i int, result1 error) {
+	fake.checkStatusMutex.Lock()
+	defer fake.checkStatusMutex.Unlock()
+	fake.CheckStatusStub = nil
+	if fake.checkStatusReturnsOnCall == nil {
+		fake.checkStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.checkStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStatusChecker) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.checkStatusMutex.RLock()
+	defer fake.checkStatusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStatusChecker) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ infohub.StatusChecker = new(FakeStatusChecker)
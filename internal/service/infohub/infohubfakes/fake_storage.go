@@ -4,12 +4,128 @@ package infohubfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
 )
 
 type FakeStorage struct {
+	AcquireLeaseStub        func(context.Context, string, string, time.Duration) (bool, error)
+	acquireLeaseMutex       sync.RWMutex
+	acquireLeaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}
+	acquireLeaseReturns struct {
+		result1 bool
+		result2 error
+	}
+	acquireLeaseReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	CompleteIdempotentRequestStub        func(context.Context, string, []byte) error
+	completeIdempotentRequestMutex       sync.RWMutex
+	completeIdempotentRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+	}
+	completeIdempotentRequestReturns struct {
+		result1 error
+	}
+	completeIdempotentRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ConsumeImportChallengeStub        func(context.Context, string) (*storage.ImportChallenge, error)
+	consumeImportChallengeMutex       sync.RWMutex
+	consumeImportChallengeArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	consumeImportChallengeReturns struct {
+		result1 *storage.ImportChallenge
+		result2 error
+	}
+	consumeImportChallengeReturnsOnCall map[int]struct {
+		result1 *storage.ImportChallenge
+		result2 error
+	}
+	CreateExportConfigurationStub        func(context.Context, *storage.ExportConfiguration) error
+	createExportConfigurationMutex       sync.RWMutex
+	createExportConfigurationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storage.ExportConfiguration
+	}
+	createExportConfigurationReturns struct {
+		result1 error
+	}
+	createExportConfigurationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateExportJobStub        func(context.Context, *storage.ExportJob) error
+	createExportJobMutex       sync.RWMutex
+	createExportJobArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storage.ExportJob
+	}
+	createExportJobReturns struct {
+		result1 error
+	}
+	createExportJobReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateIdempotentRequestStub        func(context.Context, *storage.IdempotentRequest) error
+	createIdempotentRequestMutex       sync.RWMutex
+	createIdempotentRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storage.IdempotentRequest
+	}
+	createIdempotentRequestReturns struct {
+		result1 error
+	}
+	createIdempotentRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateImportChallengeStub        func(context.Context, *storage.ImportChallenge) error
+	createImportChallengeMutex       sync.RWMutex
+	createImportChallengeArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storage.ImportChallenge
+	}
+	createImportChallengeReturns struct {
+		result1 error
+	}
+	createImportChallengeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteExportConfigurationStub        func(context.Context, string) error
+	deleteExportConfigurationMutex       sync.RWMutex
+	deleteExportConfigurationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteExportConfigurationReturns struct {
+		result1 error
+	}
+	deleteExportConfigurationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteIdempotentRequestStub        func(context.Context, string) error
+	deleteIdempotentRequestMutex       sync.RWMutex
+	deleteIdempotentRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	deleteIdempotentRequestReturns struct {
+		result1 error
+	}
+	deleteIdempotentRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ExportConfigurationStub        func(context.Context, string) (*storage.ExportConfiguration, error)
 	exportConfigurationMutex       sync.RWMutex
 	exportConfigurationArgsForCall []struct {
@@ -24,21 +140,308 @@ type FakeStorage struct {
 		result1 *storage.ExportConfiguration
 		result2 error
 	}
+	ExportConfigurationsStub        func(context.Context) ([]*storage.ExportConfiguration, error)
+	exportConfigurationsMutex       sync.RWMutex
+	exportConfigurationsArgsForCall []struct {
+		arg1 context.Context
+	}
+	exportConfigurationsReturns struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}
+	exportConfigurationsReturnsOnCall map[int]struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}
+	ExportJobStub        func(context.Context, string, string) (*storage.ExportJob, error)
+	exportJobMutex       sync.RWMutex
+	exportJobArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	exportJobReturns struct {
+		result1 *storage.ExportJob
+		result2 error
+	}
+	exportJobReturnsOnCall map[int]struct {
+		result1 *storage.ExportJob
+		result2 error
+	}
+	ExportPresentationStub        func(context.Context, string) (*storage.ExportPresentation, error)
+	exportPresentationMutex       sync.RWMutex
+	exportPresentationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	exportPresentationReturns struct {
+		result1 *storage.ExportPresentation
+		result2 error
+	}
+	exportPresentationReturnsOnCall map[int]struct {
+		result1 *storage.ExportPresentation
+		result2 error
+	}
+	FailStaleExportJobsStub        func(context.Context, time.Time, string) (int64, error)
+	failStaleExportJobsMutex       sync.RWMutex
+	failStaleExportJobsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 string
+	}
+	failStaleExportJobsReturns struct {
+		result1 int64
+		result2 error
+	}
+	failStaleExportJobsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	IdempotentRequestStub        func(context.Context, string) (*storage.IdempotentRequest, error)
+	idempotentRequestMutex       sync.RWMutex
+	idempotentRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	idempotentRequestReturns struct {
+		result1 *storage.IdempotentRequest
+		result2 error
+	}
+	idempotentRequestReturnsOnCall map[int]struct {
+		result1 *storage.IdempotentRequest
+		result2 error
+	}
+	LeaseHolderStub        func(context.Context, string) (string, error)
+	leaseHolderMutex       sync.RWMutex
+	leaseHolderArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	leaseHolderReturns struct {
+		result1 string
+		result2 error
+	}
+	leaseHolderReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ReleaseLeaseStub        func(context.Context, string, string) error
+	releaseLeaseMutex       sync.RWMutex
+	releaseLeaseArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	releaseLeaseReturns struct {
+		result1 error
+	}
+	releaseLeaseReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveExportPresentationStub        func(context.Context, string, []byte, *time.Time) error
+	saveExportPresentationMutex       sync.RWMutex
+	saveExportPresentationArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 *time.Time
+	}
+	saveExportPresentationReturns struct {
+		result1 error
+	}
+	saveExportPresentationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateExportConfigurationStub        func(context.Context, *storage.ExportConfiguration) error
+	updateExportConfigurationMutex       sync.RWMutex
+	updateExportConfigurationArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storage.ExportConfiguration
+	}
+	updateExportConfigurationReturns struct {
+		result1 error
+	}
+	updateExportConfigurationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateExportJobPolicyStub        func(context.Context, string, string, string, string) error
+	updateExportJobPolicyMutex       sync.RWMutex
+	updateExportJobPolicyArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}
+	updateExportJobPolicyReturns struct {
+		result1 error
+	}
+	updateExportJobPolicyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateExportJobStatusStub        func(context.Context, string, string) error
+	updateExportJobStatusMutex       sync.RWMutex
+	updateExportJobStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	updateExportJobStatusReturns struct {
+		result1 error
+	}
+	updateExportJobStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeStorage) ExportConfiguration(arg1 context.Context, arg2 string) (*storage.ExportConfiguration, error) {
-	fake.exportConfigurationMutex.Lock()
-	ret, specificReturn := fake.exportConfigurationReturnsOnCall[len(fake.exportConfigurationArgsForCall)]
-	fake.exportConfigurationArgsForCall = append(fake.exportConfigurationArgsForCall, struct {
+func (fake *FakeStorage) AcquireLease(arg1 context.Context, arg2 string, arg3 string, arg4 time.Duration) (bool, error) {
+	fake.acquireLeaseMutex.Lock()
+	ret, specificReturn := fake.acquireLeaseReturnsOnCall[len(fake.acquireLeaseArgsForCall)]
+	fake.acquireLeaseArgsForCall = append(fake.acquireLeaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AcquireLeaseStub
+	fakeReturns := fake.acquireLeaseReturns
+	fake.recordInvocation("AcquireLease", []interface{}{arg1, arg2, arg3, arg4})
+	fake.acquireLeaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) AcquireLeaseCallCount() int {
+	fake.acquireLeaseMutex.RLock()
+	defer fake.acquireLeaseMutex.RUnlock()
+	return len(fake.acquireLeaseArgsForCall)
+}
+
+func (fake *FakeStorage) AcquireLeaseCalls(stub func(context.Context, string, string, time.Duration) (bool, error)) {
+	fake.acquireLeaseMutex.Lock()
+	defer fake.acquireLeaseMutex.Unlock()
+	fake.AcquireLeaseStub = stub
+}
+
+func (fake *FakeStorage) AcquireLeaseArgsForCall(i int) (context.Context, string, string, time.Duration) {
+	fake.acquireLeaseMutex.RLock()
+	defer fake.acquireLeaseMutex.RUnlock()
+	argsForCall := fake.acquireLeaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStorage) AcquireLeaseReturns(result1 bool, result2 error) {
+	fake.acquireLeaseMutex.Lock()
+	defer fake.acquireLeaseMutex.Unlock()
+	fake.AcquireLeaseStub = nil
+	fake.acquireLeaseReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) AcquireLeaseReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.acquireLeaseMutex.Lock()
+	defer fake.acquireLeaseMutex.Unlock()
+	fake.AcquireLeaseStub = nil
+	if fake.acquireLeaseReturnsOnCall == nil {
+		fake.acquireLeaseReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.acquireLeaseReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) CompleteIdempotentRequest(arg1 context.Context, arg2 string, arg3 []byte) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.completeIdempotentRequestMutex.Lock()
+	ret, specificReturn := fake.completeIdempotentRequestReturnsOnCall[len(fake.completeIdempotentRequestArgsForCall)]
+	fake.completeIdempotentRequestArgsForCall = append(fake.completeIdempotentRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+	}{arg1, arg2, arg3Copy})
+	stub := fake.CompleteIdempotentRequestStub
+	fakeReturns := fake.completeIdempotentRequestReturns
+	fake.recordInvocation("CompleteIdempotentRequest", []interface{}{arg1, arg2, arg3Copy})
+	fake.completeIdempotentRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) CompleteIdempotentRequestCallCount() int {
+	fake.completeIdempotentRequestMutex.RLock()
+	defer fake.completeIdempotentRequestMutex.RUnlock()
+	return len(fake.completeIdempotentRequestArgsForCall)
+}
+
+func (fake *FakeStorage) CompleteIdempotentRequestCalls(stub func(context.Context, string, []byte) error) {
+	fake.completeIdempotentRequestMutex.Lock()
+	defer fake.completeIdempotentRequestMutex.Unlock()
+	fake.CompleteIdempotentRequestStub = stub
+}
+
+func (fake *FakeStorage) CompleteIdempotentRequestArgsForCall(i int) (context.Context, string, []byte) {
+	fake.completeIdempotentRequestMutex.RLock()
+	defer fake.completeIdempotentRequestMutex.RUnlock()
+	argsForCall := fake.completeIdempotentRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) CompleteIdempotentRequestReturns(result1 error) {
+	fake.completeIdempotentRequestMutex.Lock()
+	defer fake.completeIdempotentRequestMutex.Unlock()
+	fake.CompleteIdempotentRequestStub = nil
+	fake.completeIdempotentRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CompleteIdempotentRequestReturnsOnCall(i int, result1 error) {
+	fake.completeIdempotentRequestMutex.Lock()
+	defer fake.completeIdempotentRequestMutex.Unlock()
+	fake.CompleteIdempotentRequestStub = nil
+	if fake.completeIdempotentRequestReturnsOnCall == nil {
+		fake.completeIdempotentRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeIdempotentRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) ConsumeImportChallenge(arg1 context.Context, arg2 string) (*storage.ImportChallenge, error) {
+	fake.consumeImportChallengeMutex.Lock()
+	ret, specificReturn := fake.consumeImportChallengeReturnsOnCall[len(fake.consumeImportChallengeArgsForCall)]
+	fake.consumeImportChallengeArgsForCall = append(fake.consumeImportChallengeArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
 	}{arg1, arg2})
-	stub := fake.ExportConfigurationStub
-	fakeReturns := fake.exportConfigurationReturns
-	fake.recordInvocation("ExportConfiguration", []interface{}{arg1, arg2})
-	fake.exportConfigurationMutex.Unlock()
+	stub := fake.ConsumeImportChallengeStub
+	fakeReturns := fake.consumeImportChallengeReturns
+	fake.recordInvocation("ConsumeImportChallenge", []interface{}{arg1, arg2})
+	fake.consumeImportChallengeMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -48,56 +451,1246 @@ func (fake *FakeStorage) ExportConfiguration(arg1 context.Context, arg2 string)
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeStorage) ExportConfigurationCallCount() int {
-	fake.exportConfigurationMutex.RLock()
-	defer fake.exportConfigurationMutex.RUnlock()
-	return len(fake.exportConfigurationArgsForCall)
+func (fake *FakeStorage) ConsumeImportChallengeCallCount() int {
+	fake.consumeImportChallengeMutex.RLock()
+	defer fake.consumeImportChallengeMutex.RUnlock()
+	return len(fake.consumeImportChallengeArgsForCall)
 }
 
-func (fake *FakeStorage) ExportConfigurationCalls(stub func(context.Context, string) (*storage.ExportConfiguration, error)) {
-	fake.exportConfigurationMutex.Lock()
-	defer fake.exportConfigurationMutex.Unlock()
-	fake.ExportConfigurationStub = stub
+func (fake *FakeStorage) ConsumeImportChallengeCalls(stub func(context.Context, string) (*storage.ImportChallenge, error)) {
+	fake.consumeImportChallengeMutex.Lock()
+	defer fake.consumeImportChallengeMutex.Unlock()
+	fake.ConsumeImportChallengeStub = stub
 }
 
-func (fake *FakeStorage) ExportConfigurationArgsForCall(i int) (context.Context, string) {
-	fake.exportConfigurationMutex.RLock()
-	defer fake.exportConfigurationMutex.RUnlock()
-	argsForCall := fake.exportConfigurationArgsForCall[i]
+func (fake *FakeStorage) ConsumeImportChallengeArgsForCall(i int) (context.Context, string) {
+	fake.consumeImportChallengeMutex.RLock()
+	defer fake.consumeImportChallengeMutex.RUnlock()
+	argsForCall := fake.consumeImportChallengeArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeStorage) ExportConfigurationReturns(result1 *storage.ExportConfiguration, result2 error) {
-	fake.exportConfigurationMutex.Lock()
-	defer fake.exportConfigurationMutex.Unlock()
-	fake.ExportConfigurationStub = nil
-	fake.exportConfigurationReturns = struct {
-		result1 *storage.ExportConfiguration
+func (fake *FakeStorage) ConsumeImportChallengeReturns(result1 *storage.ImportChallenge, result2 error) {
+	fake.consumeImportChallengeMutex.Lock()
+	defer fake.consumeImportChallengeMutex.Unlock()
+	fake.ConsumeImportChallengeStub = nil
+	fake.consumeImportChallengeReturns = struct {
+		result1 *storage.ImportChallenge
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeStorage) ExportConfigurationReturnsOnCall(i int, result1 *storage.ExportConfiguration, result2 error) {
-	fake.exportConfigurationMutex.Lock()
-	defer fake.exportConfigurationMutex.Unlock()
-	fake.ExportConfigurationStub = nil
-	if fake.exportConfigurationReturnsOnCall == nil {
-		fake.exportConfigurationReturnsOnCall = make(map[int]struct {
-			result1 *storage.ExportConfiguration
+func (fake *FakeStorage) ConsumeImportChallengeReturnsOnCall(i int, result1 *storage.ImportChallenge, result2 error) {
+	fake.consumeImportChallengeMutex.Lock()
+	defer fake.consumeImportChallengeMutex.Unlock()
+	fake.ConsumeImportChallengeStub = nil
+	if fake.consumeImportChallengeReturnsOnCall == nil {
+		fake.consumeImportChallengeReturnsOnCall = make(map[int]struct {
+			result1 *storage.ImportChallenge
 			result2 error
 		})
 	}
-	fake.exportConfigurationReturnsOnCall[i] = struct {
-		result1 *storage.ExportConfiguration
+	fake.consumeImportChallengeReturnsOnCall[i] = struct {
+		result1 *storage.ImportChallenge
 		result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeStorage) CreateExportConfiguration(arg1 context.Context, arg2 *storage.ExportConfiguration) error {
+	fake.createExportConfigurationMutex.Lock()
+	ret, specificReturn := fake.createExportConfigurationReturnsOnCall[len(fake.createExportConfigurationArgsForCall)]
+	fake.createExportConfigurationArgsForCall = append(fake.createExportConfigurationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storage.ExportConfiguration
+	}{arg1, arg2})
+	stub := fake.CreateExportConfigurationStub
+	fakeReturns := fake.createExportConfigurationReturns
+	fake.recordInvocation("CreateExportConfiguration", []interface{}{arg1, arg2})
+	fake.createExportConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) CreateExportConfigurationCallCount() int {
+	fake.createExportConfigurationMutex.RLock()
+	defer fake.createExportConfigurationMutex.RUnlock()
+	return len(fake.createExportConfigurationArgsForCall)
+}
+
+func (fake *FakeStorage) CreateExportConfigurationCalls(stub func(context.Context, *storage.ExportConfiguration) error) {
+	fake.createExportConfigurationMutex.Lock()
+	defer fake.createExportConfigurationMutex.Unlock()
+	fake.CreateExportConfigurationStub = stub
+}
+
+func (fake *FakeStorage) CreateExportConfigurationArgsForCall(i int) (context.Context, *storage.ExportConfiguration) {
+	fake.createExportConfigurationMutex.RLock()
+	defer fake.createExportConfigurationMutex.RUnlock()
+	argsForCall := fake.createExportConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) CreateExportConfigurationReturns(result1 error) {
+	fake.createExportConfigurationMutex.Lock()
+	defer fake.createExportConfigurationMutex.Unlock()
+	fake.CreateExportConfigurationStub = nil
+	fake.createExportConfigurationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CreateExportConfigurationReturnsOnCall(i int, result1 error) {
+	fake.createExportConfigurationMutex.Lock()
+	defer fake.createExportConfigurationMutex.Unlock()
+	fake.CreateExportConfigurationStub = nil
+	if fake.createExportConfigurationReturnsOnCall == nil {
+		fake.createExportConfigurationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createExportConfigurationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CreateExportJob(arg1 context.Context, arg2 *storage.ExportJob) error {
+	fake.createExportJobMutex.Lock()
+	ret, specificReturn := fake.createExportJobReturnsOnCall[len(fake.createExportJobArgsForCall)]
+	fake.createExportJobArgsForCall = append(fake.createExportJobArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storage.ExportJob
+	}{arg1, arg2})
+	stub := fake.CreateExportJobStub
+	fakeReturns := fake.createExportJobReturns
+	fake.recordInvocation("CreateExportJob", []interface{}{arg1, arg2})
+	fake.createExportJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) CreateExportJobCallCount() int {
+	fake.createExportJobMutex.RLock()
+	defer fake.createExportJobMutex.RUnlock()
+	return len(fake.createExportJobArgsForCall)
+}
+
+func (fake *FakeStorage) CreateExportJobCalls(stub func(context.Context, *storage.ExportJob) error) {
+	fake.createExportJobMutex.Lock()
+	defer fake.createExportJobMutex.Unlock()
+	fake.CreateExportJobStub = stub
+}
+
+func (fake *FakeStorage) CreateExportJobArgsForCall(i int) (context.Context, *storage.ExportJob) {
+	fake.createExportJobMutex.RLock()
+	defer fake.createExportJobMutex.RUnlock()
+	argsForCall := fake.createExportJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) CreateExportJobReturns(result1 error) {
+	fake.createExportJobMutex.Lock()
+	defer fake.createExportJobMutex.Unlock()
+	fake.CreateExportJobStub = nil
+	fake.createExportJobReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CreateExportJobReturnsOnCall(i int, result1 error) {
+	fake.createExportJobMutex.Lock()
+	defer fake.createExportJobMutex.Unlock()
+	fake.CreateExportJobStub = nil
+	if fake.createExportJobReturnsOnCall == nil {
+		fake.createExportJobReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createExportJobReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CreateIdempotentRequest(arg1 context.Context, arg2 *storage.IdempotentRequest) error {
+	fake.createIdempotentRequestMutex.Lock()
+	ret, specificReturn := fake.createIdempotentRequestReturnsOnCall[len(fake.createIdempotentRequestArgsForCall)]
+	fake.createIdempotentRequestArgsForCall = append(fake.createIdempotentRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storage.IdempotentRequest
+	}{arg1, arg2})
+	stub := fake.CreateIdempotentRequestStub
+	fakeReturns := fake.createIdempotentRequestReturns
+	fake.recordInvocation("CreateIdempotentRequest", []interface{}{arg1, arg2})
+	fake.createIdempotentRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) CreateIdempotentRequestCallCount() int {
+	fake.createIdempotentRequestMutex.RLock()
+	defer fake.createIdempotentRequestMutex.RUnlock()
+	return len(fake.createIdempotentRequestArgsForCall)
+}
+
+func (fake *FakeStorage) CreateIdempotentRequestCalls(stub func(context.Context, *storage.IdempotentRequest) error) {
+	fake.createIdempotentRequestMutex.Lock()
+	defer fake.createIdempotentRequestMutex.Unlock()
+	fake.CreateIdempotentRequestStub = stub
+}
+
+func (fake *FakeStorage) CreateIdempotentRequestArgsForCall(i int) (context.Context, *storage.IdempotentRequest) {
+	fake.createIdempotentRequestMutex.RLock()
+	defer fake.createIdempotentRequestMutex.RUnlock()
+	argsForCall := fake.createIdempotentRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) CreateIdempotentRequestReturns(result1 error) {
+	fake.createIdempotentRequestMutex.Lock()
+	defer fake.createIdempotentRequestMutex.Unlock()
+	fake.CreateIdempotentRequestStub = nil
+	fake.createIdempotentRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CreateIdempotentRequestReturnsOnCall(i int, result1 error) {
+	fake.createIdempotentRequestMutex.Lock()
+	defer fake.createIdempotentRequestMutex.Unlock()
+	fake.CreateIdempotentRequestStub = nil
+	if fake.createIdempotentRequestReturnsOnCall == nil {
+		fake.createIdempotentRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createIdempotentRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CreateImportChallenge(arg1 context.Context, arg2 *storage.ImportChallenge) error {
+	fake.createImportChallengeMutex.Lock()
+	ret, specificReturn := fake.createImportChallengeReturnsOnCall[len(fake.createImportChallengeArgsForCall)]
+	fake.createImportChallengeArgsForCall = append(fake.createImportChallengeArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storage.ImportChallenge
+	}{arg1, arg2})
+	stub := fake.CreateImportChallengeStub
+	fakeReturns := fake.createImportChallengeReturns
+	fake.recordInvocation("CreateImportChallenge", []interface{}{arg1, arg2})
+	fake.createImportChallengeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) CreateImportChallengeCallCount() int {
+	fake.createImportChallengeMutex.RLock()
+	defer fake.createImportChallengeMutex.RUnlock()
+	return len(fake.createImportChallengeArgsForCall)
+}
+
+func (fake *FakeStorage) CreateImportChallengeCalls(stub func(context.Context, *storage.ImportChallenge) error) {
+	fake.createImportChallengeMutex.Lock()
+	defer fake.createImportChallengeMutex.Unlock()
+	fake.CreateImportChallengeStub = stub
+}
+
+func (fake *FakeStorage) CreateImportChallengeArgsForCall(i int) (context.Context, *storage.ImportChallenge) {
+	fake.createImportChallengeMutex.RLock()
+	defer fake.createImportChallengeMutex.RUnlock()
+	argsForCall := fake.createImportChallengeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) CreateImportChallengeReturns(result1 error) {
+	fake.createImportChallengeMutex.Lock()
+	defer fake.createImportChallengeMutex.Unlock()
+	fake.CreateImportChallengeStub = nil
+	fake.createImportChallengeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) CreateImportChallengeReturnsOnCall(i int, result1 error) {
+	fake.createImportChallengeMutex.Lock()
+	defer fake.createImportChallengeMutex.Unlock()
+	fake.CreateImportChallengeStub = nil
+	if fake.createImportChallengeReturnsOnCall == nil {
+		fake.createImportChallengeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createImportChallengeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteExportConfiguration(arg1 context.Context, arg2 string) error {
+	fake.deleteExportConfigurationMutex.Lock()
+	ret, specificReturn := fake.deleteExportConfigurationReturnsOnCall[len(fake.deleteExportConfigurationArgsForCall)]
+	fake.deleteExportConfigurationArgsForCall = append(fake.deleteExportConfigurationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteExportConfigurationStub
+	fakeReturns := fake.deleteExportConfigurationReturns
+	fake.recordInvocation("DeleteExportConfiguration", []interface{}{arg1, arg2})
+	fake.deleteExportConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DeleteExportConfigurationCallCount() int {
+	fake.deleteExportConfigurationMutex.RLock()
+	defer fake.deleteExportConfigurationMutex.RUnlock()
+	return len(fake.deleteExportConfigurationArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteExportConfigurationCalls(stub func(context.Context, string) error) {
+	fake.deleteExportConfigurationMutex.Lock()
+	defer fake.deleteExportConfigurationMutex.Unlock()
+	fake.DeleteExportConfigurationStub = stub
+}
+
+func (fake *FakeStorage) DeleteExportConfigurationArgsForCall(i int) (context.Context, string) {
+	fake.deleteExportConfigurationMutex.RLock()
+	defer fake.deleteExportConfigurationMutex.RUnlock()
+	argsForCall := fake.deleteExportConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeleteExportConfigurationReturns(result1 error) {
+	fake.deleteExportConfigurationMutex.Lock()
+	defer fake.deleteExportConfigurationMutex.Unlock()
+	fake.DeleteExportConfigurationStub = nil
+	fake.deleteExportConfigurationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteExportConfigurationReturnsOnCall(i int, result1 error) {
+	fake.deleteExportConfigurationMutex.Lock()
+	defer fake.deleteExportConfigurationMutex.Unlock()
+	fake.DeleteExportConfigurationStub = nil
+	if fake.deleteExportConfigurationReturnsOnCall == nil {
+		fake.deleteExportConfigurationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteExportConfigurationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteIdempotentRequest(arg1 context.Context, arg2 string) error {
+	fake.deleteIdempotentRequestMutex.Lock()
+	ret, specificReturn := fake.deleteIdempotentRequestReturnsOnCall[len(fake.deleteIdempotentRequestArgsForCall)]
+	fake.deleteIdempotentRequestArgsForCall = append(fake.deleteIdempotentRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DeleteIdempotentRequestStub
+	fakeReturns := fake.deleteIdempotentRequestReturns
+	fake.recordInvocation("DeleteIdempotentRequest", []interface{}{arg1, arg2})
+	fake.deleteIdempotentRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) DeleteIdempotentRequestCallCount() int {
+	fake.deleteIdempotentRequestMutex.RLock()
+	defer fake.deleteIdempotentRequestMutex.RUnlock()
+	return len(fake.deleteIdempotentRequestArgsForCall)
+}
+
+func (fake *FakeStorage) DeleteIdempotentRequestCalls(stub func(context.Context, string) error) {
+	fake.deleteIdempotentRequestMutex.Lock()
+	defer fake.deleteIdempotentRequestMutex.Unlock()
+	fake.DeleteIdempotentRequestStub = stub
+}
+
+func (fake *FakeStorage) DeleteIdempotentRequestArgsForCall(i int) (context.Context, string) {
+	fake.deleteIdempotentRequestMutex.RLock()
+	defer fake.deleteIdempotentRequestMutex.RUnlock()
+	argsForCall := fake.deleteIdempotentRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) DeleteIdempotentRequestReturns(result1 error) {
+	fake.deleteIdempotentRequestMutex.Lock()
+	defer fake.deleteIdempotentRequestMutex.Unlock()
+	fake.DeleteIdempotentRequestStub = nil
+	fake.deleteIdempotentRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) DeleteIdempotentRequestReturnsOnCall(i int, result1 error) {
+	fake.deleteIdempotentRequestMutex.Lock()
+	defer fake.deleteIdempotentRequestMutex.Unlock()
+	fake.DeleteIdempotentRequestStub = nil
+	if fake.deleteIdempotentRequestReturnsOnCall == nil {
+		fake.deleteIdempotentRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteIdempotentRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) ExportConfiguration(arg1 context.Context, arg2 string) (*storage.ExportConfiguration, error) {
+	fake.exportConfigurationMutex.Lock()
+	ret, specificReturn := fake.exportConfigurationReturnsOnCall[len(fake.exportConfigurationArgsForCall)]
+	fake.exportConfigurationArgsForCall = append(fake.exportConfigurationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ExportConfigurationStub
+	fakeReturns := fake.exportConfigurationReturns
+	fake.recordInvocation("ExportConfiguration", []interface{}{arg1, arg2})
+	fake.exportConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ExportConfigurationCallCount() int {
+	fake.exportConfigurationMutex.RLock()
+	defer fake.exportConfigurationMutex.RUnlock()
+	return len(fake.exportConfigurationArgsForCall)
+}
+
+func (fake *FakeStorage) ExportConfigurationCalls(stub func(context.Context, string) (*storage.ExportConfiguration, error)) {
+	fake.exportConfigurationMutex.Lock()
+	defer fake.exportConfigurationMutex.Unlock()
+	fake.ExportConfigurationStub = stub
+}
+
+func (fake *FakeStorage) ExportConfigurationArgsForCall(i int) (context.Context, string) {
+	fake.exportConfigurationMutex.RLock()
+	defer fake.exportConfigurationMutex.RUnlock()
+	argsForCall := fake.exportConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) ExportConfigurationReturns(result1 *storage.ExportConfiguration, result2 error) {
+	fake.exportConfigurationMutex.Lock()
+	defer fake.exportConfigurationMutex.Unlock()
+	fake.ExportConfigurationStub = nil
+	fake.exportConfigurationReturns = struct {
+		result1 *storage.ExportConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportConfigurationReturnsOnCall(i int, result1 *storage.ExportConfiguration, result2 error) {
+	fake.exportConfigurationMutex.Lock()
+	defer fake.exportConfigurationMutex.Unlock()
+	fake.ExportConfigurationStub = nil
+	if fake.exportConfigurationReturnsOnCall == nil {
+		fake.exportConfigurationReturnsOnCall = make(map[int]struct {
+			result1 *storage.ExportConfiguration
+			result2 error
+		})
+	}
+	fake.exportConfigurationReturnsOnCall[i] = struct {
+		result1 *storage.ExportConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportConfigurations(arg1 context.Context) ([]*storage.ExportConfiguration, error) {
+	fake.exportConfigurationsMutex.Lock()
+	ret, specificReturn := fake.exportConfigurationsReturnsOnCall[len(fake.exportConfigurationsArgsForCall)]
+	fake.exportConfigurationsArgsForCall = append(fake.exportConfigurationsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ExportConfigurationsStub
+	fakeReturns := fake.exportConfigurationsReturns
+	fake.recordInvocation("ExportConfigurations", []interface{}{arg1})
+	fake.exportConfigurationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ExportConfigurationsCallCount() int {
+	fake.exportConfigurationsMutex.RLock()
+	defer fake.exportConfigurationsMutex.RUnlock()
+	return len(fake.exportConfigurationsArgsForCall)
+}
+
+func (fake *FakeStorage) ExportConfigurationsCalls(stub func(context.Context) ([]*storage.ExportConfiguration, error)) {
+	fake.exportConfigurationsMutex.Lock()
+	defer fake.exportConfigurationsMutex.Unlock()
+	fake.ExportConfigurationsStub = stub
+}
+
+func (fake *FakeStorage) ExportConfigurationsArgsForCall(i int) context.Context {
+	fake.exportConfigurationsMutex.RLock()
+	defer fake.exportConfigurationsMutex.RUnlock()
+	argsForCall := fake.exportConfigurationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStorage) ExportConfigurationsReturns(result1 []*storage.ExportConfiguration, result2 error) {
+	fake.exportConfigurationsMutex.Lock()
+	defer fake.exportConfigurationsMutex.Unlock()
+	fake.ExportConfigurationsStub = nil
+	fake.exportConfigurationsReturns = struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportConfigurationsReturnsOnCall(i int, result1 []*storage.ExportConfiguration, result2 error) {
+	fake.exportConfigurationsMutex.Lock()
+	defer fake.exportConfigurationsMutex.Unlock()
+	fake.ExportConfigurationsStub = nil
+	if fake.exportConfigurationsReturnsOnCall == nil {
+		fake.exportConfigurationsReturnsOnCall = make(map[int]struct {
+			result1 []*storage.ExportConfiguration
+			result2 error
+		})
+	}
+	fake.exportConfigurationsReturnsOnCall[i] = struct {
+		result1 []*storage.ExportConfiguration
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportJob(arg1 context.Context, arg2 string, arg3 string) (*storage.ExportJob, error) {
+	fake.exportJobMutex.Lock()
+	ret, specificReturn := fake.exportJobReturnsOnCall[len(fake.exportJobArgsForCall)]
+	fake.exportJobArgsForCall = append(fake.exportJobArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ExportJobStub
+	fakeReturns := fake.exportJobReturns
+	fake.recordInvocation("ExportJob", []interface{}{arg1, arg2, arg3})
+	fake.exportJobMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ExportJobCallCount() int {
+	fake.exportJobMutex.RLock()
+	defer fake.exportJobMutex.RUnlock()
+	return len(fake.exportJobArgsForCall)
+}
+
+func (fake *FakeStorage) ExportJobCalls(stub func(context.Context, string, string) (*storage.ExportJob, error)) {
+	fake.exportJobMutex.Lock()
+	defer fake.exportJobMutex.Unlock()
+	fake.ExportJobStub = stub
+}
+
+func (fake *FakeStorage) ExportJobArgsForCall(i int) (context.Context, string, string) {
+	fake.exportJobMutex.RLock()
+	defer fake.exportJobMutex.RUnlock()
+	argsForCall := fake.exportJobArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) ExportJobReturns(result1 *storage.ExportJob, result2 error) {
+	fake.exportJobMutex.Lock()
+	defer fake.exportJobMutex.Unlock()
+	fake.ExportJobStub = nil
+	fake.exportJobReturns = struct {
+		result1 *storage.ExportJob
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportJobReturnsOnCall(i int, result1 *storage.ExportJob, result2 error) {
+	fake.exportJobMutex.Lock()
+	defer fake.exportJobMutex.Unlock()
+	fake.ExportJobStub = nil
+	if fake.exportJobReturnsOnCall == nil {
+		fake.exportJobReturnsOnCall = make(map[int]struct {
+			result1 *storage.ExportJob
+			result2 error
+		})
+	}
+	fake.exportJobReturnsOnCall[i] = struct {
+		result1 *storage.ExportJob
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportPresentation(arg1 context.Context, arg2 string) (*storage.ExportPresentation, error) {
+	fake.exportPresentationMutex.Lock()
+	ret, specificReturn := fake.exportPresentationReturnsOnCall[len(fake.exportPresentationArgsForCall)]
+	fake.exportPresentationArgsForCall = append(fake.exportPresentationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ExportPresentationStub
+	fakeReturns := fake.exportPresentationReturns
+	fake.recordInvocation("ExportPresentation", []interface{}{arg1, arg2})
+	fake.exportPresentationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) ExportPresentationCallCount() int {
+	fake.exportPresentationMutex.RLock()
+	defer fake.exportPresentationMutex.RUnlock()
+	return len(fake.exportPresentationArgsForCall)
+}
+
+func (fake *FakeStorage) ExportPresentationCalls(stub func(context.Context, string) (*storage.ExportPresentation, error)) {
+	fake.exportPresentationMutex.Lock()
+	defer fake.exportPresentationMutex.Unlock()
+	fake.ExportPresentationStub = stub
+}
+
+func (fake *FakeStorage) ExportPresentationArgsForCall(i int) (context.Context, string) {
+	fake.exportPresentationMutex.RLock()
+	defer fake.exportPresentationMutex.RUnlock()
+	argsForCall := fake.exportPresentationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) ExportPresentationReturns(result1 *storage.ExportPresentation, result2 error) {
+	fake.exportPresentationMutex.Lock()
+	defer fake.exportPresentationMutex.Unlock()
+	fake.ExportPresentationStub = nil
+	fake.exportPresentationReturns = struct {
+		result1 *storage.ExportPresentation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ExportPresentationReturnsOnCall(i int, result1 *storage.ExportPresentation, result2 error) {
+	fake.exportPresentationMutex.Lock()
+	defer fake.exportPresentationMutex.Unlock()
+	fake.ExportPresentationStub = nil
+	if fake.exportPresentationReturnsOnCall == nil {
+		fake.exportPresentationReturnsOnCall = make(map[int]struct {
+			result1 *storage.ExportPresentation
+			result2 error
+		})
+	}
+	fake.exportPresentationReturnsOnCall[i] = struct {
+		result1 *storage.ExportPresentation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) FailStaleExportJobs(arg1 context.Context, arg2 time.Time, arg3 string) (int64, error) {
+	fake.failStaleExportJobsMutex.Lock()
+	ret, specificReturn := fake.failStaleExportJobsReturnsOnCall[len(fake.failStaleExportJobsArgsForCall)]
+	fake.failStaleExportJobsArgsForCall = append(fake.failStaleExportJobsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.FailStaleExportJobsStub
+	fakeReturns := fake.failStaleExportJobsReturns
+	fake.recordInvocation("FailStaleExportJobs", []interface{}{arg1, arg2, arg3})
+	fake.failStaleExportJobsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) FailStaleExportJobsCallCount() int {
+	fake.failStaleExportJobsMutex.RLock()
+	defer fake.failStaleExportJobsMutex.RUnlock()
+	return len(fake.failStaleExportJobsArgsForCall)
+}
+
+func (fake *FakeStorage) FailStaleExportJobsCalls(stub func(context.Context, time.Time, string) (int64, error)) {
+	fake.failStaleExportJobsMutex.Lock()
+	defer fake.failStaleExportJobsMutex.Unlock()
+	fake.FailStaleExportJobsStub = stub
+}
+
+func (fake *FakeStorage) FailStaleExportJobsArgsForCall(i int) (context.Context, time.Time, string) {
+	fake.failStaleExportJobsMutex.RLock()
+	defer fake.failStaleExportJobsMutex.RUnlock()
+	argsForCall := fake.failStaleExportJobsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) FailStaleExportJobsReturns(result1 int64, result2 error) {
+	fake.failStaleExportJobsMutex.Lock()
+	defer fake.failStaleExportJobsMutex.Unlock()
+	fake.FailStaleExportJobsStub = nil
+	fake.failStaleExportJobsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) FailStaleExportJobsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.failStaleExportJobsMutex.Lock()
+	defer fake.failStaleExportJobsMutex.Unlock()
+	fake.FailStaleExportJobsStub = nil
+	if fake.failStaleExportJobsReturnsOnCall == nil {
+		fake.failStaleExportJobsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.failStaleExportJobsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) IdempotentRequest(arg1 context.Context, arg2 string) (*storage.IdempotentRequest, error) {
+	fake.idempotentRequestMutex.Lock()
+	ret, specificReturn := fake.idempotentRequestReturnsOnCall[len(fake.idempotentRequestArgsForCall)]
+	fake.idempotentRequestArgsForCall = append(fake.idempotentRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IdempotentRequestStub
+	fakeReturns := fake.idempotentRequestReturns
+	fake.recordInvocation("IdempotentRequest", []interface{}{arg1, arg2})
+	fake.idempotentRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) IdempotentRequestCallCount() int {
+	fake.idempotentRequestMutex.RLock()
+	defer fake.idempotentRequestMutex.RUnlock()
+	return len(fake.idempotentRequestArgsForCall)
+}
+
+func (fake *FakeStorage) IdempotentRequestCalls(stub func(context.Context, string) (*storage.IdempotentRequest, error)) {
+	fake.idempotentRequestMutex.Lock()
+	defer fake.idempotentRequestMutex.Unlock()
+	fake.IdempotentRequestStub = stub
+}
+
+func (fake *FakeStorage) IdempotentRequestArgsForCall(i int) (context.Context, string) {
+	fake.idempotentRequestMutex.RLock()
+	defer fake.idempotentRequestMutex.RUnlock()
+	argsForCall := fake.idempotentRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) IdempotentRequestReturns(result1 *storage.IdempotentRequest, result2 error) {
+	fake.idempotentRequestMutex.Lock()
+	defer fake.idempotentRequestMutex.Unlock()
+	fake.IdempotentRequestStub = nil
+	fake.idempotentRequestReturns = struct {
+		result1 *storage.IdempotentRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) IdempotentRequestReturnsOnCall(i int, result1 *storage.IdempotentRequest, result2 error) {
+	fake.idempotentRequestMutex.Lock()
+	defer fake.idempotentRequestMutex.Unlock()
+	fake.IdempotentRequestStub = nil
+	if fake.idempotentRequestReturnsOnCall == nil {
+		fake.idempotentRequestReturnsOnCall = make(map[int]struct {
+			result1 *storage.IdempotentRequest
+			result2 error
+		})
+	}
+	fake.idempotentRequestReturnsOnCall[i] = struct {
+		result1 *storage.IdempotentRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) LeaseHolder(arg1 context.Context, arg2 string) (string, error) {
+	fake.leaseHolderMutex.Lock()
+	ret, specificReturn := fake.leaseHolderReturnsOnCall[len(fake.leaseHolderArgsForCall)]
+	fake.leaseHolderArgsForCall = append(fake.leaseHolderArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.LeaseHolderStub
+	fakeReturns := fake.leaseHolderReturns
+	fake.recordInvocation("LeaseHolder", []interface{}{arg1, arg2})
+	fake.leaseHolderMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStorage) LeaseHolderCallCount() int {
+	fake.leaseHolderMutex.RLock()
+	defer fake.leaseHolderMutex.RUnlock()
+	return len(fake.leaseHolderArgsForCall)
+}
+
+func (fake *FakeStorage) LeaseHolderCalls(stub func(context.Context, string) (string, error)) {
+	fake.leaseHolderMutex.Lock()
+	defer fake.leaseHolderMutex.Unlock()
+	fake.LeaseHolderStub = stub
+}
+
+func (fake *FakeStorage) LeaseHolderArgsForCall(i int) (context.Context, string) {
+	fake.leaseHolderMutex.RLock()
+	defer fake.leaseHolderMutex.RUnlock()
+	argsForCall := fake.leaseHolderArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) LeaseHolderReturns(result1 string, result2 error) {
+	fake.leaseHolderMutex.Lock()
+	defer fake.leaseHolderMutex.Unlock()
+	fake.LeaseHolderStub = nil
+	fake.leaseHolderReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) LeaseHolderReturnsOnCall(i int, result1 string, result2 error) {
+	fake.leaseHolderMutex.Lock()
+	defer fake.leaseHolderMutex.Unlock()
+	fake.LeaseHolderStub = nil
+	if fake.leaseHolderReturnsOnCall == nil {
+		fake.leaseHolderReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.leaseHolderReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStorage) ReleaseLease(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.releaseLeaseMutex.Lock()
+	ret, specificReturn := fake.releaseLeaseReturnsOnCall[len(fake.releaseLeaseArgsForCall)]
+	fake.releaseLeaseArgsForCall = append(fake.releaseLeaseArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseLeaseStub
+	fakeReturns := fake.releaseLeaseReturns
+	fake.recordInvocation("ReleaseLease", []interface{}{arg1, arg2, arg3})
+	fake.releaseLeaseMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) ReleaseLeaseCallCount() int {
+	fake.releaseLeaseMutex.RLock()
+	defer fake.releaseLeaseMutex.RUnlock()
+	return len(fake.releaseLeaseArgsForCall)
+}
+
+func (fake *FakeStorage) ReleaseLeaseCalls(stub func(context.Context, string, string) error) {
+	fake.releaseLeaseMutex.Lock()
+	defer fake.releaseLeaseMutex.Unlock()
+	fake.ReleaseLeaseStub = stub
+}
+
+func (fake *FakeStorage) ReleaseLeaseArgsForCall(i int) (context.Context, string, string) {
+	fake.releaseLeaseMutex.RLock()
+	defer fake.releaseLeaseMutex.RUnlock()
+	argsForCall := fake.releaseLeaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) ReleaseLeaseReturns(result1 error) {
+	fake.releaseLeaseMutex.Lock()
+	defer fake.releaseLeaseMutex.Unlock()
+	fake.ReleaseLeaseStub = nil
+	fake.releaseLeaseReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) ReleaseLeaseReturnsOnCall(i int, result1 error) {
+	fake.releaseLeaseMutex.Lock()
+	defer fake.releaseLeaseMutex.Unlock()
+	fake.ReleaseLeaseStub = nil
+	if fake.releaseLeaseReturnsOnCall == nil {
+		fake.releaseLeaseReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseLeaseReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) SaveExportPresentation(arg1 context.Context, arg2 string, arg3 []byte, arg4 *time.Time) error {
+	var arg3Copy []byte
+	if arg3 != nil {
+		arg3Copy = make([]byte, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.saveExportPresentationMutex.Lock()
+	ret, specificReturn := fake.saveExportPresentationReturnsOnCall[len(fake.saveExportPresentationArgsForCall)]
+	fake.saveExportPresentationArgsForCall = append(fake.saveExportPresentationArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 []byte
+		arg4 *time.Time
+	}{arg1, arg2, arg3Copy, arg4})
+	stub := fake.SaveExportPresentationStub
+	fakeReturns := fake.saveExportPresentationReturns
+	fake.recordInvocation("SaveExportPresentation", []interface{}{arg1, arg2, arg3Copy, arg4})
+	fake.saveExportPresentationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) SaveExportPresentationCallCount() int {
+	fake.saveExportPresentationMutex.RLock()
+	defer fake.saveExportPresentationMutex.RUnlock()
+	return len(fake.saveExportPresentationArgsForCall)
+}
+
+func (fake *FakeStorage) SaveExportPresentationCalls(stub func(context.Context, string, []byte, *time.Time) error) {
+	fake.saveExportPresentationMutex.Lock()
+	defer fake.saveExportPresentationMutex.Unlock()
+	fake.SaveExportPresentationStub = stub
+}
+
+func (fake *FakeStorage) SaveExportPresentationArgsForCall(i int) (context.Context, string, []byte, *time.Time) {
+	fake.saveExportPresentationMutex.RLock()
+	defer fake.saveExportPresentationMutex.RUnlock()
+	argsForCall := fake.saveExportPresentationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeStorage) SaveExportPresentationReturns(result1 error) {
+	fake.saveExportPresentationMutex.Lock()
+	defer fake.saveExportPresentationMutex.Unlock()
+	fake.SaveExportPresentationStub = nil
+	fake.saveExportPresentationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) SaveExportPresentationReturnsOnCall(i int, result1 error) {
+	fake.saveExportPresentationMutex.Lock()
+	defer fake.saveExportPresentationMutex.Unlock()
+	fake.SaveExportPresentationStub = nil
+	if fake.saveExportPresentationReturnsOnCall == nil {
+		fake.saveExportPresentationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveExportPresentationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateExportConfiguration(arg1 context.Context, arg2 *storage.ExportConfiguration) error {
+	fake.updateExportConfigurationMutex.Lock()
+	ret, specificReturn := fake.updateExportConfigurationReturnsOnCall[len(fake.updateExportConfigurationArgsForCall)]
+	fake.updateExportConfigurationArgsForCall = append(fake.updateExportConfigurationArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storage.ExportConfiguration
+	}{arg1, arg2})
+	stub := fake.UpdateExportConfigurationStub
+	fakeReturns := fake.updateExportConfigurationReturns
+	fake.recordInvocation("UpdateExportConfiguration", []interface{}{arg1, arg2})
+	fake.updateExportConfigurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) UpdateExportConfigurationCallCount() int {
+	fake.updateExportConfigurationMutex.RLock()
+	defer fake.updateExportConfigurationMutex.RUnlock()
+	return len(fake.updateExportConfigurationArgsForCall)
+}
+
+func (fake *FakeStorage) UpdateExportConfigurationCalls(stub func(context.Context, *storage.ExportConfiguration) error) {
+	fake.updateExportConfigurationMutex.Lock()
+	defer fake.updateExportConfigurationMutex.Unlock()
+	fake.UpdateExportConfigurationStub = stub
+}
+
+func (fake *FakeStorage) UpdateExportConfigurationArgsForCall(i int) (context.Context, *storage.ExportConfiguration) {
+	fake.updateExportConfigurationMutex.RLock()
+	defer fake.updateExportConfigurationMutex.RUnlock()
+	argsForCall := fake.updateExportConfigurationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorage) UpdateExportConfigurationReturns(result1 error) {
+	fake.updateExportConfigurationMutex.Lock()
+	defer fake.updateExportConfigurationMutex.Unlock()
+	fake.UpdateExportConfigurationStub = nil
+	fake.updateExportConfigurationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateExportConfigurationReturnsOnCall(i int, result1 error) {
+	fake.updateExportConfigurationMutex.Lock()
+	defer fake.updateExportConfigurationMutex.Unlock()
+	fake.UpdateExportConfigurationStub = nil
+	if fake.updateExportConfigurationReturnsOnCall == nil {
+		fake.updateExportConfigurationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateExportConfigurationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateExportJobPolicy(arg1 context.Context, arg2 string, arg3 string, arg4 string, arg5 string) error {
+	fake.updateExportJobPolicyMutex.Lock()
+	ret, specificReturn := fake.updateExportJobPolicyReturnsOnCall[len(fake.updateExportJobPolicyArgsForCall)]
+	fake.updateExportJobPolicyArgsForCall = append(fake.updateExportJobPolicyArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.UpdateExportJobPolicyStub
+	fakeReturns := fake.updateExportJobPolicyReturns
+	fake.recordInvocation("UpdateExportJobPolicy", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.updateExportJobPolicyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) UpdateExportJobPolicyCallCount() int {
+	fake.updateExportJobPolicyMutex.RLock()
+	defer fake.updateExportJobPolicyMutex.RUnlock()
+	return len(fake.updateExportJobPolicyArgsForCall)
+}
+
+func (fake *FakeStorage) UpdateExportJobPolicyCalls(stub func(context.Context, string, string, string, string) error) {
+	fake.updateExportJobPolicyMutex.Lock()
+	defer fake.updateExportJobPolicyMutex.Unlock()
+	fake.UpdateExportJobPolicyStub = stub
+}
+
+func (fake *FakeStorage) UpdateExportJobPolicyArgsForCall(i int) (context.Context, string, string, string, string) {
+	fake.updateExportJobPolicyMutex.RLock()
+	defer fake.updateExportJobPolicyMutex.RUnlock()
+	argsForCall := fake.updateExportJobPolicyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeStorage) UpdateExportJobPolicyReturns(result1 error) {
+	fake.updateExportJobPolicyMutex.Lock()
+	defer fake.updateExportJobPolicyMutex.Unlock()
+	fake.UpdateExportJobPolicyStub = nil
+	fake.updateExportJobPolicyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateExportJobPolicyReturnsOnCall(i int, result1 error) {
+	fake.updateExportJobPolicyMutex.Lock()
+	defer fake.updateExportJobPolicyMutex.Unlock()
+	fake.UpdateExportJobPolicyStub = nil
+	if fake.updateExportJobPolicyReturnsOnCall == nil {
+		fake.updateExportJobPolicyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateExportJobPolicyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateExportJobStatus(arg1 context.Context, arg2 string, arg3 string) error {
+	fake.updateExportJobStatusMutex.Lock()
+	ret, specificReturn := fake.updateExportJobStatusReturnsOnCall[len(fake.updateExportJobStatusArgsForCall)]
+	fake.updateExportJobStatusArgsForCall = append(fake.updateExportJobStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateExportJobStatusStub
+	fakeReturns := fake.updateExportJobStatusReturns
+	fake.recordInvocation("UpdateExportJobStatus", []interface{}{arg1, arg2, arg3})
+	fake.updateExportJobStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorage) UpdateExportJobStatusCallCount() int {
+	fake.updateExportJobStatusMutex.RLock()
+	defer fake.updateExportJobStatusMutex.RUnlock()
+	return len(fake.updateExportJobStatusArgsForCall)
+}
+
+func (fake *FakeStorage) UpdateExportJobStatusCalls(stub func(context.Context, string, string) error) {
+	fake.updateExportJobStatusMutex.Lock()
+	defer fake.updateExportJobStatusMutex.Unlock()
+	fake.UpdateExportJobStatusStub = stub
+}
+
+func (fake *FakeStorage) UpdateExportJobStatusArgsForCall(i int) (context.Context, string, string) {
+	fake.updateExportJobStatusMutex.RLock()
+	defer fake.updateExportJobStatusMutex.RUnlock()
+	argsForCall := fake.updateExportJobStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeStorage) UpdateExportJobStatusReturns(result1 error) {
+	fake.updateExportJobStatusMutex.Lock()
+	defer fake.updateExportJobStatusMutex.Unlock()
+	fake.UpdateExportJobStatusStub = nil
+	fake.updateExportJobStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorage) UpdateExportJobStatusReturnsOnCall(i int, result1 error) {
+	fake.updateExportJobStatusMutex.Lock()
+	defer fake.updateExportJobStatusMutex.Unlock()
+	fake.UpdateExportJobStatusStub = nil
+	if fake.updateExportJobStatusReturnsOnCall == nil {
+		fake.updateExportJobStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateExportJobStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeStorage) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acquireLeaseMutex.RLock()
+	defer fake.acquireLeaseMutex.RUnlock()
+	fake.completeIdempotentRequestMutex.RLock()
+	defer fake.completeIdempotentRequestMutex.RUnlock()
+	fake.consumeImportChallengeMutex.RLock()
+	defer fake.consumeImportChallengeMutex.RUnlock()
+	fake.createExportConfigurationMutex.RLock()
+	defer fake.createExportConfigurationMutex.RUnlock()
+	fake.createExportJobMutex.RLock()
+	defer fake.createExportJobMutex.RUnlock()
+	fake.createIdempotentRequestMutex.RLock()
+	defer fake.createIdempotentRequestMutex.RUnlock()
+	fake.createImportChallengeMutex.RLock()
+	defer fake.createImportChallengeMutex.RUnlock()
+	fake.deleteExportConfigurationMutex.RLock()
+	defer fake.deleteExportConfigurationMutex.RUnlock()
+	fake.deleteIdempotentRequestMutex.RLock()
+	defer fake.deleteIdempotentRequestMutex.RUnlock()
 	fake.exportConfigurationMutex.RLock()
 	defer fake.exportConfigurationMutex.RUnlock()
+	fake.exportConfigurationsMutex.RLock()
+	defer fake.exportConfigurationsMutex.RUnlock()
+	fake.exportJobMutex.RLock()
+	defer fake.exportJobMutex.RUnlock()
+	fake.exportPresentationMutex.RLock()
+	defer fake.exportPresentationMutex.RUnlock()
+	fake.failStaleExportJobsMutex.RLock()
+	defer fake.failStaleExportJobsMutex.RUnlock()
+	fake.idempotentRequestMutex.RLock()
+	defer fake.idempotentRequestMutex.RUnlock()
+	fake.leaseHolderMutex.RLock()
+	defer fake.leaseHolderMutex.RUnlock()
+	fake.releaseLeaseMutex.RLock()
+	defer fake.releaseLeaseMutex.RUnlock()
+	fake.saveExportPresentationMutex.RLock()
+	defer fake.saveExportPresentationMutex.RUnlock()
+	fake.updateExportConfigurationMutex.RLock()
+	defer fake.updateExportConfigurationMutex.RUnlock()
+	fake.updateExportJobPolicyMutex.RLock()
+	defer fake.updateExportJobPolicyMutex.RUnlock()
+	fake.updateExportJobStatusMutex.RLock()
+	defer fake.updateExportJobStatusMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,119 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package infohubfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+)
+
+type FakeTrustAnchors struct {
+	IsTrustedIssuerStub        func(context.Context, string) (bool, error)
+	isTrustedIssuerMutex       sync.RWMutex
+	isTrustedIssuerArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	isTrustedIssuerReturns struct {
+		result1 bool
+		result2 error
+	}
+	isTrustedIssuerReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTrustAnchors) IsTrustedIssuer(arg1 context.Context, arg2 string) (bool, error) {
+	fake.isTrustedIssuerMutex.Lock()
+	ret, specificReturn := fake.isTrustedIssuerReturnsOnCall[len(fake.isTrustedIssuerArgsForCall)]
+	fake.isTrustedIssuerArgsForCall = append(fake.isTrustedIssuerArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IsTrustedIssuerStub
+	fakeReturns := fake.isTrustedIssuerReturns
+	fake.recordInvocation("IsTrustedIssuer", []interface{}{arg1, arg2})
+	fake.isTrustedIssuerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTrustAnchors) IsTrustedIssuerCallCount() int {
+	fake.isTrustedIssuerMutex.RLock()
+	defer fake.isTrustedIssuerMutex.RUnlock()
+	return len(fake.isTrustedIssuerArgsForCall)
+}
+
+func (fake *FakeTrustAnchors) IsTrustedIssuerCalls(stub func(context.Context, string) (bool, error)) {
+	fake.isTrustedIssuerMutex.Lock()
+	defer fake.isTrustedIssuerMutex.Unlock()
+	fake.IsTrustedIssuerStub = stub
+}
+
+func (fake *FakeTrustAnchors) IsTrustedIssuerArgsForCall(i int) (context.Context, string) {
+	fake.isTrustedIssuerMutex.RLock()
+	defer fake.isTrustedIssuerMutex.RUnlock()
+	argsForCall := fake.isTrustedIssuerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTrustAnchors) IsTrustedIssuerReturns(result1 bool, result2 error) {
+	fake.isTrustedIssuerMutex.Lock()
+	defer fake.isTrustedIssuerMutex.Unlock()
+	fake.IsTrustedIssuerStub = nil
+	fake.isTrustedIssuerReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTrustAnchors) IsTrustedIssuerReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isTrustedIssuerMutex.Lock()
+	defer fake.isTrustedIssuerMutex.Unlock()
+	fake.IsTrustedIssuerStub = nil
+	if fake.isTrustedIssuerReturnsOnCall == nil {
+		fake.isTrustedIssuerReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isTrustedIssuerReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTrustAnchors) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.isTrustedIssuerMutex.RLock()
+	defer fake.isTrustedIssuerMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTrustAnchors) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ infohub.TrustAnchors = new(FakeTrustAnchors)
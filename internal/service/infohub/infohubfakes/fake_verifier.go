@@ -0,0 +1,119 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package infohubfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+)
+
+type FakeVerifier struct {
+	VerifyPresentationStub        func(context.Context, []byte) error
+	verifyPresentationMutex       sync.RWMutex
+	verifyPresentationArgsForCall []struct {
+		arg1 context.Context
+		arg2 []byte
+	}
+	verifyPresentationReturns struct {
+		result1 error
+	}
+	verifyPresentationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeVerifier) VerifyPresentation(arg1 context.Context, arg2 []byte) error {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.verifyPresentationMutex.Lock()
+	ret, specificReturn := fake.verifyPresentationReturnsOnCall[len(fake.verifyPresentationArgsForCall)]
+	fake.verifyPresentationArgsForCall = append(fake.verifyPresentationArgsForCall, struct {
+		arg1 context.Context
+		arg2 []byte
+	}{arg1, arg2Copy})
+	stub := fake.VerifyPresentationStub
+	fakeReturns := fake.verifyPresentationReturns
+	fake.recordInvocation("VerifyPresentation", []interface{}{arg1, arg2Copy})
+	fake.verifyPresentationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeVerifier) VerifyPresentationCallCount() int {
+	fake.verifyPresentationMutex.RLock()
+	defer fake.verifyPresentationMutex.RUnlock()
+	return len(fake.verifyPresentationArgsForCall)
+}
+
+func (fake *FakeVerifier) VerifyPresentationCalls(stub func(context.Context, []byte) error) {
+	fake.verifyPresentationMutex.Lock()
+	defer fake.verifyPresentationMutex.Unlock()
+	fake.VerifyPresentationStub = stub
+}
+
+func (fake *FakeVerifier) VerifyPresentationArgsForCall(i int) (context.Context, []byte) {
+	fake.verifyPresentationMutex.RLock()
+	defer fake.verifyPresentationMutex.RUnlock()
+	argsForCall := fake.verifyPresentationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeVerifier) VerifyPresentationReturns(result1 error) {
+	fake.verifyPresentationMutex.Lock()
+	defer fake.verifyPresentationMutex.Unlock()
+	fake.VerifyPresentationStub = nil
+	fake.verifyPresentationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVerifier) VerifyPresentationReturnsOnCall(i int, result1 error) {
+	fake.verifyPresentationMutex.Lock()
+	defer fake.verifyPresentationMutex.Unlock()
+	fake.VerifyPresentationStub = nil
+	if fake.verifyPresentationReturnsOnCall == nil {
+		fake.verifyPresentationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyPresentationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeVerifier) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.verifyPresentationMutex.RLock()
+	defer fake.verifyPresentationMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeVerifier) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ infohub.Verifier = new(FakeVerifier)
@@ -0,0 +1,97 @@
+package infohub
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// GetExportJob returns the progress of an asynchronous export job.
+func (s *Service) GetExportJob(ctx context.Context, req *infohub.ExportJobRequest) (*infohub.ExportJob, error) {
+	logger := s.logger.With(
+		zap.String("operation", "getExportJob"),
+		zap.String("exportName", req.ExportName),
+		zap.String("jobID", req.ID),
+	)
+
+	// jobs reveal the parameters and progress of exports,
+	// so they are authorized like the exports
+	exportCfg, err := s.storage.ExportConfiguration(ctx, req.ExportName)
+	if err != nil {
+		logger.Error("error getting export configuration", zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.authorizeExport(ctx, exportCfg); err != nil {
+		logger.Error("export job is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	job, err := s.storage.ExportJob(ctx, req.ExportName, req.ID)
+	if err != nil {
+		logger.Error("error getting export job", zap.Error(err))
+		return nil, err
+	}
+
+	// jobs of instances which stopped are never finished, so clients
+	// following them get a failed job once it's without progress for too long
+	if s.interrupted(job) {
+		failInterruptedJob(job)
+		if _, err := s.FailStaleExportJobs(ctx); err != nil {
+			logger.Error("error failing interrupted export jobs", zap.Error(err))
+		}
+	}
+
+	policies := make([]*infohub.ExportJobPolicy, 0, len(job.Policies))
+	for _, p := range job.Policies {
+		policy := &infohub.ExportJobPolicy{
+			Policy: p.Policy,
+			Status: p.Status,
+		}
+		if p.Error != "" {
+			policy.Error = &p.Error
+		}
+		policies = append(policies, policy)
+	}
+
+	return &infohub.ExportJob{
+		ID:         job.ID,
+		ExportName: job.ExportName,
+		Status:     job.Status,
+		Policies:   policies,
+		Parameters: job.Parameters,
+		CreatedAt:  job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  job.UpdatedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// FailStaleExportJobs marks the unfinished export jobs which made no
+// progress within the job timeout as failed. Such jobs were interrupted,
+// e.g. by a restart of the instance running them.
+func (s *Service) FailStaleExportJobs(ctx context.Context) (int64, error) {
+	return s.storage.FailStaleExportJobs(ctx, time.Now().Add(-s.jobTimeout), jobInterrupted)
+}
+
+const jobInterrupted = "export job was interrupted"
+
+// interrupted reports whether the export job is unfinished
+// and made no progress within the job timeout.
+func (s *Service) interrupted(job *storage.ExportJob) bool {
+	if job.Status != storage.JobPending && job.Status != storage.JobRunning {
+		return false
+	}
+	return time.Since(job.UpdatedAt) > s.jobTimeout
+}
+
+func failInterruptedJob(job *storage.ExportJob) {
+	job.Status = storage.JobFailed
+	for _, p := range job.Policies {
+		if p.Status == storage.PolicyPending {
+			p.Status, p.Error = storage.PolicyFailed, jobInterrupted
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/claims"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_GetExportJob(t *testing.T) {
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		auth    *storage.ExportAuthorization
+		claims  claims.Claims
+		storage *infohubfakes.FakeStorage
+
+		interrupted bool
+
+		res     *goainfohub.ExportJob
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name: "export job not found",
+			storage: &infohubfakes.FakeStorage{
+				ExportJobStub: func(ctx context.Context, exportName, id string) (*storage.ExportJob, error) {
+					return nil, errors.New(errors.NotFound, "export job not found")
+				},
+			},
+			errkind: errors.NotFound,
+			errtext: "export job not found",
+		},
+		{
+			name:    "export job of export which the client may not perform",
+			auth:    &storage.ExportAuthorization{Scopes: []string{"export"}},
+			claims:  claims.Claims{"sub": "client-1", "scope": "import"},
+			storage: &infohubfakes.FakeStorage{},
+			errkind: errors.Forbidden,
+			errtext: `missing scope "export"`,
+		},
+		{
+			name: "interrupted export job is failed",
+			storage: &infohubfakes.FakeStorage{
+				ExportJobStub: func(ctx context.Context, exportName, id string) (*storage.ExportJob, error) {
+					return &storage.ExportJob{
+						ID:         "job-1",
+						ExportName: "testexport",
+						Status:     storage.JobRunning,
+						Policies: []*storage.ExportJobPolicy{
+							{Policy: "test/test/1.0", Status: storage.PolicyEvaluated},
+							{Policy: "test/test/2.0", Status: storage.PolicyPending},
+						},
+						CreatedAt: created,
+						UpdatedAt: created,
+					}, nil
+				},
+			},
+			res: &goainfohub.ExportJob{
+				ID:         "job-1",
+				ExportName: "testexport",
+				Status:     "failed",
+				Policies: []*goainfohub.ExportJobPolicy{
+					{Policy: "test/test/1.0", Status: "evaluated"},
+					{Policy: "test/test/2.0", Status: "failed", Error: ptr.String("export job was interrupted")},
+				},
+				CreatedAt: "2024-01-02T03:04:05Z",
+				UpdatedAt: "2024-01-02T03:04:05Z",
+			},
+			interrupted: true,
+		},
+		{
+			name: "export job is returned",
+			storage: &infohubfakes.FakeStorage{
+				ExportJobStub: func(ctx context.Context, exportName, id string) (*storage.ExportJob, error) {
+					return &storage.ExportJob{
+						ID:         "job-1",
+						ExportName: "testexport",
+						Status:     storage.JobFailed,
+						Policies: []*storage.ExportJobPolicy{
+							{Policy: "test/test/1.0", Status: storage.PolicyEvaluated},
+							{Policy: "test/test/2.0", Status: storage.PolicyFailed, Error: "some error"},
+						},
+						CreatedAt: created,
+						UpdatedAt: created,
+					}, nil
+				},
+			},
+			res: &goainfohub.ExportJob{
+				ID:         "job-1",
+				ExportName: "testexport",
+				Status:     "failed",
+				Policies: []*goainfohub.ExportJobPolicy{
+					{Policy: "test/test/1.0", Status: "evaluated"},
+					{Policy: "test/test/2.0", Status: "failed", Error: ptr.String("some error")},
+				},
+				CreatedAt: "2024-01-02T03:04:05Z",
+				UpdatedAt: "2024-01-02T03:04:05Z",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			test.storage.ExportConfigurationStub = func(ctx context.Context, name string) (*storage.ExportConfiguration, error) {
+				return &storage.ExportConfiguration{ExportName: name, Authorization: test.auth}, nil
+			}
+			ctx := context.Background()
+			if test.claims != nil {
+				ctx = claims.NewContext(ctx, test.claims)
+			}
+
+			svc := infohub.New(test.storage, nil, nil, nil, nil, zap.NewNop())
+			res, err := svc.GetExportJob(ctx, &goainfohub.ExportJobRequest{ExportName: "testexport", ID: "job-1"})
+			if err != nil {
+				assert.Nil(t, res)
+				assert.NotEmpty(t, test.errtext)
+				e, ok := err.(*errors.Error)
+				assert.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Contains(t, e.Error(), test.errtext)
+			} else {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, test.res, res)
+				// interrupted jobs are failed in the database as well
+				assert.Equal(t, test.interrupted, test.storage.FailStaleExportJobsCallCount() == 1)
+			}
+		})
+	}
+}
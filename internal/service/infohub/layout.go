@@ -0,0 +1,101 @@
+package infohub
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// policyLabel is the credential subject field which identifies the policy
+// that produced the exported data. It's namespaced, so that it doesn't
+// collide with the fields of policy results.
+const policyLabel = "infohub:policy"
+
+// singleCredential reports whether an export with the given layout and
+// number of policies is exported as a single credential, which is required
+// by the SD-JWT format.
+func singleCredential(layout string, policies int) bool {
+	return layout == storage.LayoutMerged || policies == 1
+}
+
+// exportSubjects decodes the policy results and arranges them as credential
+// subjects according to the layout of the export. Subjects are ordered by the
+// policy names of the export, so the exported presentations are deterministic.
+//
+// With the default credentialPerPolicy layout, each policy result becomes a
+// separate subject labeled with the policy name. With the merged layout a
+// single subject is returned, containing the policy results keyed by policy name.
+func exportSubjects(exportCfg *storage.ExportConfiguration, policyResults map[string][]byte) ([]map[string]interface{}, error) {
+	merged := make(map[string]interface{}, len(policyResults))
+	var subjects []map[string]interface{}
+	for _, policy := range exportCfg.PolicyNames() {
+		var res map[string]interface{}
+		if err := json.Unmarshal(policyResults[policy], &res); err != nil {
+			return nil, err
+		}
+
+		switch exportCfg.Layout {
+		case storage.LayoutMerged:
+			merged[policy] = res
+		case "", storage.LayoutCredentialPerPolicy:
+			if _, ok := res[policyLabel]; ok {
+				return nil, fmt.Errorf("result of policy %s contains reserved field %q", policy, policyLabel)
+			}
+			if res == nil {
+				res = make(map[string]interface{})
+			}
+			res[policyLabel] = policy
+			subjects = append(subjects, res)
+		default:
+			return nil, errors.New(errors.Internal, fmt.Sprintf("unknown export layout %q", exportCfg.Layout))
+		}
+	}
+
+	if exportCfg.Layout == storage.LayoutMerged {
+		subjects = append(subjects, merged)
+	}
+
+	return subjects, nil
+}
+
+// exportPresentation wraps the given subjects in Verifiable Credentials
+// and returns them in an unsigned Verifiable Presentation. The credentials
+// use the JSON-LD contexts, types and schema of the export configuration and
+// expire together with the export data in the Cache.
+func (s *Service) exportPresentation(exportCfg *storage.ExportConfiguration, subjects []map[string]interface{}) (*verifiable.Presentation, error) {
+	opts := []credential.CredentialOption{
+		credential.WithIssuer(exportCfg.Issuer),
+		credential.WithTypes(exportCfg.CredentialTypes...),
+	}
+	if exportCfg.CredentialSchema != nil {
+		opts = append(opts, credential.WithSchema(exportCfg.CredentialSchema.ID, exportCfg.CredentialSchema.Type))
+	}
+	if exportCfg.CacheTTL != nil {
+		opts = append(opts, credential.WithExpiration(time.Now().Add(time.Duration(*exportCfg.CacheTTL)*time.Second)))
+	}
+
+	var vcs []*verifiable.Credential
+	for _, subject := range subjects {
+		vc, err := s.credentials.NewCredential(exportCfg.Contexts, subject, opts...)
+		if err != nil {
+			return nil, err
+		}
+		vcs = append(vcs, vc)
+	}
+
+	vp, err := s.credentials.NewPresentation(exportCfg.Contexts, vcs...)
+	if err != nil {
+		return nil, err
+	}
+	if exportCfg.Issuer != "" {
+		vp.Holder = exportCfg.Issuer
+	}
+
+	return vp, nil
+}
@@ -0,0 +1,158 @@
+package infohub
+
+import "time"
+
+const (
+	defaultPolicyWorkers = 5
+	defaultJobTimeout    = 10 * time.Minute
+	defaultAdminScope    = "infohub:admin"
+)
+
+type Option func(*Service)
+
+// WithPolicyWorkers limits the number of policies of an export
+// which are evaluated concurrently.
+func WithPolicyWorkers(n int) Option {
+	return func(s *Service) {
+		if n > 0 {
+			s.policyWorkers = n
+		}
+	}
+}
+
+// WithExportJobTimeout sets the time after which unfinished export jobs
+// which made no progress are considered interrupted and failed.
+func WithExportJobTimeout(timeout time.Duration) Option {
+	return func(s *Service) {
+		if timeout > 0 {
+			s.jobTimeout = timeout
+		}
+	}
+}
+
+// WithAdminScope sets the scope which clients must be granted in order
+// to manage export configurations.
+func WithAdminScope(scope string) Option {
+	return func(s *Service) {
+		if scope != "" {
+			s.adminScope = scope
+		}
+	}
+}
+
+// WithImportScope sets the scope which clients must be granted
+// in order to import data.
+func WithImportScope(scope string) Option {
+	return func(s *Service) {
+		s.importScope = scope
+	}
+}
+
+// WithImportNamespaces sets the Cache namespaces which clients may import
+// into, keyed by client ID. Namespaces of the "*" key are allowed for all
+// clients. The default namespace is allowed for all clients.
+func WithImportNamespaces(namespaces map[string][]string) Option {
+	return func(s *Service) {
+		s.importNamespaces = namespaces
+	}
+}
+
+// WithImportPolicies validates imported data with the given policies.
+func WithImportPolicies(policies ImportPolicies) Option {
+	return func(s *Service) {
+		s.importPolicies = policies
+	}
+}
+
+// WithSchemaValidator sets the validator of the JSON schemas which
+// export configurations require their policy results to satisfy.
+func WithSchemaValidator(v SchemaValidator) Option {
+	return func(s *Service) {
+		if v != nil {
+			s.schemas = v
+		}
+	}
+}
+
+// WithImportSchemaValidation validates imported credentials with the JSON
+// schemas referenced by their credentialSchema property. It requires
+// a schema validator.
+func WithImportSchemaValidation(enabled bool) Option {
+	return func(s *Service) {
+		s.importSchemas = enabled
+	}
+}
+
+// WithVerifier sets the verifier of imported presentations
+// instead of the Signer service.
+func WithVerifier(v Verifier) Option {
+	return func(s *Service) {
+		if v != nil {
+			s.verifier = v
+		}
+	}
+}
+
+// WithJWTParser enables the import of JWT encoded presentations
+// and credentials, which are verified by the given parser.
+func WithJWTParser(p JWTParser) Option {
+	return func(s *Service) {
+		if p != nil {
+			s.jwt = p
+		}
+	}
+}
+
+// WithTrustAnchors restricts imports to credentials of issuers
+// which are trusted by the given trust anchors.
+func WithTrustAnchors(t TrustAnchors) Option {
+	return func(s *Service) {
+		if t != nil {
+			s.trust = t
+		}
+	}
+}
+
+// WithStatusChecker checks the status of imported credentials,
+// so that revoked or suspended credentials are rejected.
+func WithStatusChecker(c StatusChecker) Option {
+	return func(s *Service) {
+		if c != nil {
+			s.status = c
+		}
+	}
+}
+
+// WithImportChallenge requires imported presentations to be proven with
+// a challenge issued by the service and the given domain. Challenges
+// expire after the given TTL.
+func WithImportChallenge(domain string, ttl time.Duration) Option {
+	return func(s *Service) {
+		s.challengeRequired = true
+		s.challengeDomain = domain
+		if ttl > 0 {
+			s.challengeTTL = ttl
+		}
+	}
+}
+
+// WithImportRegistry records imported credentials in the given registry.
+// Credentials which are already imported into the same Cache namespace
+// and scope are not imported again.
+func WithImportRegistry(r ImportRegistry) Option {
+	return func(s *Service) {
+		if r != nil {
+			s.registry = r
+		}
+	}
+}
+
+// WithCredentialHasher sets how the hashes of imported credentials are
+// computed. By default the JSON Canonicalization Scheme is used.
+func WithCredentialHasher(h CredentialHasher) Option {
+	return func(s *Service) {
+		if h != nil {
+			s.hasher = h
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package infohub
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// parameterPlaceholder matches the ${name} placeholders of export
+// parameters in the policy input.
+var parameterPlaceholder = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// exportParameters are the validated parameter values of an export request.
+type exportParameters struct {
+	values map[string]interface{}
+	// key identifies the parameter values in the Cache keys of the
+	// export data. It's empty when the export has no parameter values.
+	key string
+}
+
+// resolveParameters validates the parameters given in the query string and
+// the request body against the parameters declared by the export and converts
+// them to their declared types. Values in the body take precedence over values
+// in the query string. Parameters which are not given get their default value.
+func resolveParameters(exportCfg *storage.ExportConfiguration, query map[string]string, body map[string]interface{}) (*exportParameters, error) {
+	given := make(map[string]interface{}, len(query)+len(body))
+	for name, value := range query {
+		given[name] = value
+	}
+	for name, value := range body {
+		given[name] = value
+	}
+
+	declared := make(map[string]*storage.ExportParameter, len(exportCfg.Parameters))
+	for _, param := range exportCfg.Parameters {
+		declared[param.Name] = param
+	}
+	for name := range given {
+		if declared[name] == nil {
+			return nil, errors.New(errors.BadRequest, fmt.Sprintf("unknown export parameter %q", name))
+		}
+	}
+
+	values := make(map[string]interface{}, len(exportCfg.Parameters))
+	for _, param := range exportCfg.Parameters {
+		value, ok := given[param.Name]
+		if !ok {
+			if param.Default == nil {
+				if param.Required {
+					return nil, errors.New(errors.BadRequest, fmt.Sprintf("missing required export parameter %q", param.Name))
+				}
+				continue
+			}
+			value = param.Default
+		}
+
+		v, err := parameterValue(param, value)
+		if err != nil {
+			return nil, errors.New(errors.BadRequest, fmt.Sprintf("invalid export parameter %q", param.Name), err)
+		}
+		values[param.Name] = v
+	}
+
+	params := &exportParameters{values: values}
+	if len(values) > 0 {
+		// map keys are sorted when encoded, so equal values produce equal keys
+		b, err := json.Marshal(values)
+		if err != nil {
+			return nil, errors.New("error encoding export parameters", err)
+		}
+		sum := sha256.Sum256(b)
+		params.key = hex.EncodeToString(sum[:])
+	}
+
+	return params, nil
+}
+
+// parameterValue converts the value of a parameter to its declared type and
+// checks that string values match the declared pattern. String values are
+// parsed for parameters of other types, as query string values are strings.
+func parameterValue(param *storage.ExportParameter, value interface{}) (interface{}, error) {
+	switch param.Type {
+	case storage.ParameterString, "":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("value must be a string")
+		}
+		if param.Pattern != "" {
+			matched, err := regexp.MatchString(param.Pattern, s)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				return nil, fmt.Errorf("value must match pattern %s", param.Pattern)
+			}
+		}
+		return s, nil
+	case storage.ParameterNumber:
+		switch v := value.(type) {
+		case string:
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, err
+			}
+			// NaN and infinity can't be encoded in the policy input
+			if math.IsNaN(f) || math.IsInf(f, 0) {
+				return nil, fmt.Errorf("value must be a finite number")
+			}
+			return f, nil
+		case float64:
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return nil, fmt.Errorf("value must be a finite number")
+			}
+			return v, nil
+		case int32:
+			return float64(v), nil
+		case int64:
+			return float64(v), nil
+		case int:
+			return float64(v), nil
+		}
+		return nil, fmt.Errorf("value must be a number")
+	case storage.ParameterInteger:
+		switch v := value.(type) {
+		case string:
+			return strconv.ParseInt(v, 10, 64)
+		case float64:
+			if v != math.Trunc(v) {
+				return nil, fmt.Errorf("value must be an integer")
+			}
+			return int64(v), nil
+		case int32:
+			return int64(v), nil
+		case int64:
+			return v, nil
+		case int:
+			return int64(v), nil
+		}
+		return nil, fmt.Errorf("value must be an integer")
+	case storage.ParameterBoolean:
+		switch v := value.(type) {
+		case string:
+			return strconv.ParseBool(v)
+		case bool:
+			return v, nil
+		}
+		return nil, fmt.Errorf("value must be a boolean")
+	}
+
+	return nil, fmt.Errorf("unknown parameter type %q", param.Type)
+}
+
+// policyInput returns the policy input with the ${name} placeholders replaced
+// by the parameter values. A string consisting of a single placeholder is
+// replaced by the typed value, while placeholders within longer strings are
+// replaced by the formatted value. Placeholders of parameters without value
+// are kept unchanged.
+func (p *exportParameters) policyInput(input interface{}) (interface{}, error) {
+	if len(p.values) == 0 || input == nil {
+		return input, nil
+	}
+
+	// the input is copied, as the export configuration is shared
+	// by the evaluations of all policies
+	b, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	var cp interface{}
+	if err := json.Unmarshal(b, &cp); err != nil {
+		return nil, err
+	}
+
+	return p.template(cp), nil
+}
+
+func (p *exportParameters) template(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = p.template(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = p.template(value)
+		}
+		return v
+	case string:
+		if m := parameterPlaceholder.FindStringSubmatch(v); m != nil && m[0] == v {
+			if value, ok := p.values[m[1]]; ok {
+				return value
+			}
+			return v
+		}
+		return parameterPlaceholder.ReplaceAllStringFunc(v, func(placeholder string) string {
+			name := strings.TrimSuffix(strings.TrimPrefix(placeholder, "${"), "}")
+			if value, ok := p.values[name]; ok {
+				return fmt.Sprint(value)
+			}
+			return placeholder
+		})
+	}
+	return v
+}
+
+// validateParameters checks that the declared parameters of an export have
+// unique names, valid patterns and default values of the declared type.
+func validateParameters(params []*storage.ExportParameter) error {
+	names := make(map[string]bool, len(params))
+	for _, param := range params {
+		if names[param.Name] {
+			return fmt.Errorf("duplicate parameter %q", param.Name)
+		}
+		names[param.Name] = true
+
+		if param.Pattern != "" {
+			if _, err := regexp.Compile(param.Pattern); err != nil {
+				return fmt.Errorf("invalid pattern of parameter %q: %w", param.Name, err)
+			}
+		}
+		if param.Default != nil {
+			if _, err := parameterValue(param, param.Default); err != nil {
+				return fmt.Errorf("invalid default value of parameter %q: %w", param.Name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package infohub_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_Export_Parameters(t *testing.T) {
+	exportCfg := &storage.ExportConfiguration{
+		ExportName: "participant-compliance",
+		Policies: map[string]interface{}{
+			"test/test/1.0": map[string]interface{}{
+				"participant": "${participantId}",
+				"depth":       "${depth}",
+				"strict":      "${strict}",
+				"message":     "check ${participantId} with depth ${depth}",
+				"list":        []interface{}{"${participantId}"},
+			},
+		},
+		Parameters: []*storage.ExportParameter{
+			{Name: "participantId", Type: storage.ParameterString, Required: true, Pattern: "^did:web:.+$"},
+			{Name: "depth", Type: storage.ParameterInteger, Default: int32(1)},
+			{Name: "strict", Type: storage.ParameterBoolean},
+			{Name: "threshold", Type: storage.ParameterNumber},
+		},
+	}
+	notFound := func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+		return nil, errors.New(errors.NotFound, "no data")
+	}
+
+	tests := []struct {
+		name       string
+		query      map[string]string
+		parameters map[string]interface{}
+
+		errkind errors.Kind
+		errtext string
+		input   map[string]interface{}
+	}{
+		{
+			name:    "missing required parameter",
+			errkind: errors.BadRequest,
+			errtext: `missing required export parameter "participantId"`,
+		},
+		{
+			name:    "unknown parameter",
+			query:   map[string]string{"participantId": "did:web:example.com", "other": "value"},
+			errkind: errors.BadRequest,
+			errtext: `unknown export parameter "other"`,
+		},
+		{
+			name:    "parameter not matching pattern",
+			query:   map[string]string{"participantId": "did:key:123"},
+			errkind: errors.BadRequest,
+			errtext: `invalid export parameter "participantId"`,
+		},
+		{
+			name:    "parameter of wrong type",
+			query:   map[string]string{"participantId": "did:web:example.com", "depth": "deep"},
+			errkind: errors.BadRequest,
+			errtext: `invalid export parameter "depth"`,
+		},
+		{
+			name:    "number parameter which is not a number",
+			query:   map[string]string{"participantId": "did:web:example.com", "threshold": "NaN"},
+			errkind: errors.BadRequest,
+			errtext: `invalid export parameter "threshold"`,
+		},
+		{
+			name:    "number parameter which is infinite",
+			query:   map[string]string{"participantId": "did:web:example.com", "threshold": "+Inf"},
+			errkind: errors.BadRequest,
+			errtext: `invalid export parameter "threshold"`,
+		},
+		{
+			name:  "parameters from query string are templated into policy input",
+			query: map[string]string{"participantId": "did:web:example.com", "strict": "true"},
+			input: map[string]interface{}{
+				"participant": "did:web:example.com",
+				"depth":       int64(1),
+				"strict":      true,
+				"message":     "check did:web:example.com with depth 1",
+				"list":        []interface{}{"did:web:example.com"},
+			},
+		},
+		{
+			name:       "parameters from body take precedence over query string",
+			query:      map[string]string{"participantId": "did:web:query.example.com"},
+			parameters: map[string]interface{}{"participantId": "did:web:example.com", "depth": float64(3)},
+			input: map[string]interface{}{
+				"participant": "did:web:example.com",
+				"depth":       int64(3),
+				"strict":      "${strict}",
+				"message":     "check did:web:example.com with depth 3",
+				"list":        []interface{}{"did:web:example.com"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storageFake := &infohubfakes.FakeStorage{
+				ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+					return exportCfg, nil
+				},
+			}
+			policyFake := &infohubfakes.FakePolicy{}
+
+			svc := infohub.New(storageFake, policyFake, &infohubfakes.FakeCache{GetStub: notFound}, nil, nil, zap.NewNop())
+			res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{
+				ExportName: "participant-compliance",
+				Query:      test.query,
+				Parameters: test.parameters,
+			})
+			svc.Wait()
+
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Nil(t, res)
+				assert.Contains(t, err.Error(), test.errtext)
+				e, ok := err.(*errors.Error)
+				require.True(t, ok)
+				assert.Equal(t, test.errkind, e.Kind)
+				assert.Equal(t, 0, policyFake.EvaluateCallCount())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "accepted", res.Status)
+
+			require.Equal(t, 1, policyFake.EvaluateCallCount())
+			_, _, input, evaluationID, _ := policyFake.EvaluateArgsForCall(0)
+			assert.Equal(t, test.input, input)
+			assert.True(t, strings.HasPrefix(evaluationID, "participant-compliance:test/test/1.0:"))
+
+			_, job := storageFake.CreateExportJobArgsForCall(0)
+			assert.Equal(t, test.input["participant"], job.Parameters["participantId"])
+		})
+	}
+}
+
+func TestService_Export_ParametersCacheKey(t *testing.T) {
+	storageFake := &infohubfakes.FakeStorage{
+		ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+			return &storage.ExportConfiguration{
+				ExportName: "testexport",
+				Policies:   map[string]interface{}{"test/test/1.0": map[string]interface{}{"id": "${id}"}},
+				Parameters: []*storage.ExportParameter{{Name: "id", Type: storage.ParameterString}},
+			}, nil
+		},
+	}
+	cacheFake := &infohubfakes.FakeCache{
+		GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+			return nil, errors.New(errors.NotFound, "no data")
+		},
+	}
+
+	svc := infohub.New(storageFake, &infohubfakes.FakePolicy{}, cacheFake, nil, nil, zap.NewNop())
+	for _, query := range []map[string]string{{"id": "a"}, {"id": "b"}, {"id": "a"}, nil} {
+		_, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport", Query: query})
+		require.NoError(t, err)
+	}
+	svc.Wait()
+
+	var keys []string
+	for i := 0; i < cacheFake.GetCallCount(); i++ {
+		_, key, _, _ := cacheFake.GetArgsForCall(i)
+		keys = append(keys, key)
+	}
+	require.Len(t, keys, 4)
+	assert.NotEqual(t, keys[0], keys[1])
+	assert.Equal(t, keys[0], keys[2])
+	assert.Equal(t, "testexport:test/test/1.0", keys[3])
+}
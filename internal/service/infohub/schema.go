@@ -0,0 +1,41 @@
+package infohub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// validateExportData checks that each policy result of the export satisfies
+// the result schema of the export configuration, if it declares one, so
+// that invalid data is not signed.
+func (s *Service) validateExportData(ctx context.Context, exportCfg *storage.ExportConfiguration, policyResults map[string][]byte) error {
+	if exportCfg.ResultSchema == "" {
+		return nil
+	}
+
+	if s.schemas == nil {
+		return errors.New(errors.Internal, "export result schema cannot be validated, schema validation is not configured")
+	}
+
+	for _, policy := range exportCfg.PolicyNames() {
+		var result interface{}
+		if err := json.Unmarshal(policyResults[policy], &result); err != nil {
+			return errors.New(errors.Internal, fmt.Sprintf("error decoding result of policy %q", policy), err)
+		}
+
+		if err := s.schemas.Validate(ctx, exportCfg.ResultSchema, result); err != nil {
+			msg := fmt.Sprintf("result of policy %q doesn't satisfy the export schema", policy)
+			if errors.Is(errors.BadRequest, err) || errors.Is(errors.NotFound, err) {
+				// the data or configuration of the export is invalid, not the request
+				return errors.New(errors.Internal, msg, err)
+			}
+			return errors.New(msg, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_Export_ResultSchema(t *testing.T) {
+	exportCfg := &storage.ExportConfiguration{
+		ExportName:   "testexport",
+		Policies:     map[string]interface{}{"test/test/1.0": map[string]interface{}{}},
+		Issuer:       "did:web:issuer.example.com",
+		KeyNamespace: "transit",
+		Key:          "key1",
+		ResultSchema: "https://schemas.example.com/compliance.json",
+	}
+
+	tests := []struct {
+		name        string
+		validator   *infohubfakes.FakeSchemaValidator
+		noValidator bool
+
+		errkind errors.Kind
+		errtext string
+	}{
+		{
+			name:      "policy result satisfies schema",
+			validator: &infohubfakes.FakeSchemaValidator{},
+		},
+		{
+			name: "policy result doesn't satisfy schema",
+			validator: &infohubfakes.FakeSchemaValidator{
+				ValidateStub: func(ctx context.Context, id string, data interface{}) error {
+					return errors.New(errors.BadRequest, "data doesn't match schema")
+				},
+			},
+			errkind: errors.Internal,
+			errtext: `result of policy "test/test/1.0" doesn't satisfy the export schema`,
+		},
+		{
+			name:        "schema validation is not configured",
+			noValidator: true,
+			errkind:     errors.Internal,
+			errtext:     "schema validation is not configured",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storageFake := &infohubfakes.FakeStorage{}
+			storageFake.ExportConfigurationReturns(exportCfg, nil)
+			cacheFake := &infohubfakes.FakeCache{}
+			cacheFake.GetReturns([]byte(`{"allow":true}`), nil)
+			signerFake := &infohubfakes.FakeSigner{}
+			signerFake.PresentationProofReturns(map[string]interface{}{"id": "did:web:example.com"}, nil)
+
+			var opts []infohub.Option
+			if !test.noValidator {
+				opts = append(opts, infohub.WithSchemaValidator(test.validator))
+			}
+			svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop(), opts...)
+			res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+
+			if test.validator != nil {
+				require.Equal(t, 1, test.validator.ValidateCallCount())
+				_, id, data := test.validator.ValidateArgsForCall(0)
+				assert.Equal(t, "https://schemas.example.com/compliance.json", id)
+				assert.Equal(t, map[string]interface{}{"allow": true}, data)
+			}
+
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				assert.True(t, errors.Is(test.errkind, err))
+				assert.Equal(t, 0, signerFake.PresentationProofCallCount())
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, 1, signerFake.PresentationProofCallCount())
+		})
+	}
+}
+
+func TestService_Import_SchemaValidation(t *testing.T) {
+	vp, err := verifiable.ParsePresentation([]byte(`{
+		"@context": ["https://www.w3.org/2018/credentials/v1"],
+		"type": ["VerifiablePresentation"],
+		"verifiableCredential": [{
+			"credentialSchema": {"id": "https://schemas.example.com/participant.json", "type": "JsonSchema"},
+			"credentialSubject": {"name": 1}
+		}]
+	}`), verifiable.WithPresDisabledProofCheck(), verifiable.WithDisabledJSONLDChecks())
+	require.NoError(t, err)
+
+	credentialsFake := &infohubfakes.FakeCredentials{}
+	credentialsFake.ParsePresentationReturns(vp, nil)
+	validatorFake := &infohubfakes.FakeSchemaValidator{}
+	validatorFake.ValidateCredentialReturns(errors.New(errors.BadRequest, `data doesn't match schema "https://schemas.example.com/participant.json"`))
+
+	t.Run("credentials are not validated by default", func(t *testing.T) {
+		svc := infohub.New(nil, nil, &infohubfakes.FakeCache{}, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(), infohub.WithSchemaValidator(validatorFake))
+		_, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+		require.NoError(t, err)
+		assert.Equal(t, 0, validatorFake.ValidateCredentialCallCount())
+	})
+
+	t.Run("credential which doesn't match its schema is rejected", func(t *testing.T) {
+		cacheFake := &infohubfakes.FakeCache{}
+		svc := infohub.New(nil, nil, cacheFake, credentialsFake, &infohubfakes.FakeSigner{}, zap.NewNop(),
+			infohub.WithSchemaValidator(validatorFake),
+			infohub.WithImportSchemaValidation(true),
+		)
+		res, err := svc.Import(context.Background(), &goainfohub.ImportRequest{Data: []byte(`{}`)})
+		assert.Nil(t, res)
+		require.Error(t, err)
+		assert.True(t, errors.Is(errors.BadRequest, err))
+		assert.Contains(t, err.Error(), `credential 0: data doesn't match schema "https://schemas.example.com/participant.json"`)
+		assert.Equal(t, 0, cacheFake.SetCallCount())
+	})
+}
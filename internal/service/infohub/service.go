@@ -2,7 +2,8 @@ package infohub
 
 import (
 	"context"
-	"encoding/json"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
@@ -10,6 +11,8 @@ import (
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential/jwtvc"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
 )
 
@@ -18,11 +21,38 @@ import (
 //go:generate counterfeiter . Cache
 //go:generate counterfeiter . Credentials
 //go:generate counterfeiter . Signer
+//go:generate counterfeiter . Verifier
+//go:generate counterfeiter . TrustAnchors
+//go:generate counterfeiter . StatusChecker
+//go:generate counterfeiter . ImportRegistry
+//go:generate counterfeiter . CredentialHasher
+//go:generate counterfeiter . SchemaValidator
+//go:generate counterfeiter . JWTParser
 
-var exportAccepted = map[string]interface{}{"result": "export request is accepted"}
+const exportAccepted = "export request is accepted"
 
 type Storage interface {
 	ExportConfiguration(ctx context.Context, exportName string) (*storage.ExportConfiguration, error)
+	ExportConfigurations(ctx context.Context) ([]*storage.ExportConfiguration, error)
+	CreateExportConfiguration(ctx context.Context, cfg *storage.ExportConfiguration) error
+	UpdateExportConfiguration(ctx context.Context, cfg *storage.ExportConfiguration) error
+	DeleteExportConfiguration(ctx context.Context, exportName string) error
+	CreateExportJob(ctx context.Context, job *storage.ExportJob) error
+	ExportJob(ctx context.Context, exportName, id string) (*storage.ExportJob, error)
+	UpdateExportJobStatus(ctx context.Context, id, status string) error
+	UpdateExportJobPolicy(ctx context.Context, id, policy, status, errmsg string) error
+	FailStaleExportJobs(ctx context.Context, before time.Time, errmsg string) (int64, error)
+	SaveExportPresentation(ctx context.Context, key string, vp []byte, expiresAt *time.Time) error
+	ExportPresentation(ctx context.Context, key string) (*storage.ExportPresentation, error)
+	AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error)
+	LeaseHolder(ctx context.Context, name string) (string, error)
+	ReleaseLease(ctx context.Context, name, holder string) error
+	CreateImportChallenge(ctx context.Context, challenge *storage.ImportChallenge) error
+	ConsumeImportChallenge(ctx context.Context, challenge string) (*storage.ImportChallenge, error)
+	CreateIdempotentRequest(ctx context.Context, req *storage.IdempotentRequest) error
+	IdempotentRequest(ctx context.Context, id string) (*storage.IdempotentRequest, error)
+	CompleteIdempotentRequest(ctx context.Context, id string, result []byte) error
+	DeleteIdempotentRequest(ctx context.Context, id string) error
 }
 
 type Policy interface {
@@ -32,91 +62,143 @@ type Policy interface {
 type Cache interface {
 	Get(ctx context.Context, key, namespace, scope string) ([]byte, error)
 	Set(ctx context.Context, key, namespace, scope string, value []byte) error
+	Delete(ctx context.Context, key, namespace, scope string) error
 }
 
 type Credentials interface {
+	NewCredential(contexts []string, subject map[string]interface{}, opts ...credential.CredentialOption) (*verifiable.Credential, error)
+	NewPresentation(contexts []string, vc ...*verifiable.Credential) (*verifiable.Presentation, error)
 	ParsePresentation(vpBytes []byte) (*verifiable.Presentation, error)
 }
 
 type Signer interface {
-	CreatePresentation(ctx context.Context, issuer, namespace, key string, data []map[string]interface{}) (map[string]interface{}, error)
+	PresentationProof(ctx context.Context, issuer, namespace, key string, vp *verifiable.Presentation) (map[string]interface{}, error)
+	SignJWT(ctx context.Context, issuer, namespace, key, typ string, claims map[string]interface{}) (string, error)
+	Verifier
+}
+
+// Verifier verifies the proofs of imported presentations.
+type Verifier interface {
 	VerifyPresentation(ctx context.Context, vp []byte) error
 }
 
+// TrustAnchors decide which issuers of imported credentials are trusted.
+type TrustAnchors interface {
+	IsTrustedIssuer(ctx context.Context, issuer string) (bool, error)
+}
+
+// StatusChecker checks the status of imported credentials, e.g. whether
+// they are revoked.
+type StatusChecker interface {
+	CheckStatus(ctx context.Context, cred map[string]interface{}) error
+}
+
+// ImportRegistry records imported credentials by the hashes of their content,
+// so that credentials which are imported again are detected and imported
+// data can be found and erased.
+type ImportRegistry interface {
+	ImportRecord(ctx context.Context, id string) (*storage.ImportRecord, error)
+	KeyImportRecord(ctx context.Context, key, namespace, scope string) (*storage.ImportRecord, error)
+	ImportRecords(ctx context.Context, filter *storage.ImportRecordFilter) ([]*storage.ImportRecord, error)
+	FindImportRecord(ctx context.Context, hash, namespace, scope, key string) (*storage.ImportRecord, error)
+	SaveImportRecord(ctx context.Context, record *storage.ImportRecord) (*storage.ImportRecord, error)
+	DeleteImportRecord(ctx context.Context, key, namespace, scope string) error
+}
+
+// CredentialHasher computes the hashes of the canonical form of credentials.
+type CredentialHasher interface {
+	Hash(cred map[string]interface{}) (string, error)
+}
+
+// SchemaValidator validates imported credentials and exported
+// data with JSON schemas.
+type SchemaValidator interface {
+	Validate(ctx context.Context, schemaID string, data interface{}) error
+	ValidateCredential(ctx context.Context, cred map[string]interface{}) error
+}
+
+// JWTParser verifies and parses JWT encoded presentations and credentials,
+// which are normalised into the JSON-LD data model.
+type JWTParser interface {
+	ParsePresentation(ctx context.Context, data []byte) (*jwtvc.Presentation, error)
+	ParseCredential(ctx context.Context, cred interface{}) (map[string]interface{}, error)
+}
+
 type Service struct {
 	storage     Storage
 	policy      Policy
 	cache       Cache
 	credentials Credentials
 	signer      Signer
+	verifier    Verifier
+	trust       TrustAnchors
+	status      StatusChecker
+	registry    ImportRegistry
+	hasher      CredentialHasher
+	schemas     SchemaValidator
+	jwt         JWTParser
 	logger      *zap.Logger
-}
 
-func New(storage Storage, policy Policy, cache Cache, cred Credentials, signer Signer, logger *zap.Logger) *Service {
-	return &Service{
-		storage:     storage,
-		policy:      policy,
-		cache:       cache,
-		credentials: cred,
-		signer:      signer,
-		logger:      logger,
-	}
-}
+	// policyWorkers limits the number of concurrent policy evaluations of an export
+	policyWorkers int
 
-// Import the given data wrapped as Verifiable Presentation into the Cache.
-func (s *Service) Import(ctx context.Context, req *infohub.ImportRequest) (res *infohub.ImportResult, err error) {
-	logger := s.logger.With(zap.String("operation", "import"))
+	// jobTimeout is the time after which unfinished export jobs
+	// without progress are considered interrupted
+	jobTimeout time.Duration
 
-	if err := s.signer.VerifyPresentation(ctx, req.Data); err != nil {
-		logger.Error("error verifying presentation", zap.Error(err))
-		return nil, err
-	}
+	// adminScope is the scope required for managing export configurations
+	adminScope string
 
-	vp, err := s.credentials.ParsePresentation(req.Data)
-	if err != nil {
-		logger.Error("error parsing verifiable presentation", zap.Error(err))
-		return nil, err
-	}
+	// importScope is the scope required for importing data
+	importScope string
 
-	// separate data entries are wrapped in separate verifiable credentials;
-	// each one of them must be placed separately in the cache
-	var importedCredentials []string
-	for _, credential := range vp.Credentials() {
-		cred, ok := credential.(map[string]interface{})
-		if !ok {
-			logger.Warn("verifiable presentation contains unknown credential type")
-			return nil, errors.New(errors.BadRequest, "verifiable presentation contains unknown credential type")
-		}
+	// importNamespaces maps client IDs to the Cache namespaces
+	// which the clients may import into
+	importNamespaces map[string][]string
 
-		if cred["credentialSubject"] == nil {
-			logger.Error("verifiable credential doesn't contain subject")
-			return nil, errors.New(errors.BadRequest, "verifiable credential doesn't contain subject")
-		}
+	// importPolicies validate imported data
+	importPolicies ImportPolicies
 
-		subject, ok := cred["credentialSubject"].(map[string]interface{})
-		if !ok {
-			logger.Error("verifiable credential subject is not a map object")
-			return nil, errors.New(errors.BadRequest, "verifiable credential subject is not a map object")
-		}
+	// importSchemas enables the validation of imported
+	// credentials with their JSON schemas
+	importSchemas bool
 
-		subjectBytes, err := json.Marshal(subject)
-		if err != nil {
-			logger.Error("error encoding subject to json", zap.Error(err))
-			return nil, errors.New("error encoding subject to json")
-		}
+	// challengeRequired makes imports require a challenge issued by the service
+	challengeRequired bool
+	challengeDomain   string
+	challengeTTL      time.Duration
 
-		importID := uuid.NewString()
-		if err := s.cache.Set(ctx, importID, "", "", subjectBytes); err != nil {
-			logger.Error("error saving imported data to cache", zap.Error(err))
-			continue
-		}
-		importedCredentials = append(importedCredentials, importID)
+	// jobs tracks the export jobs running in background
+	jobs sync.WaitGroup
+}
+
+func New(storage Storage, policy Policy, cache Cache, cred Credentials, signer Signer, logger *zap.Logger, opts ...Option) *Service {
+	s := &Service{
+		storage:       storage,
+		policy:        policy,
+		cache:         cache,
+		credentials:   cred,
+		signer:        signer,
+		verifier:      signer,
+		hasher:        &credential.Hasher{},
+		logger:        logger,
+		policyWorkers: defaultPolicyWorkers,
+		jobTimeout:    defaultJobTimeout,
+		adminScope:    defaultAdminScope,
+		challengeTTL:  defaultChallengeTTL,
 	}
 
-	return &infohub.ImportResult{ImportIds: importedCredentials}, nil
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
-func (s *Service) Export(ctx context.Context, req *infohub.ExportRequest) (interface{}, error) {
+// Export returns data signed as Verifiable Presentation. If the data is not
+// available in the Cache, an export job is started in background and its
+// location is returned, so that clients can follow the job progress.
+func (s *Service) Export(ctx context.Context, req *infohub.ExportRequest) (*infohub.ExportResult, error) {
 	logger := s.logger.With(
 		zap.String("operation", "export"),
 		zap.String("exportName", req.ExportName),
@@ -128,84 +210,234 @@ func (s *Service) Export(ctx context.Context, req *infohub.ExportRequest) (inter
 		return nil, err
 	}
 
-	// get policy names needed for the export
-	var policyNames []string
-	for name := range exportCfg.Policies {
-		policyNames = append(policyNames, name)
+	if err := s.authorizeExport(ctx, exportCfg); err != nil {
+		logger.Error("export is not authorized", zap.Error(err))
+		return nil, err
+	}
+
+	params, err := resolveParameters(exportCfg, req.Query, req.Parameters)
+	if err != nil {
+		logger.Error("invalid export parameters", zap.Error(err))
+		return nil, err
 	}
 
+	format := exportFormat(exportCfg, req.Accept)
+
 	// get the results of all policies configured in the export
-	policyResults, err := s.getExportData(ctx, exportCfg.ExportName, policyNames)
+	policyResults, missing, err := s.getExportData(ctx, exportCfg.ExportName, params, exportCfg.PolicyNames())
 	if err != nil {
-		if errors.Is(errors.NotFound, err) {
-			if err := s.triggerExport(ctx, exportCfg); err != nil {
+		logger.Error("failed to get policy results from cache", zap.Error(err))
+		return nil, err
+	}
+
+	// only the policies without results in the Cache are evaluated again
+	if len(missing) > 0 {
+		if exportCfg.StaleWhileRevalidate {
+			res, err := s.staleExport(ctx, exportCfg, params, format, missing)
+			if err != nil {
 				logger.Error("error performing export", zap.Error(err))
 				return nil, err
 			}
-			return exportAccepted, nil
+			if res != nil {
+				return res, nil
+			}
 		}
-		logger.Error("failed to get policy results from cache", zap.Error(err))
+		job, err := s.triggerExport(ctx, exportCfg, params, missing)
+		if err != nil {
+			logger.Error("error performing export", zap.Error(err))
+			return nil, err
+		}
+		return exportAcceptedResult(job), nil
+	}
+
+	if err := s.validateExportData(ctx, exportCfg, policyResults); err != nil {
+		logger.Error("invalid export data", zap.Error(err))
 		return nil, err
 	}
 
-	var results []map[string]interface{}
-	for policy, result := range policyResults {
-		var res map[string]interface{}
-		if err := json.Unmarshal(result, &res); err != nil {
-			logger.Error("error decoding policy result as json", zap.Error(err), zap.String("policy", policy))
-			return nil, errors.New("error creating export", err)
+	// the credentials expire no earlier than the cache TTL after this time
+	createdAt := time.Now()
+	result, err := s.encodeExport(ctx, exportCfg, format, policyResults)
+	if err != nil {
+		logger.Error("error creating export", zap.String("format", format), zap.Error(err))
+		if errors.Is(errors.BadRequest, err) {
+			return nil, err
 		}
-		results = append(results, res)
+		return nil, errors.New("error creating export", err)
 	}
 
-	// create verifiable presentation
-	vp, err := s.signer.CreatePresentation(
-		ctx,
-		exportCfg.Issuer,
-		exportCfg.KeyNamespace,
-		exportCfg.Key,
-		results,
-	)
+	if exportCfg.StaleWhileRevalidate {
+		s.saveExportPresentation(ctx, exportCfg, params, format, result, createdAt)
+	}
+
+	return &infohub.ExportResult{Result: result, Status: "completed", ContentType: contentType(format)}, nil
+}
+
+// RefreshExport evaluates all policies of the export in background, so that
+// the export data in the Cache is renewed before it expires. Parameters of
+// the export get their default values, so exports with required parameters
+// without default cannot be refreshed.
+func (s *Service) RefreshExport(ctx context.Context, exportName string) error {
+	exportCfg, err := s.storage.ExportConfiguration(ctx, exportName)
 	if err != nil {
-		logger.Error("error creating verifiable presentation", zap.Error(err))
-		return nil, errors.New("error creating export", err)
+		return err
+	}
+
+	params, err := resolveParameters(exportCfg, nil, nil)
+	if err != nil {
+		return err
 	}
 
-	return vp, nil
+	_, err = s.triggerExport(ctx, exportCfg, params, exportCfg.PolicyNames())
+	return err
 }
 
 // getExportData retrieves from Cache the serialized policy execution results.
-// If result for a given policy name is not found in the Cache, a NotFound error
-// is returned.
-// If all results are found, they are returned as map, where the key is policyName
-// and the value is the JSON serialized bytes of the policy result.
+// Results which are found are returned as map, where the key is policyName
+// and the value is the JSON serialized bytes of the policy result. The names
+// of the policies whose results are not found in the Cache are returned as
+// missing.
 //
 // policyNames are formatted as 'group/policy/version' string, e.g. 'example/example/1.0'
-func (s *Service) getExportData(ctx context.Context, exportName string, policyNames []string) (map[string][]byte, error) {
-	results := make(map[string][]byte)
+func (s *Service) getExportData(ctx context.Context, exportName string, params *exportParameters, policyNames []string) (results map[string][]byte, missing []string, err error) {
+	results = make(map[string][]byte)
 	for _, policy := range policyNames {
-		res, err := s.cache.Get(ctx, exportCacheKey(exportName, policy), "", "")
+		res, err := s.cache.Get(ctx, exportCacheKey(exportName, policy, params), "", "")
 		if err != nil {
-			return nil, err
+			if errors.Is(errors.NotFound, err) {
+				missing = append(missing, policy)
+				continue
+			}
+			return nil, nil, err
 		}
 		results[policy] = res
 	}
 
-	return results, nil
+	return results, missing, nil
+}
+
+// triggerExport creates an export job and starts the evaluation of the given
+// export policies in background. Policies of the export which are not given
+// are already evaluated and are recorded as such in the job. The job is
+// returned as soon as it is persisted.
+func (s *Service) triggerExport(ctx context.Context, exportCfg *storage.ExportConfiguration, params *exportParameters, policies []string) (*storage.ExportJob, error) {
+	return s.startExportJob(ctx, exportCfg, params, uuid.NewString(), policies, "")
+}
+
+// startExportJob creates the export job with the given ID and runs it in
+// background. If a lease is given, the job holds it and releases it when
+// the job is finished or cannot be created.
+func (s *Service) startExportJob(ctx context.Context, exportCfg *storage.ExportConfiguration, params *exportParameters, jobID string, policies []string, lease string) (*storage.ExportJob, error) {
+	evaluate := make(map[string]bool, len(policies))
+	for _, policy := range policies {
+		evaluate[policy] = true
+	}
+
+	now := time.Now()
+	job := &storage.ExportJob{
+		ID:         jobID,
+		ExportName: exportCfg.ExportName,
+		Status:     storage.JobPending,
+		Parameters: params.values,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	for _, policy := range exportCfg.PolicyNames() {
+		status := storage.PolicyEvaluated
+		if evaluate[policy] {
+			status = storage.PolicyPending
+		}
+		job.Policies = append(job.Policies, &storage.ExportJobPolicy{
+			Policy: policy,
+			Status: status,
+		})
+	}
+
+	if err := s.storage.CreateExportJob(ctx, job); err != nil {
+		s.releaseLease(ctx, lease, jobID)
+		return nil, errors.New("error creating export job", err)
+	}
+
+	s.logger.Info("export triggered",
+		zap.String("exportName", exportCfg.ExportName),
+		zap.String("jobID", job.ID),
+		zap.Strings("policies", policies),
+	)
+
+	// the job must outlive the request which triggered it
+	jobCtx := context.WithoutCancel(ctx)
+	s.jobs.Add(1)
+	go func() {
+		defer s.jobs.Done()
+		s.runExportJob(jobCtx, exportCfg, params, job.ID, policies)
+		s.releaseLease(jobCtx, lease, job.ID)
+	}()
+
+	return job, nil
 }
 
-func (s *Service) triggerExport(ctx context.Context, exportCfg *storage.ExportConfiguration) error {
-	s.logger.Info("export triggered", zap.String("exportName", exportCfg.ExportName))
-	for policy, input := range exportCfg.Policies {
-		cacheKey := exportCacheKey(exportCfg.ExportName, policy)
-		_, err := s.policy.Evaluate(ctx, policy, input, cacheKey, exportCfg.CacheTTL)
+// runExportJob evaluates the given export policies and records the progress
+// of each evaluation in the export job.
+func (s *Service) runExportJob(ctx context.Context, exportCfg *storage.ExportConfiguration, params *exportParameters, jobID string, policies []string) {
+	logger := s.logger.With(
+		zap.String("exportName", exportCfg.ExportName),
+		zap.String("jobID", jobID),
+	)
+
+	if err := s.storage.UpdateExportJobStatus(ctx, jobID, storage.JobRunning); err != nil {
+		logger.Error("error updating export job status", zap.Error(err))
+	}
+
+	res := s.evaluatePolicies(ctx, exportCfg, params, policies, func(policy string, err error) {
+		status, errmsg := storage.PolicyEvaluated, ""
 		if err != nil {
-			return err
+			status, errmsg = storage.PolicyFailed, err.Error()
+		}
+		if err := s.storage.UpdateExportJobPolicy(ctx, jobID, policy, status, errmsg); err != nil {
+			logger.Error("error updating export job policy status", zap.String("policy", policy), zap.Error(err))
 		}
+	})
+
+	status := storage.JobCompleted
+	if err := res.Err(); err != nil {
+		logger.Error("error evaluating export policies", zap.Strings("failed", res.FailedPolicies()), zap.Error(err))
+		status = storage.JobFailed
+	}
+
+	if err := s.storage.UpdateExportJobStatus(ctx, jobID, status); err != nil {
+		logger.Error("error updating export job status", zap.Error(err))
+	}
+
+	logger.Info("export job finished", zap.String("status", status), zap.Strings("evaluated", res.Evaluated))
+}
+
+// Wait blocks until all export jobs running in background are finished.
+func (s *Service) Wait() {
+	s.jobs.Wait()
+}
+
+// exportCacheKey returns the Cache key of a policy result of the export.
+// Results of exports requested with different parameters have different keys.
+func exportCacheKey(exportName string, policyName string, params *exportParameters) string {
+	if params.key == "" {
+		return exportName + ":" + policyName
 	}
-	return nil
+	return exportName + ":" + policyName + ":" + params.key
 }
 
-func exportCacheKey(exportName string, policyName string) string {
-	return exportName + ":" + policyName
+func exportJobLocation(exportName, jobID string) string {
+	return "/v1/export/" + exportName + "/jobs/" + jobID
+}
+
+func exportAcceptedResult(job *storage.ExportJob) *infohub.ExportResult {
+	location := exportJobLocation(job.ExportName, job.ID)
+	return &infohub.ExportResult{
+		Result: map[string]interface{}{
+			"result":   exportAccepted,
+			"jobId":    job.ID,
+			"location": location,
+		},
+		Status:   "accepted",
+		Location: &location,
+	}
 }
@@ -2,14 +2,19 @@ package infohub_test
 
 import (
 	"context"
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
 	goasigner "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/credential"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
 	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
@@ -30,9 +35,10 @@ func TestService_Export(t *testing.T) {
 		cred    *infohubfakes.FakeCredentials
 		signer  *infohubfakes.FakeSigner
 
-		res     interface{}
-		errkind errors.Kind
-		errtext string
+		res       *goasigner.ExportResult
+		jobStatus string
+		errkind   errors.Kind
+		errtext   string
 	}{
 		{
 			name: "export configuration not found",
@@ -99,8 +105,30 @@ func TestService_Export(t *testing.T) {
 					return nil, errors.New("error evaluation policy")
 				},
 			},
+			jobStatus: "failed",
+		},
+		{
+			name: "export data not found and creating export job fails",
+			req:  &goasigner.ExportRequest{ExportName: "testexport"},
+			storage: &infohubfakes.FakeStorage{
+				ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+					return &storage.ExportConfiguration{
+						ExportName: "testexport",
+						Contexts:   []string{"https://www.w3.org/2018/credentials/examples/v1"},
+						Policies:   map[string]interface{}{"test/test/1.0": map[string]interface{}{"hello": "test world"}},
+					}, nil
+				},
+				CreateExportJobStub: func(ctx context.Context, job *storage.ExportJob) error {
+					return errors.New("some error")
+				},
+			},
+			cache: &infohubfakes.FakeCache{
+				GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+					return nil, errors.New(errors.NotFound, "no data")
+				},
+			},
 			errkind: errors.Unknown,
-			errtext: "error evaluation policy",
+			errtext: "error creating export job",
 		},
 		{
 			name: "export triggering successfully",
@@ -124,7 +152,7 @@ func TestService_Export(t *testing.T) {
 					return []byte(`{"allow":"true"}`), nil
 				},
 			},
-			res: map[string]interface{}{"result": "export request is accepted"},
+			jobStatus: "completed",
 		},
 		{
 			name: "export triggering successfully with TTL provided in export configuration",
@@ -149,7 +177,7 @@ func TestService_Export(t *testing.T) {
 					return []byte(`{"allow":"true"}`), nil
 				},
 			},
-			res: map[string]interface{}{"result": "export request is accepted"},
+			jobStatus: "completed",
 		},
 		{
 			name: "export data is not valid json",
@@ -188,8 +216,9 @@ func TestService_Export(t *testing.T) {
 					return []byte(`{"allow":true}`), nil
 				},
 			},
+			cred: newCredentialsFake(),
 			signer: &infohubfakes.FakeSigner{
-				CreatePresentationStub: func(ctx context.Context, issuer string, namespace string, key string, data []map[string]interface{}) (map[string]interface{}, error) {
+				PresentationProofStub: func(ctx context.Context, issuer string, namespace string, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
 					return nil, errors.New("some error")
 				},
 			},
@@ -213,12 +242,16 @@ func TestService_Export(t *testing.T) {
 					return []byte(`{"allow":true}`), nil
 				},
 			},
+			cred: newCredentialsFake(),
 			signer: &infohubfakes.FakeSigner{
-				CreatePresentationStub: func(ctx context.Context, issuer string, namespace string, key string, data []map[string]interface{}) (map[string]interface{}, error) {
+				PresentationProofStub: func(ctx context.Context, issuer string, namespace string, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
 					return map[string]interface{}{"id": "did:web:example.com"}, nil
 				},
 			},
-			res: map[string]interface{}{"id": "did:web:example.com"},
+			res: &goasigner.ExportResult{
+				Result: map[string]interface{}{"id": "did:web:example.com"},
+				Status: "completed",
+			},
 		},
 	}
 
@@ -226,6 +259,7 @@ func TestService_Export(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			svc := infohub.New(test.storage, test.policy, test.cache, test.cred, test.signer, zap.NewNop())
 			res, err := svc.Export(context.Background(), test.req)
+			svc.Wait()
 			if err != nil {
 				assert.Nil(t, res)
 				assert.NotEmpty(t, test.errtext)
@@ -233,6 +267,23 @@ func TestService_Export(t *testing.T) {
 				assert.True(t, ok)
 				assert.Equal(t, test.errkind, e.Kind)
 				assert.Contains(t, e.Error(), test.errtext)
+			} else if test.jobStatus != "" {
+				assert.Empty(t, test.errtext)
+				assert.Equal(t, "accepted", res.Status)
+				assert.Equal(t, 1, test.storage.CreateExportJobCallCount())
+				_, job := test.storage.CreateExportJobArgsForCall(0)
+				assert.Equal(t, "/v1/export/testexport/jobs/"+job.ID, *res.Location)
+				assert.Equal(t, map[string]interface{}{
+					"result":   "export request is accepted",
+					"jobId":    job.ID,
+					"location": *res.Location,
+				}, res.Result)
+
+				// the final job status is set after all policies are evaluated
+				calls := test.storage.UpdateExportJobStatusCallCount()
+				_, id, status := test.storage.UpdateExportJobStatusArgsForCall(calls - 1)
+				assert.Equal(t, job.ID, id)
+				assert.Equal(t, test.jobStatus, status)
 			} else {
 				assert.Empty(t, test.errtext)
 				assert.NotNil(t, res)
@@ -241,3 +292,345 @@ func TestService_Export(t *testing.T) {
 		})
 	}
 }
+
+func TestService_Export_EvaluatesOnlyMissingPolicies(t *testing.T) {
+	storageFake := &infohubfakes.FakeStorage{
+		ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+			return &storage.ExportConfiguration{
+				ExportName: "testexport",
+				Policies: map[string]interface{}{
+					"test/cached/1.0":  map[string]interface{}{"hello": "cached"},
+					"test/missing/1.0": map[string]interface{}{"hello": "missing"},
+				},
+			}, nil
+		},
+	}
+	cacheFake := &infohubfakes.FakeCache{
+		GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+			if key == "testexport:test/cached/1.0" {
+				return []byte(`{"allow":true}`), nil
+			}
+			return nil, errors.New(errors.NotFound, "no data")
+		},
+	}
+	policyFake := &infohubfakes.FakePolicy{}
+
+	svc := infohub.New(storageFake, policyFake, cacheFake, nil, nil, zap.NewNop())
+	res, err := svc.Export(context.Background(), &goasigner.ExportRequest{ExportName: "testexport"})
+	svc.Wait()
+	assert.NoError(t, err)
+	assert.Equal(t, "accepted", res.Status)
+
+	assert.Equal(t, 1, policyFake.EvaluateCallCount())
+	_, policy, _, cacheKey, _ := policyFake.EvaluateArgsForCall(0)
+	assert.Equal(t, "test/missing/1.0", policy)
+	assert.Equal(t, "testexport:test/missing/1.0", cacheKey)
+
+	_, job := storageFake.CreateExportJobArgsForCall(0)
+	statuses := map[string]string{}
+	for _, p := range job.Policies {
+		statuses[p.Policy] = p.Status
+	}
+	assert.Equal(t, map[string]string{
+		"test/cached/1.0":  storage.PolicyEvaluated,
+		"test/missing/1.0": storage.PolicyPending,
+	}, statuses)
+}
+
+func TestService_Export_ConcurrentPolicyEvaluation(t *testing.T) {
+	policies := map[string]interface{}{}
+	for _, name := range []string{"test/a/1.0", "test/b/1.0", "test/c/1.0", "test/d/1.0", "test/e/1.0"} {
+		policies[name] = map[string]interface{}{}
+	}
+
+	storageFake := &infohubfakes.FakeStorage{
+		ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+			return &storage.ExportConfiguration{ExportName: "testexport", Policies: policies}, nil
+		},
+	}
+	cacheFake := &infohubfakes.FakeCache{
+		GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+			return nil, errors.New(errors.NotFound, "no data")
+		},
+	}
+
+	var running, maxRunning int32
+	policyFake := &infohubfakes.FakePolicy{
+		EvaluateStub: func(ctx context.Context, policy string, input interface{}, cachekey string, ttl *int) ([]byte, error) {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				m := atomic.LoadInt32(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt32(&maxRunning, m, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			if policy == "test/c/1.0" || policy == "test/e/1.0" {
+				return nil, errors.New("policy failed")
+			}
+			return []byte(`{"allow":true}`), nil
+		},
+	}
+
+	svc := infohub.New(storageFake, policyFake, cacheFake, nil, nil, zap.NewNop(), infohub.WithPolicyWorkers(2))
+	_, err := svc.Export(context.Background(), &goasigner.ExportRequest{ExportName: "testexport"})
+	svc.Wait()
+	assert.NoError(t, err)
+
+	// all policies are evaluated regardless of failures
+	assert.Equal(t, 5, policyFake.EvaluateCallCount())
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(2))
+
+	failed := map[string]string{}
+	for i := 0; i < storageFake.UpdateExportJobPolicyCallCount(); i++ {
+		_, _, policy, status, errmsg := storageFake.UpdateExportJobPolicyArgsForCall(i)
+		if status == storage.PolicyFailed {
+			failed[policy] = errmsg
+		}
+	}
+	assert.Len(t, failed, 2)
+	assert.Contains(t, failed["test/c/1.0"], "policy failed")
+	assert.Contains(t, failed["test/e/1.0"], "policy failed")
+
+	calls := storageFake.UpdateExportJobStatusCallCount()
+	_, _, status := storageFake.UpdateExportJobStatusArgsForCall(calls - 1)
+	assert.Equal(t, storage.JobFailed, status)
+}
+
+func TestService_Export_CredentialLayout(t *testing.T) {
+	policies := map[string]interface{}{
+		"test/b/1.0": map[string]interface{}{},
+		"test/a/1.0": map[string]interface{}{},
+		"test/c/1.0": map[string]interface{}{},
+	}
+	results := map[string]string{
+		"testexport:test/a/1.0": `{"name":"a"}`,
+		"testexport:test/b/1.0": `{"name":"b"}`,
+		"testexport:test/c/1.0": `{"name":"c"}`,
+	}
+
+	tests := []struct {
+		name   string
+		layout string
+		order  []string
+		cache  map[string]string
+
+		data    []map[string]interface{}
+		errtext string
+	}{
+		{
+			name:  "credential per policy ordered by policy name",
+			cache: results,
+			data: []map[string]interface{}{
+				{"name": "a", "infohub:policy": "test/a/1.0"},
+				{"name": "b", "infohub:policy": "test/b/1.0"},
+				{"name": "c", "infohub:policy": "test/c/1.0"},
+			},
+		},
+		{
+			name:  "credential per policy in configured policy order",
+			order: []string{"test/c/1.0", "test/a/1.0", "test/b/1.0"},
+			cache: results,
+			data: []map[string]interface{}{
+				{"name": "c", "infohub:policy": "test/c/1.0"},
+				{"name": "a", "infohub:policy": "test/a/1.0"},
+				{"name": "b", "infohub:policy": "test/b/1.0"},
+			},
+		},
+		{
+			name: "policy result with policy field",
+			cache: map[string]string{
+				"testexport:test/a/1.0": `{"name":"a"}`,
+				"testexport:test/b/1.0": `{"policy":"b"}`,
+				"testexport:test/c/1.0": `{"name":"c"}`,
+			},
+			data: []map[string]interface{}{
+				{"name": "a", "infohub:policy": "test/a/1.0"},
+				{"policy": "b", "infohub:policy": "test/b/1.0"},
+				{"name": "c", "infohub:policy": "test/c/1.0"},
+			},
+		},
+		{
+			name:   "merged credential subject",
+			layout: storage.LayoutMerged,
+			cache:  results,
+			data: []map[string]interface{}{
+				{
+					"test/a/1.0": map[string]interface{}{"name": "a"},
+					"test/b/1.0": map[string]interface{}{"name": "b"},
+					"test/c/1.0": map[string]interface{}{"name": "c"},
+				},
+			},
+		},
+		{
+			name: "policy result contains reserved label field",
+			cache: map[string]string{
+				"testexport:test/a/1.0": `{"name":"a"}`,
+				"testexport:test/b/1.0": `{"infohub:policy":"b"}`,
+				"testexport:test/c/1.0": `{"name":"c"}`,
+			},
+			errtext: `contains reserved field "infohub:policy"`,
+		},
+		{
+			name:    "unknown layout",
+			layout:  "unknown",
+			cache:   results,
+			errtext: `unknown export layout "unknown"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			storageFake := &infohubfakes.FakeStorage{
+				ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+					return &storage.ExportConfiguration{ExportName: "testexport", Policies: policies, Layout: test.layout, PolicyOrder: test.order}, nil
+				},
+			}
+			cacheFake := &infohubfakes.FakeCache{
+				GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+					return []byte(test.cache[key]), nil
+				},
+			}
+			signerFake := &infohubfakes.FakeSigner{
+				PresentationProofStub: func(ctx context.Context, issuer string, namespace string, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
+					return map[string]interface{}{"id": "did:web:example.com"}, nil
+				},
+			}
+
+			svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop())
+			res, err := svc.Export(context.Background(), &goasigner.ExportRequest{ExportName: "testexport"})
+			if test.errtext != "" {
+				assert.Nil(t, res)
+				assert.ErrorContains(t, err, test.errtext)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, signerFake.PresentationProofCallCount())
+			_, _, _, _, vp := signerFake.PresentationProofArgsForCall(0)
+			assert.Equal(t, test.data, credentialSubjects(vp))
+		})
+	}
+}
+
+func TestService_Export_CredentialFormat(t *testing.T) {
+	storageFake := &infohubfakes.FakeStorage{
+		ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+			return &storage.ExportConfiguration{
+				ExportName:      "testexport",
+				Contexts:        []string{"https://www.w3.org/2018/credentials/examples/v1"},
+				Policies:        map[string]interface{}{"test/test/1.0": map[string]interface{}{}},
+				CacheTTL:        ptr.Int(3600),
+				Issuer:          "did:web:issuer.example.com",
+				KeyNamespace:    "transit",
+				Key:             "key1",
+				CredentialTypes: []string{"ComplianceCredential"},
+				CredentialSchema: &storage.CredentialSchema{
+					ID:   "https://example.com/schemas/compliance.json",
+					Type: "JsonSchema",
+				},
+			}, nil
+		},
+	}
+	cacheFake := &infohubfakes.FakeCache{
+		GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+			return []byte(`{"id":"did:web:participant.example.com","allow":true}`), nil
+		},
+	}
+	signerFake := &infohubfakes.FakeSigner{
+		PresentationProofStub: func(ctx context.Context, issuer string, namespace string, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
+			return map[string]interface{}{"id": "did:web:example.com"}, nil
+		},
+	}
+
+	svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop())
+	_, err := svc.Export(context.Background(), &goasigner.ExportRequest{ExportName: "testexport"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, 1, signerFake.PresentationProofCallCount())
+	_, issuer, namespace, key, vp := signerFake.PresentationProofArgsForCall(0)
+	assert.Equal(t, "did:web:issuer.example.com", issuer)
+	assert.Equal(t, "transit", namespace)
+	assert.Equal(t, "key1", key)
+	assert.Equal(t, "did:web:issuer.example.com", vp.Holder)
+	assert.Contains(t, vp.Context, "https://www.w3.org/2018/credentials/examples/v1")
+
+	assert.Len(t, vp.Credentials(), 1)
+	vc := vp.Credentials()[0].(*verifiable.Credential)
+	assert.Equal(t, []string{
+		"https://www.w3.org/2018/credentials/v1",
+		"https://w3id.org/security/suites/jws-2020/v1",
+		"https://schema.org",
+		"https://www.w3.org/2018/credentials/examples/v1",
+	}, vc.Context)
+	assert.Equal(t, []string{"VerifiableCredential", "ComplianceCredential"}, vc.Types)
+	assert.Equal(t, "did:web:issuer.example.com", vc.Issuer.ID)
+	assert.Equal(t, []verifiable.TypedID{{ID: "https://example.com/schemas/compliance.json", Type: "JsonSchema"}}, vc.Schemas)
+	assert.NotNil(t, vc.Expired)
+	assert.WithinDuration(t, vc.Issued.Add(time.Hour), vc.Expired.Time, time.Second)
+
+	subject := vc.Subject.(verifiable.Subject)
+	assert.Equal(t, "did:web:participant.example.com", subject.ID)
+	assert.Equal(t, verifiable.CustomFields{"allow": true, "infohub:policy": "test/test/1.0"}, subject.CustomFields)
+}
+
+// newCredentialsFake returns a fake which creates verifiable
+// credentials and presentations with the real implementation.
+func newCredentialsFake() *infohubfakes.FakeCredentials {
+	creds := credential.New("https://example.com", http.DefaultClient)
+	return &infohubfakes.FakeCredentials{
+		NewCredentialStub:   creds.NewCredential,
+		NewPresentationStub: creds.NewPresentation,
+	}
+}
+
+// credentialSubjects returns the subject fields of the credentials in the presentation.
+func credentialSubjects(vp *verifiable.Presentation) []map[string]interface{} {
+	var subjects []map[string]interface{}
+	for _, c := range vp.Credentials() {
+		subject := c.(*verifiable.Credential).Subject.(verifiable.Subject)
+		fields := map[string]interface{}(subject.CustomFields)
+		if subject.ID != "" {
+			fields["id"] = subject.ID
+		}
+		subjects = append(subjects, fields)
+	}
+	return subjects
+}
+
+func TestService_RefreshExport(t *testing.T) {
+	t.Run("export configuration not found", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+				return nil, errors.New(errors.NotFound, "export configuration not found")
+			},
+		}
+
+		svc := infohub.New(storageFake, nil, nil, nil, nil, zap.NewNop())
+		err := svc.RefreshExport(context.Background(), "testexport")
+		assert.True(t, errors.Is(errors.NotFound, err))
+	})
+
+	t.Run("all export policies are evaluated", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+				return &storage.ExportConfiguration{
+					ExportName: "testexport",
+					Policies: map[string]interface{}{
+						"test/a/1.0": map[string]interface{}{},
+						"test/b/1.0": map[string]interface{}{},
+					},
+				}, nil
+			},
+		}
+		policyFake := &infohubfakes.FakePolicy{}
+
+		svc := infohub.New(storageFake, policyFake, nil, nil, nil, zap.NewNop())
+		err := svc.RefreshExport(context.Background(), "testexport")
+		svc.Wait()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, storageFake.CreateExportJobCallCount())
+		assert.Equal(t, 2, policyFake.EvaluateCallCount())
+	})
+}
@@ -0,0 +1,159 @@
+package infohub
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	"github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+// saveExportPresentation keeps the signed presentation of an export, so
+// that it can be served while the export data is evaluated again. Failures
+// are only logged, as they must not fail the export itself.
+func (s *Service) saveExportPresentation(ctx context.Context, exportCfg *storage.ExportConfiguration, params *exportParameters, format string, vp interface{}, createdAt time.Time) {
+	vpJSON, err := json.Marshal(vp)
+	if err != nil {
+		s.logger.Error("error encoding export presentation", zap.String("exportName", exportCfg.ExportName), zap.Error(err))
+		return
+	}
+
+	// credentials of exports with cache TTL expire together with the export data
+	var expiresAt *time.Time
+	if exportCfg.CacheTTL != nil {
+		exp := createdAt.Add(time.Duration(*exportCfg.CacheTTL) * time.Second)
+		expiresAt = &exp
+	}
+
+	if err := s.storage.SaveExportPresentation(ctx, exportPresentationKey(exportCfg.ExportName, params, format), vpJSON, expiresAt); err != nil {
+		s.logger.Error("error saving export presentation", zap.String("exportName", exportCfg.ExportName), zap.Error(err))
+	}
+}
+
+// staleExport returns the last signed presentation of the export together
+// with its age and the location of the job which evaluates the given export
+// policies again. If there is no such presentation or its credentials have
+// expired, nil is returned and no job is started.
+func (s *Service) staleExport(ctx context.Context, exportCfg *storage.ExportConfiguration, params *exportParameters, format string, policies []string) (*infohub.ExportResult, error) {
+	logger := s.logger.With(zap.String("exportName", exportCfg.ExportName))
+
+	stored, err := s.storage.ExportPresentation(ctx, exportPresentationKey(exportCfg.ExportName, params, format))
+	if err != nil {
+		if !errors.Is(errors.NotFound, err) {
+			logger.Error("error getting last export presentation", zap.Error(err))
+		}
+		return nil, nil
+	}
+
+	if stored.ExpiresAt != nil && !time.Now().Before(*stored.ExpiresAt) {
+		logger.Debug("last export presentation has expired", zap.Time("expiresAt", *stored.ExpiresAt))
+		return nil, nil
+	}
+
+	var vp interface{}
+	if err := json.Unmarshal(stored.Presentation, &vp); err != nil {
+		logger.Error("error decoding last export presentation", zap.Error(err))
+		return nil, nil
+	}
+
+	job, err := s.revalidateExport(ctx, exportCfg, params, policies)
+	if err != nil {
+		return nil, err
+	}
+
+	stale := true
+	age := int(time.Since(stored.CreatedAt).Seconds())
+	res := &infohub.ExportResult{
+		Result:      vp,
+		Status:      "completed",
+		Stale:       &stale,
+		Age:         &age,
+		ContentType: contentType(format),
+	}
+	if job != nil {
+		location := exportJobLocation(job.ExportName, job.ID)
+		res.Location = &location
+		logger = logger.With(zap.String("jobID", job.ID))
+	}
+	logger.Info("serving stale export presentation", zap.Int("age", age))
+
+	return res, nil
+}
+
+// revalidateExport starts the evaluation of the given export policies unless
+// the export data for the same parameters is already evaluated by another
+// job, in which case that job is returned. The job which evaluates the export
+// data holds the revalidation lease of the export until it is finished, so
+// requests served with stale presentations don't start a job each.
+// If the other job has just finished, nil is returned.
+func (s *Service) revalidateExport(ctx context.Context, exportCfg *storage.ExportConfiguration, params *exportParameters, policies []string) (*storage.ExportJob, error) {
+	lease := revalidationLease(exportCfg.ExportName, params)
+	jobID := uuid.NewString()
+
+	acquired, err := s.storage.AcquireLease(ctx, lease, jobID, s.jobTimeout)
+	if err != nil {
+		return nil, errors.New("error acquiring export revalidation lease", err)
+	}
+	if acquired {
+		return s.startExportJob(ctx, exportCfg, params, jobID, policies, lease)
+	}
+
+	holder, err := s.storage.LeaseHolder(ctx, lease)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return nil, nil
+		}
+		return nil, errors.New("error getting export revalidation lease", err)
+	}
+
+	job, err := s.storage.ExportJob(ctx, exportCfg.ExportName, holder)
+	if err != nil {
+		if errors.Is(errors.NotFound, err) {
+			return nil, nil
+		}
+		return nil, errors.New("error getting export job", err)
+	}
+
+	return job, nil
+}
+
+// releaseLease releases the given lease of the export job, if there is one.
+// Failures are only logged, as the lease expires with the job timeout anyway.
+func (s *Service) releaseLease(ctx context.Context, lease, jobID string) {
+	if lease == "" {
+		return
+	}
+	if err := s.storage.ReleaseLease(ctx, lease, jobID); err != nil {
+		s.logger.Error("error releasing export revalidation lease", zap.String("lease", lease), zap.String("jobID", jobID), zap.Error(err))
+	}
+}
+
+// revalidationLease returns the name of the lease held by the job which
+// evaluates the data of the export requested with the given parameters.
+// The export data doesn't depend on the format, so neither does the lease.
+func revalidationLease(exportName string, params *exportParameters) string {
+	key := "revalidate:" + exportName
+	if params.key != "" {
+		key += ":" + params.key
+	}
+	return key
+}
+
+// exportPresentationKey returns the key of the last signed presentation of
+// the export requested with the given parameters and format. Presentations
+// in the default JSON-LD format keep the key without format.
+func exportPresentationKey(exportName string, params *exportParameters, format string) string {
+	key := exportName
+	if params.key != "" {
+		key += ":" + params.key
+	}
+	if format != storage.FormatJSONLD {
+		key += "#" + format
+	}
+	return key
+}
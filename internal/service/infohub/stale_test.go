@@ -0,0 +1,253 @@
+package infohub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/aries-framework-go/pkg/doc/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+	ptr "github.com/eclipse-xfsc/microservice-core-go/pkg/ptr"
+	goainfohub "github.com/eclipse-xfsc/trusted-info-hub/gen/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/service/infohub/infohubfakes"
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestService_Export_StaleWhileRevalidate(t *testing.T) {
+	exportCfg := func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+		return &storage.ExportConfiguration{
+			ExportName:           "testexport",
+			Policies:             map[string]interface{}{"test/test/1.0": map[string]interface{}{}},
+			StaleWhileRevalidate: true,
+		}, nil
+	}
+	notFound := func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+		return nil, errors.New(errors.NotFound, "no data")
+	}
+
+	t.Run("stale presentation is returned while export data is evaluated", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: exportCfg,
+			ExportPresentationStub: func(ctx context.Context, key string) (*storage.ExportPresentation, error) {
+				return &storage.ExportPresentation{
+					Key:          key,
+					Presentation: []byte(`{"id":"did:web:example.com"}`),
+					CreatedAt:    time.Now().Add(-2 * time.Minute),
+				}, nil
+			},
+			AcquireLeaseStub: func(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+				return true, nil
+			},
+		}
+		policyFake := &infohubfakes.FakePolicy{}
+
+		svc := infohub.New(storageFake, policyFake, &infohubfakes.FakeCache{GetStub: notFound}, nil, nil, zap.NewNop())
+		res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		svc.Wait()
+		assert.NoError(t, err)
+
+		assert.Equal(t, "completed", res.Status)
+		assert.Equal(t, map[string]interface{}{"id": "did:web:example.com"}, res.Result)
+		assert.True(t, *res.Stale)
+		assert.InDelta(t, 120, *res.Age, 5)
+
+		_, job := storageFake.CreateExportJobArgsForCall(0)
+		assert.Equal(t, "/v1/export/testexport/jobs/"+job.ID, *res.Location)
+		assert.Equal(t, 1, policyFake.EvaluateCallCount())
+
+		_, key := storageFake.ExportPresentationArgsForCall(0)
+		assert.Equal(t, "testexport", key)
+
+		require.Equal(t, 1, storageFake.AcquireLeaseCallCount())
+		_, lease, holder, ttl := storageFake.AcquireLeaseArgsForCall(0)
+		assert.Equal(t, "revalidate:testexport", lease)
+		assert.Equal(t, job.ID, holder)
+		assert.Equal(t, 10*time.Minute, ttl)
+
+		require.Equal(t, 1, storageFake.ReleaseLeaseCallCount())
+		_, lease, holder = storageFake.ReleaseLeaseArgsForCall(0)
+		assert.Equal(t, "revalidate:testexport", lease)
+		assert.Equal(t, job.ID, holder)
+	})
+
+	t.Run("running revalidation job is returned with stale presentation", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: exportCfg,
+			ExportPresentationStub: func(ctx context.Context, key string) (*storage.ExportPresentation, error) {
+				return &storage.ExportPresentation{
+					Key:          key,
+					Presentation: []byte(`{"id":"did:web:example.com"}`),
+					CreatedAt:    time.Now().Add(-2 * time.Minute),
+				}, nil
+			},
+			AcquireLeaseStub: func(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+				return false, nil
+			},
+			LeaseHolderStub: func(ctx context.Context, name string) (string, error) {
+				return "running-job", nil
+			},
+			ExportJobStub: func(ctx context.Context, exportName, id string) (*storage.ExportJob, error) {
+				return &storage.ExportJob{ID: id, ExportName: exportName, Status: storage.JobRunning}, nil
+			},
+		}
+		policyFake := &infohubfakes.FakePolicy{}
+
+		svc := infohub.New(storageFake, policyFake, &infohubfakes.FakeCache{GetStub: notFound}, nil, nil, zap.NewNop())
+		res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		svc.Wait()
+		assert.NoError(t, err)
+
+		assert.Equal(t, "completed", res.Status)
+		assert.True(t, *res.Stale)
+		assert.Equal(t, "/v1/export/testexport/jobs/running-job", *res.Location)
+
+		assert.Equal(t, 0, storageFake.CreateExportJobCallCount())
+		assert.Equal(t, 0, storageFake.ReleaseLeaseCallCount())
+		assert.Equal(t, 0, policyFake.EvaluateCallCount())
+	})
+
+	t.Run("stale presentation is returned without location when revalidation has just finished", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: exportCfg,
+			ExportPresentationStub: func(ctx context.Context, key string) (*storage.ExportPresentation, error) {
+				return &storage.ExportPresentation{
+					Key:          key,
+					Presentation: []byte(`{"id":"did:web:example.com"}`),
+					CreatedAt:    time.Now().Add(-2 * time.Minute),
+				}, nil
+			},
+			LeaseHolderStub: func(ctx context.Context, name string) (string, error) {
+				return "", errors.New(errors.NotFound, "lease not found")
+			},
+		}
+
+		svc := infohub.New(storageFake, &infohubfakes.FakePolicy{}, &infohubfakes.FakeCache{GetStub: notFound}, nil, nil, zap.NewNop())
+		res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		svc.Wait()
+		assert.NoError(t, err)
+
+		assert.True(t, *res.Stale)
+		assert.Nil(t, res.Location)
+		assert.Equal(t, 0, storageFake.CreateExportJobCallCount())
+	})
+
+	t.Run("error acquiring revalidation lease", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: exportCfg,
+			ExportPresentationStub: func(ctx context.Context, key string) (*storage.ExportPresentation, error) {
+				return &storage.ExportPresentation{
+					Key:          key,
+					Presentation: []byte(`{"id":"did:web:example.com"}`),
+					CreatedAt:    time.Now().Add(-2 * time.Minute),
+				}, nil
+			},
+			AcquireLeaseStub: func(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+				return false, errors.New("some error")
+			},
+		}
+
+		svc := infohub.New(storageFake, &infohubfakes.FakePolicy{}, &infohubfakes.FakeCache{GetStub: notFound}, nil, nil, zap.NewNop())
+		res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		assert.Nil(t, res)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "error acquiring export revalidation lease")
+		assert.Equal(t, 0, storageFake.CreateExportJobCallCount())
+	})
+
+	t.Run("expired stale presentation is not returned", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Minute)
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: exportCfg,
+			ExportPresentationStub: func(ctx context.Context, key string) (*storage.ExportPresentation, error) {
+				return &storage.ExportPresentation{
+					Key:          key,
+					Presentation: []byte(`{"id":"did:web:example.com"}`),
+					CreatedAt:    time.Now().Add(-2 * time.Hour),
+					ExpiresAt:    &expiresAt,
+				}, nil
+			},
+		}
+
+		svc := infohub.New(storageFake, &infohubfakes.FakePolicy{}, &infohubfakes.FakeCache{GetStub: notFound}, nil, nil, zap.NewNop())
+		res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		svc.Wait()
+		assert.NoError(t, err)
+		assert.Equal(t, "accepted", res.Status)
+		assert.Nil(t, res.Stale)
+	})
+
+	t.Run("export request is accepted when there is no stale presentation", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: exportCfg,
+			ExportPresentationStub: func(ctx context.Context, key string) (*storage.ExportPresentation, error) {
+				return nil, errors.New(errors.NotFound, "export presentation not found")
+			},
+		}
+
+		svc := infohub.New(storageFake, &infohubfakes.FakePolicy{}, &infohubfakes.FakeCache{GetStub: notFound}, nil, nil, zap.NewNop())
+		res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		svc.Wait()
+		assert.NoError(t, err)
+		assert.Equal(t, "accepted", res.Status)
+		assert.Nil(t, res.Stale)
+	})
+
+	t.Run("signed presentation is saved", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{ExportConfigurationStub: exportCfg}
+		cacheFake := &infohubfakes.FakeCache{
+			GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+				return []byte(`{"allow":true}`), nil
+			},
+		}
+		signerFake := &infohubfakes.FakeSigner{
+			PresentationProofStub: func(ctx context.Context, issuer string, namespace string, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
+				return map[string]interface{}{"id": "did:web:example.com"}, nil
+			},
+		}
+
+		svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop())
+		res, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		assert.NoError(t, err)
+		assert.Nil(t, res.Stale)
+
+		assert.Equal(t, 1, storageFake.SaveExportPresentationCallCount())
+		_, key, vp, expiresAt := storageFake.SaveExportPresentationArgsForCall(0)
+		assert.Equal(t, "testexport", key)
+		assert.JSONEq(t, `{"id":"did:web:example.com"}`, string(vp))
+		assert.Nil(t, expiresAt)
+	})
+
+	t.Run("signed presentation is saved with the expiry of its credentials", func(t *testing.T) {
+		storageFake := &infohubfakes.FakeStorage{
+			ExportConfigurationStub: func(ctx context.Context, s string) (*storage.ExportConfiguration, error) {
+				cfg, err := exportCfg(ctx, s)
+				cfg.CacheTTL = ptr.Int(3600)
+				return cfg, err
+			},
+		}
+		cacheFake := &infohubfakes.FakeCache{
+			GetStub: func(ctx context.Context, key string, namespace string, scope string) ([]byte, error) {
+				return []byte(`{"allow":true}`), nil
+			},
+		}
+		signerFake := &infohubfakes.FakeSigner{
+			PresentationProofStub: func(ctx context.Context, issuer string, namespace string, key string, vp *verifiable.Presentation) (map[string]interface{}, error) {
+				return map[string]interface{}{"id": "did:web:example.com"}, nil
+			},
+		}
+
+		svc := infohub.New(storageFake, nil, cacheFake, newCredentialsFake(), signerFake, zap.NewNop())
+		_, err := svc.Export(context.Background(), &goainfohub.ExportRequest{ExportName: "testexport"})
+		assert.NoError(t, err)
+
+		assert.Equal(t, 1, storageFake.SaveExportPresentationCallCount())
+		_, _, _, expiresAt := storageFake.SaveExportPresentationArgsForCall(0)
+		require.NotNil(t, expiresAt)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), *expiresAt, 5*time.Second)
+	})
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	goahttp "goa.design/goa/v3/http"
+)
+
+// ResponseEncoder returns the goa response encoder, except for responses
+// with a JWT based media type, like "application/vp+jwt" or
+// "application/vc+sd-jwt", whose string bodies are written as they are.
+func ResponseEncoder(ctx context.Context, w http.ResponseWriter) goahttp.Encoder {
+	return &responseEncoder{w: w, enc: goahttp.ResponseEncoder(ctx, w)}
+}
+
+type responseEncoder struct {
+	w   http.ResponseWriter
+	enc goahttp.Encoder
+}
+
+// Encode checks the content type when the body is written, as the
+// generated handlers set the response headers after creating the encoder.
+func (e *responseEncoder) Encode(v interface{}) error {
+	if s, ok := v.(string); ok && isJWTMediaType(e.w.Header().Get("Content-Type")) {
+		_, err := io.WriteString(e.w, s)
+		return err
+	}
+	return e.enc.Encode(v)
+}
+
+func isJWTMediaType(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return strings.HasSuffix(mt, "+jwt") || strings.HasSuffix(mt, "+sd-jwt")
+}
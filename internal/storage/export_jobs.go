@@ -0,0 +1,146 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const (
+	exportJobsCollection = "exportJobs"
+
+	// exportJobTTL specifies how long finished and unfinished
+	// export jobs are kept in the database.
+	exportJobTTL = 7 * 24 * time.Hour
+)
+
+// Export job statuses.
+const (
+	JobPending   = "pending"
+	JobRunning   = "running"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+)
+
+// Policy evaluation statuses of an export job.
+const (
+	PolicyPending   = "pending"
+	PolicyEvaluated = "evaluated"
+	PolicyFailed    = "failed"
+)
+
+// ExportJob tracks the progress of the policy evaluations
+// which are needed to produce the data for an export.
+type ExportJob struct {
+	ID         string             `bson:"_id"`
+	ExportName string             `bson:"exportName"`
+	Status     string             `bson:"status"`
+	Policies   []*ExportJobPolicy `bson:"policies"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+	UpdatedAt  time.Time          `bson:"updatedAt"`
+
+	// Parameters are the parameter values of the export request.
+	Parameters map[string]interface{} `bson:"parameters,omitempty"`
+}
+
+type ExportJobPolicy struct {
+	Policy string `bson:"policy"`
+	Status string `bson:"status"`
+	Error  string `bson:"error,omitempty"`
+}
+
+func createExportJobIndexes(ctx context.Context, jobs *mongo.Collection) error {
+	_, err := jobs.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "createdAt", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32(exportJobTTL.Seconds())),
+		},
+		{
+			Keys: bson.D{{Key: "exportName", Value: 1}},
+		},
+	})
+	return err
+}
+
+func (s *Storage) CreateExportJob(ctx context.Context, job *ExportJob) error {
+	_, err := s.exportJobs.InsertOne(ctx, job)
+	return err
+}
+
+// ExportJob returns the export job with the given ID which belongs to the given export.
+func (s *Storage) ExportJob(ctx context.Context, exportName, id string) (*ExportJob, error) {
+	result := s.exportJobs.FindOne(ctx, bson.M{
+		"_id":        id,
+		"exportName": exportName,
+	}, options.FindOne().SetCollation(exportNameCollation))
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "export job not found")
+		}
+		return nil, result.Err()
+	}
+
+	var job ExportJob
+	if err := result.Decode(&job); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// UpdateExportJobStatus sets the overall status of an export job.
+func (s *Storage) UpdateExportJobStatus(ctx context.Context, id, status string) error {
+	_, err := s.exportJobs.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{
+			"status":    status,
+			"updatedAt": time.Now(),
+		},
+	})
+	return err
+}
+
+// UpdateExportJobPolicy sets the evaluation status of a single policy of an
+// export job. errmsg is stored only for failed evaluations.
+func (s *Storage) UpdateExportJobPolicy(ctx context.Context, id, policy, status, errmsg string) error {
+	_, err := s.exportJobs.UpdateOne(ctx, bson.M{
+		"_id":             id,
+		"policies.policy": policy,
+	}, bson.M{
+		"$set": bson.M{
+			"policies.$.status": status,
+			"policies.$.error":  errmsg,
+			"updatedAt":         time.Now(),
+		},
+	})
+	return err
+}
+
+// FailStaleExportJobs marks the unfinished export jobs which haven't made
+// progress since the given time as failed, together with their pending
+// policies. Such jobs were interrupted, e.g. by a restart of the service.
+func (s *Storage) FailStaleExportJobs(ctx context.Context, before time.Time, errmsg string) (int64, error) {
+	res, err := s.exportJobs.UpdateMany(ctx, bson.M{
+		"status":    bson.M{"$in": bson.A{JobPending, JobRunning}},
+		"updatedAt": bson.M{"$lt": before},
+	}, bson.M{
+		"$set": bson.M{
+			"status":               JobFailed,
+			"policies.$[p].status": PolicyFailed,
+			"policies.$[p].error":  errmsg,
+			"updatedAt":            time.Now(),
+		},
+	}, options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{bson.M{"p.status": PolicyPending}},
+	}))
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const exportPresentationsCollection = "exportPresentations"
+
+// ExportPresentation is the last successfully signed presentation of an export.
+type ExportPresentation struct {
+	Key          string    `bson:"_id"`
+	Presentation []byte    `bson:"presentation"` // JSON encoded verifiable presentation
+	CreatedAt    time.Time `bson:"createdAt"`
+
+	// ExpiresAt is the time at which the credentials of the
+	// presentation expire. It's empty if they don't expire.
+	ExpiresAt *time.Time `bson:"expiresAt,omitempty"`
+}
+
+// SaveExportPresentation stores the JSON encoded presentation under the given
+// key, replacing the presentation which was previously stored.
+func (s *Storage) SaveExportPresentation(ctx context.Context, key string, vp []byte, expiresAt *time.Time) error {
+	_, err := s.exportPresentations.ReplaceOne(ctx, bson.M{"_id": key}, &ExportPresentation{
+		Key:          key,
+		Presentation: vp,
+		CreatedAt:    time.Now(),
+		ExpiresAt:    expiresAt,
+	}, options.Replace().SetUpsert(true))
+	return err
+}
+
+// ExportPresentation returns the presentation stored under the given key.
+func (s *Storage) ExportPresentation(ctx context.Context, key string) (*ExportPresentation, error) {
+	result := s.exportPresentations.FindOne(ctx, bson.M{"_id": key})
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return nil, errors.New(errors.NotFound, "export presentation not found")
+		}
+		return nil, result.Err()
+	}
+
+	var vp ExportPresentation
+	if err := result.Decode(&vp); err != nil {
+		return nil, err
+	}
+
+	return &vp, nil
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const (
+	idempotentRequestsCollection = "idempotentRequests"
+
+	// idempotentRequestTTL specifies how long the results of
+	// requests with idempotency keys are kept.
+	idempotentRequestTTL = 24 * time.Hour
+)
+
+// IdempotentRequest is a request made with an idempotency key.
+// Its result is stored when the request is completed, so that
+// the result can be returned when the request is repeated.
+type IdempotentRequest struct {
+	ID          string    `bson:"_id"`         // client and idempotency key
+	RequestHash string    `bson:"requestHash"` // hash of the request data
+	Completed   bool      `bson:"completed"`
+	Result      []byte    `bson:"result,omitempty"` // JSON encoded result
+	CreatedAt   time.Time `bson:"createdAt"`
+}
+
+func createIdempotentRequestIndexes(ctx context.Context, requests *mongo.Collection) error {
+	_, err := requests.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "createdAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(int32(idempotentRequestTTL.Seconds())),
+	})
+	return err
+}
+
+// CreateIdempotentRequest records a request which is started. If a request
+// with the same ID exists, an Exist error is returned.
+func (s *Storage) CreateIdempotentRequest(ctx context.Context, req *IdempotentRequest) error {
+	_, err := s.idempotentRequests.InsertOne(ctx, req)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New(errors.Exist, "idempotent request already exists")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *Storage) IdempotentRequest(ctx context.Context, id string) (*IdempotentRequest, error) {
+	result := s.idempotentRequests.FindOne(ctx, bson.M{"_id": id})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, errors.New(errors.NotFound, "idempotent request not found")
+		}
+		return nil, result.Err()
+	}
+
+	var req IdempotentRequest
+	if err := result.Decode(&req); err != nil {
+		return nil, err
+	}
+
+	return &req, nil
+}
+
+// CompleteIdempotentRequest stores the result of a completed request.
+func (s *Storage) CompleteIdempotentRequest(ctx context.Context, id string, result []byte) error {
+	_, err := s.idempotentRequests.UpdateOne(ctx, bson.M{"_id": id}, bson.M{
+		"$set": bson.M{
+			"completed": true,
+			"result":    result,
+		},
+	})
+	return err
+}
+
+// DeleteIdempotentRequest removes a request which failed,
+// so that it can be repeated with the same idempotency key.
+func (s *Storage) DeleteIdempotentRequest(ctx context.Context, id string) error {
+	_, err := s.idempotentRequests.DeleteOne(ctx, bson.M{"_id": id})
+	return err
+}
@@ -0,0 +1,59 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const importChallengesCollection = "importChallenges"
+
+// ImportChallenge is a single-use challenge which must be
+// given in the proof of an imported presentation.
+type ImportChallenge struct {
+	Challenge string    `bson:"_id"`
+	Domain    string    `bson:"domain,omitempty"`
+	CreatedAt time.Time `bson:"createdAt"`
+	ExpiresAt time.Time `bson:"expiresAt"`
+}
+
+func createImportChallengeIndexes(ctx context.Context, challenges *mongo.Collection) error {
+	_, err := challenges.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+func (s *Storage) CreateImportChallenge(ctx context.Context, challenge *ImportChallenge) error {
+	_, err := s.importChallenges.InsertOne(ctx, challenge)
+	return err
+}
+
+// ConsumeImportChallenge removes the challenge, so that it cannot be used
+// again, and returns it. Expired challenges which are not yet removed by
+// the TTL index are not returned.
+func (s *Storage) ConsumeImportChallenge(ctx context.Context, challenge string) (*ImportChallenge, error) {
+	result := s.importChallenges.FindOneAndDelete(ctx, bson.M{
+		"_id":       challenge,
+		"expiresAt": bson.M{"$gt": time.Now()},
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, errors.New(errors.NotFound, "import challenge not found")
+		}
+		return nil, result.Err()
+	}
+
+	var c ImportChallenge
+	if err := result.Decode(&c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const importsCollection = "imports"
+
+// ImportRecord registers a credential subject imported into the Cache.
+type ImportRecord struct {
+	ID           string    `bson:"_id"`
+	Key          string    `bson:"key"` // cache key
+	Namespace    string    `bson:"namespace"`
+	Scope        string    `bson:"scope"`
+	Hash         string    `bson:"hash"` // hash of the canonical form of the credential
+	CredentialID string    `bson:"credentialId,omitempty"`
+	Issuer       string    `bson:"issuer,omitempty"`
+	Holder       string    `bson:"holder,omitempty"`    // holder of the imported presentation
+	SubjectID    string    `bson:"subjectId,omitempty"` // id of the credential subject
+	Importer     string    `bson:"importer,omitempty"`  // client which imported the credential
+	CreatedAt    time.Time `bson:"createdAt"`
+}
+
+// ImportRecordFilter selects import records. Empty fields match all records.
+type ImportRecordFilter struct {
+	Issuer    string
+	Holder    string
+	SubjectID string
+	// Importer selects the records of a client, which is
+	// empty for data imported by anonymous clients.
+	Importer *string
+	// Namespaces selects the records of data in the given namespaces.
+	Namespaces []string
+	Limit      int64
+}
+
+func createImportIndexes(ctx context.Context, imports *mongo.Collection) error {
+	_, err := imports.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "namespace", Value: 1}, {Key: "scope", Value: 1}, {Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "hash", Value: 1}, {Key: "namespace", Value: 1}, {Key: "scope", Value: 1}},
+		},
+		{Keys: bson.D{{Key: "issuer", Value: 1}}},
+		{Keys: bson.D{{Key: "holder", Value: 1}}},
+		{Keys: bson.D{{Key: "subjectId", Value: 1}}},
+		{Keys: bson.D{{Key: "importer", Value: 1}, {Key: "createdAt", Value: -1}}},
+	})
+	return err
+}
+
+// FindImportRecord returns the record of a credential with the given hash,
+// which is imported into the given Cache namespace and scope. If key is
+// given, only a record of the credential imported with this key is returned.
+func (s *Storage) FindImportRecord(ctx context.Context, hash, namespace, scope, key string) (*ImportRecord, error) {
+	filter := bson.M{
+		"hash":      hash,
+		"namespace": namespace,
+		"scope":     scope,
+	}
+	if key != "" {
+		filter["key"] = key
+	}
+
+	result := s.imports.FindOne(ctx, filter, options.FindOne().SetSort(bson.M{"createdAt": 1}))
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, errors.New(errors.NotFound, "import record not found")
+		}
+		return nil, result.Err()
+	}
+
+	var record ImportRecord
+	if err := result.Decode(&record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// ImportRecord returns the import record with the given ID.
+func (s *Storage) ImportRecord(ctx context.Context, id string) (*ImportRecord, error) {
+	result := s.imports.FindOne(ctx, bson.M{"_id": id})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, errors.New(errors.NotFound, "import record not found")
+		}
+		return nil, result.Err()
+	}
+
+	var record ImportRecord
+	if err := result.Decode(&record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// KeyImportRecord returns the record of data imported with the
+// given Cache key, namespace and scope.
+func (s *Storage) KeyImportRecord(ctx context.Context, key, namespace, scope string) (*ImportRecord, error) {
+	result := s.imports.FindOne(ctx, bson.M{
+		"namespace": namespace,
+		"scope":     scope,
+		"key":       key,
+	})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, errors.New(errors.NotFound, "import record not found")
+		}
+		return nil, result.Err()
+	}
+
+	var record ImportRecord
+	if err := result.Decode(&record); err != nil {
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// ImportRecords returns the import records selected by the filter,
+// most recent imports first.
+func (s *Storage) ImportRecords(ctx context.Context, filter *ImportRecordFilter) ([]*ImportRecord, error) {
+	query := bson.M{}
+	if filter.Issuer != "" {
+		query["issuer"] = filter.Issuer
+	}
+	if filter.Holder != "" {
+		query["holder"] = filter.Holder
+	}
+	if filter.SubjectID != "" {
+		query["subjectId"] = filter.SubjectID
+	}
+	if filter.Importer != nil {
+		// records without importer are stored without the field
+		if *filter.Importer == "" {
+			query["importer"] = bson.M{"$exists": false}
+		} else {
+			query["importer"] = *filter.Importer
+		}
+	}
+	if filter.Namespaces != nil {
+		query["namespace"] = bson.M{"$in": filter.Namespaces}
+	}
+
+	opts := options.Find().SetSort(bson.M{"createdAt": -1})
+	if filter.Limit > 0 {
+		opts.SetLimit(filter.Limit)
+	}
+
+	cursor, err := s.imports.Find(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx) //nolint:errcheck
+
+	records := []*ImportRecord{}
+	if err := cursor.All(ctx, &records); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// importRecordOptionalFields are the fields of import records which
+// are omitted when they are empty.
+var importRecordOptionalFields = []string{"credentialId", "issuer", "holder", "subjectId", "importer"}
+
+// SaveImportRecord stores the record of an import and returns the stored
+// record. A record of data previously imported with the same Cache key,
+// namespace and scope is replaced, keeping its ID. Fields which are empty
+// in the new record are removed from the stored record.
+func (s *Storage) SaveImportRecord(ctx context.Context, record *ImportRecord) (*ImportRecord, error) {
+	filter := bson.M{
+		"namespace": record.Namespace,
+		"scope":     record.Scope,
+		"key":       record.Key,
+	}
+
+	fields, err := bson.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	var set bson.M
+	if err := bson.Unmarshal(fields, &set); err != nil {
+		return nil, err
+	}
+	delete(set, "_id")
+
+	update := bson.M{
+		"$set":         set,
+		"$setOnInsert": bson.M{"_id": record.ID},
+	}
+	unset := bson.M{}
+	for _, field := range importRecordOptionalFields {
+		if _, ok := set[field]; !ok {
+			unset[field] = ""
+		}
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	result := s.imports.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	var stored ImportRecord
+	if err := result.Decode(&stored); err != nil {
+		return nil, err
+	}
+
+	return &stored, nil
+}
+
+// DeleteImportRecord removes the record of data imported with the
+// given Cache key, namespace and scope.
+func (s *Storage) DeleteImportRecord(ctx context.Context, key, namespace, scope string) error {
+	_, err := s.imports.DeleteOne(ctx, bson.M{
+		"namespace": namespace,
+		"scope":     scope,
+		"key":       key,
+	})
+	return err
+}
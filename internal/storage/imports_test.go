@@ -0,0 +1,93 @@
+package storage_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/integration/mtest"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/storage"
+)
+
+func TestStorage_SaveImportRecord(t *testing.T) {
+	mt := mtest.New(t, mtest.NewOptions().ClientType(mtest.Mock))
+	defer mt.Close()
+
+	tests := []struct {
+		name   string
+		record *storage.ImportRecord
+
+		set   []string
+		unset []string
+	}{
+		{
+			name: "re-import with other subject and importer",
+			record: &storage.ImportRecord{
+				ID:        "2",
+				Key:       "key1",
+				Namespace: "public",
+				Hash:      "hash2",
+				Issuer:    "did:web:issuer.example.com",
+				SubjectID: "did:web:other.example.com",
+				Importer:  "client2",
+			},
+			set:   []string{"issuer", "subjectId", "importer"},
+			unset: []string{"credentialId", "holder"},
+		},
+		{
+			name: "anonymous re-import without subject",
+			record: &storage.ImportRecord{
+				ID:        "2",
+				Key:       "key1",
+				Namespace: "public",
+				Hash:      "hash2",
+			},
+			unset: []string{"credentialId", "issuer", "holder", "subjectId", "importer"},
+		},
+	}
+
+	for _, test := range tests {
+		mt.Run(test.name, func(mt *mtest.T) {
+			// the storage pings the database and creates the indexes of its collections
+			for i := 0; i < 7; i++ {
+				mt.AddMockResponses(mtest.CreateSuccessResponse())
+			}
+			s, err := storage.New(mt.Client, "infohub", "exports", zap.NewNop())
+			require.NoError(t, err)
+
+			stored := bson.D{
+				{Key: "_id", Value: "1"},
+				{Key: "key", Value: "key1"},
+				{Key: "namespace", Value: "public"},
+				{Key: "hash", Value: "hash2"},
+				{Key: "createdAt", Value: time.Now()},
+			}
+			mt.AddMockResponses(mtest.CreateSuccessResponse(bson.E{Key: "value", Value: stored}))
+			mt.ClearEvents()
+
+			res, err := s.SaveImportRecord(context.Background(), test.record)
+			require.NoError(t, err)
+			assert.Equal(t, "1", res.ID)
+
+			cmd := mt.GetStartedEvent().Command
+			update := cmd.Lookup("update").Document()
+			for _, field := range test.set {
+				_, err := update.LookupErr("$set", field)
+				assert.NoError(t, err, field)
+				_, err = update.LookupErr("$unset", field)
+				assert.Error(t, err, field)
+			}
+			for _, field := range test.unset {
+				_, err := update.LookupErr("$unset", field)
+				assert.NoError(t, err, field)
+				_, err = update.LookupErr("$set", field)
+				assert.Error(t, err, field)
+			}
+		})
+	}
+}
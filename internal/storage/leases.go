@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const leasesCollection = "leases"
+
+func createLeaseIndexes(ctx context.Context, leases *mongo.Collection) error {
+	_, err := leases.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "expiresAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	})
+	return err
+}
+
+// AcquireLease acquires or renews the lease with the given name for the given
+// holder. It returns false if the lease is currently held by someone else.
+// Lease holders are elected by inserting the lease document with a unique ID,
+// so only one of the competing holders can acquire an expired lease.
+func (s *Storage) AcquireLease(ctx context.Context, name, holder string, ttl time.Duration) (bool, error) {
+	now := time.Now()
+	_, err := s.leases.UpdateOne(ctx, bson.M{
+		"_id": name,
+		"$or": bson.A{
+			bson.M{"holder": holder},
+			bson.M{"expiresAt": bson.M{"$lte": now}},
+		},
+	}, bson.M{
+		"$set": bson.M{
+			"holder":    holder,
+			"expiresAt": now.Add(ttl),
+		},
+	}, options.Update().SetUpsert(true))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// LeaseHolder returns the holder of the lease with the given name. If the
+// lease is not held by anyone, a NotFound error is returned.
+func (s *Storage) LeaseHolder(ctx context.Context, name string) (string, error) {
+	result := s.leases.FindOne(ctx, bson.M{
+		"_id":       name,
+		"expiresAt": bson.M{"$gt": time.Now()},
+	})
+
+	if result.Err() != nil {
+		if strings.Contains(result.Err().Error(), "no documents in result") {
+			return "", errors.New(errors.NotFound, "lease not found")
+		}
+		return "", result.Err()
+	}
+
+	var lease struct {
+		Holder string `bson:"holder"`
+	}
+	if err := result.Decode(&lease); err != nil {
+		return "", err
+	}
+
+	return lease.Holder, nil
+}
+
+// ReleaseLease releases the lease with the given name, if it is held by the
+// given holder, so that others can acquire it before it expires.
+func (s *Storage) ReleaseLease(ctx context.Context, name, holder string) error {
+	_, err := s.leases.DeleteOne(ctx, bson.M{"_id": name, "holder": holder})
+	return err
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
+)
+
+const schemasCollection = "schemas"
+
+// Schema returns the JSON encoded document with the given ID from the
+// collection of schemas. Documents are stored as
+// {"_id": "https://example.com/schema.json", "schema": {...}}.
+func (s *Storage) Schema(ctx context.Context, id string) ([]byte, error) {
+	result := s.schemas.FindOne(ctx, bson.M{"_id": id})
+	if result.Err() != nil {
+		if result.Err() == mongo.ErrNoDocuments {
+			return nil, errors.New(errors.NotFound, "schema not found")
+		}
+		return nil, result.Err()
+	}
+
+	var doc struct {
+		Schema bson.Raw `bson:"schema"`
+	}
+	if err := result.Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc.Schema == nil {
+		return nil, errors.New(errors.NotFound, "schema not found")
+	}
+
+	return bson.MarshalExtJSON(doc.Schema, false, false)
+}
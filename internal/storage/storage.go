@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -12,38 +13,216 @@ import (
 	errors "github.com/eclipse-xfsc/microservice-core-go/pkg/err"
 )
 
+// exportNameCollation makes export names case-insensitive.
+var exportNameCollation = &options.Collation{
+	Locale:   "en",
+	Strength: 2,
+}
+
+// Layouts of the credentials in an exported presentation.
+const (
+	// LayoutCredentialPerPolicy exports the result of each policy as a separate credential.
+	LayoutCredentialPerPolicy = "credentialPerPolicy"
+	// LayoutMerged exports the results of all policies in the subject of a single credential.
+	LayoutMerged = "merged"
+)
+
+// Formats of the exported data.
+const (
+	// FormatJSONLD exports a JSON-LD presentation with linked data proofs.
+	FormatJSONLD = "jsonld"
+	// FormatJWT exports a VC-JWT presentation of VC-JWT credentials.
+	FormatJWT = "jwt"
+	// FormatSDJWT exports a single SD-JWT VC credential.
+	FormatSDJWT = "sd-jwt"
+	// FormatJSON exports the unsigned policy results.
+	FormatJSON = "json"
+)
+
 type ExportConfiguration struct {
-	ExportName   string
-	Contexts     []string
-	Policies     map[string]interface{}
-	CacheTTL     *int
-	Issuer       string // issuer DID
-	KeyNamespace string // signing key namespace
-	Key          string // signing key name
+	ExportName       string                 `bson:"exportName"`
+	Contexts         []string               `bson:"contexts,omitempty"`
+	Policies         map[string]interface{} `bson:"policies"`
+	CacheTTL         *int                   `bson:"cacheTTL,omitempty"`
+	Issuer           string                 `bson:"issuer"`                     // issuer DID
+	KeyNamespace     string                 `bson:"keyNamespace"`               // signing key namespace
+	Key              string                 `bson:"key"`                        // signing key name
+	Layout           string                 `bson:"layout,omitempty"`           // credentials layout in the exported presentation
+	CredentialTypes  []string               `bson:"credentialTypes,omitempty"`  // types added to the exported credentials
+	CredentialSchema *CredentialSchema      `bson:"credentialSchema,omitempty"` // schema of the exported credentials
+
+	// StaleWhileRevalidate enables serving the last signed presentation
+	// of the export while its data is evaluated again.
+	StaleWhileRevalidate bool `bson:"staleWhileRevalidate,omitempty"`
+
+	// Schedule is a cron expression specifying when the export data is
+	// evaluated in advance, so that it's available in the Cache before
+	// the previous results expire.
+	Schedule string `bson:"schedule,omitempty"`
+
+	// Parameters declare the values which can be given when requesting
+	// the export and which are templated into the policy input.
+	Parameters []*ExportParameter `bson:"parameters,omitempty"`
+
+	// Authorization restricts which clients can perform the export.
+	Authorization *ExportAuthorization `bson:"authorization,omitempty"`
+
+	// ResultSchema identifies the JSON schema which each policy
+	// result must satisfy before it is exported.
+	ResultSchema string `bson:"resultSchema,omitempty"`
+
+	// Format of the exported data, unless another format is requested.
+	Format string `bson:"format,omitempty"`
+
+	// SelectiveDisclosure lists the claims of the credential subject
+	// which are selectively disclosable in SD-JWT exports.
+	SelectiveDisclosure []string `bson:"selectiveDisclosure,omitempty"`
+
+	// PolicyOrder lists the names of the policies in the order
+	// in which their results are exported.
+	PolicyOrder []string `bson:"policyOrder,omitempty"`
+}
+
+// ExportAuthorization specifies the rules which the token of a client
+// must satisfy in order to perform an export. All given rules must be
+// satisfied.
+type ExportAuthorization struct {
+	Scopes []string          `bson:"scopes,omitempty"` // scopes the token must grant
+	Claims map[string]string `bson:"claims,omitempty"` // claims the token must have with the given values
+	Policy string            `bson:"policy,omitempty"` // policy evaluated with the token claims
+}
+
+// Types of export parameter values.
+const (
+	ParameterString  = "string"
+	ParameterNumber  = "number"
+	ParameterInteger = "integer"
+	ParameterBoolean = "boolean"
+)
+
+// ExportParameter declares a parameter of an export.
+type ExportParameter struct {
+	Name        string      `bson:"name"`
+	Type        string      `bson:"type"`
+	Required    bool        `bson:"required,omitempty"`
+	Pattern     string      `bson:"pattern,omitempty"`
+	Default     interface{} `bson:"default,omitempty"`
+	Description string      `bson:"description,omitempty"`
+}
+
+// CredentialSchema references the schema of the exported credentials.
+type CredentialSchema struct {
+	ID   string `bson:"id"`
+	Type string `bson:"type"`
+}
+
+// RequiredParameters returns the names of the required parameters without
+// default value, which must be given by every request of the export.
+func (c *ExportConfiguration) RequiredParameters() []string {
+	var names []string
+	for _, param := range c.Parameters {
+		if param.Required && param.Default == nil {
+			names = append(names, param.Name)
+		}
+	}
+	return names
+}
+
+// PolicyNames returns the names of the export policies in the order
+// in which their results are exported. Policies are ordered by the
+// policy order of the configuration, or by name if it has none.
+func (c *ExportConfiguration) PolicyNames() []string {
+	if len(c.PolicyOrder) == len(c.Policies) {
+		ordered := true
+		for _, name := range c.PolicyOrder {
+			if _, ok := c.Policies[name]; !ok {
+				ordered = false
+				break
+			}
+		}
+		if ordered {
+			return c.PolicyOrder
+		}
+	}
+
+	names := make([]string, 0, len(c.Policies))
+	for name := range c.Policies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 type Storage struct {
-	exportConfig *mongo.Collection
-	logger       *zap.Logger
+	exportConfig        *mongo.Collection
+	exportJobs          *mongo.Collection
+	exportPresentations *mongo.Collection
+	leases              *mongo.Collection
+	trustedIssuers      *mongo.Collection
+	schemas             *mongo.Collection
+	importChallenges    *mongo.Collection
+	imports             *mongo.Collection
+	idempotentRequests  *mongo.Collection
+	logger              *zap.Logger
 }
 
 func New(db *mongo.Client, dbname, collection string, logger *zap.Logger) (*Storage, error) {
 	if err := db.Ping(context.Background(), nil); err != nil {
 		return nil, err
 	}
+
+	exportConfig := db.Database(dbname).Collection(collection)
+	_, err := exportConfig.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "exportName", Value: 1}},
+		Options: options.Index().SetUnique(true).SetCollation(exportNameCollation),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	exportJobs := db.Database(dbname).Collection(exportJobsCollection)
+	if err := createExportJobIndexes(context.Background(), exportJobs); err != nil {
+		return nil, err
+	}
+
+	leases := db.Database(dbname).Collection(leasesCollection)
+	if err := createLeaseIndexes(context.Background(), leases); err != nil {
+		return nil, err
+	}
+
+	importChallenges := db.Database(dbname).Collection(importChallengesCollection)
+	if err := createImportChallengeIndexes(context.Background(), importChallenges); err != nil {
+		return nil, err
+	}
+
+	imports := db.Database(dbname).Collection(importsCollection)
+	if err := createImportIndexes(context.Background(), imports); err != nil {
+		return nil, err
+	}
+
+	idempotentRequests := db.Database(dbname).Collection(idempotentRequestsCollection)
+	if err := createIdempotentRequestIndexes(context.Background(), idempotentRequests); err != nil {
+		return nil, err
+	}
+
 	return &Storage{
-		exportConfig: db.Database(dbname).Collection(collection),
-		logger:       logger,
+		exportConfig:        exportConfig,
+		exportJobs:          exportJobs,
+		exportPresentations: db.Database(dbname).Collection(exportPresentationsCollection),
+		leases:              leases,
+		trustedIssuers:      db.Database(dbname).Collection(trustedIssuersCollection),
+		schemas:             db.Database(dbname).Collection(schemasCollection),
+		importChallenges:    importChallenges,
+		imports:             imports,
+		idempotentRequests:  idempotentRequests,
+		logger:              logger,
 	}, nil
 }
 
 func (s *Storage) ExportConfiguration(ctx context.Context, exportName string) (*ExportConfiguration, error) {
 	result := s.exportConfig.FindOne(ctx, bson.M{
 		"exportName": exportName,
-	}, options.FindOne().SetCollation(&options.Collation{
-		Locale:   "en",
-		Strength: 2,
-	}))
+	}, options.FindOne().SetCollation(exportNameCollation))
 
 	if result.Err() != nil {
 		if strings.Contains(result.Err().Error(), "no documents in result") {
@@ -59,3 +238,68 @@ func (s *Storage) ExportConfiguration(ctx context.Context, exportName string) (*
 
 	return &expcfg, nil
 }
+
+// ExportConfigurations returns all export configurations sorted by name.
+func (s *Storage) ExportConfigurations(ctx context.Context) ([]*ExportConfiguration, error) {
+	cursor, err := s.exportConfig.Find(ctx, bson.M{}, options.Find().
+		SetSort(bson.M{"exportName": 1}).
+		SetCollation(exportNameCollation),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx) //nolint:errcheck
+
+	var configs []*ExportConfiguration
+	if err := cursor.All(ctx, &configs); err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
+
+// CreateExportConfiguration inserts a new export configuration. If an export
+// with the same name already exists, an Exist error is returned.
+func (s *Storage) CreateExportConfiguration(ctx context.Context, cfg *ExportConfiguration) error {
+	_, err := s.exportConfig.InsertOne(ctx, cfg)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return errors.New(errors.Exist, "export configuration already exists")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UpdateExportConfiguration replaces an existing export configuration.
+func (s *Storage) UpdateExportConfiguration(ctx context.Context, cfg *ExportConfiguration) error {
+	result, err := s.exportConfig.ReplaceOne(ctx, bson.M{
+		"exportName": cfg.ExportName,
+	}, cfg, options.Replace().SetCollation(exportNameCollation))
+	if err != nil {
+		return err
+	}
+
+	if result.MatchedCount == 0 {
+		return errors.New(errors.NotFound, "export configuration not found")
+	}
+
+	return nil
+}
+
+// DeleteExportConfiguration removes an export configuration.
+func (s *Storage) DeleteExportConfiguration(ctx context.Context, exportName string) error {
+	result, err := s.exportConfig.DeleteOne(ctx, bson.M{
+		"exportName": exportName,
+	}, options.Delete().SetCollation(exportNameCollation))
+	if err != nil {
+		return err
+	}
+
+	if result.DeletedCount == 0 {
+		return errors.New(errors.NotFound, "export configuration not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const trustedIssuersCollection = "trustedIssuers"
+
+// TrustedIssuer is an issuer whose credentials are accepted on import.
+type TrustedIssuer struct {
+	Issuer      string `bson:"_id"` // issuer DID or URI
+	Description string `bson:"description,omitempty"`
+}
+
+// IsTrustedIssuer reports whether the issuer is stored in the
+// collection of trusted issuers.
+func (s *Storage) IsTrustedIssuer(ctx context.Context, issuer string) (bool, error) {
+	n, err := s.trustedIssuers.CountDocuments(ctx, bson.M{"_id": issuer})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
@@ -0,0 +1,49 @@
+// Package trust provides the trust anchors which decide whether
+// the issuers of imported credentials are trusted.
+package trust
+
+import (
+	"context"
+)
+
+// Anchors decide whether an issuer is trusted.
+type Anchors interface {
+	IsTrustedIssuer(ctx context.Context, issuer string) (bool, error)
+}
+
+// Static trusts a fixed set of issuers.
+type Static map[string]bool
+
+// NewStatic returns trust anchors trusting the given issuers.
+func NewStatic(issuers ...string) Static {
+	s := make(Static, len(issuers))
+	for _, issuer := range issuers {
+		s[issuer] = true
+	}
+	return s
+}
+
+func (s Static) IsTrustedIssuer(_ context.Context, issuer string) (bool, error) {
+	return s[issuer], nil
+}
+
+type anyOf []Anchors
+
+// Any returns trust anchors trusting the issuers which are trusted by any
+// of the given anchors. The anchors are asked in the given order.
+func Any(anchors ...Anchors) Anchors {
+	return anyOf(anchors)
+}
+
+func (a anyOf) IsTrustedIssuer(ctx context.Context, issuer string) (bool, error) {
+	for _, anchors := range a {
+		trusted, err := anchors.IsTrustedIssuer(ctx, issuer)
+		if err != nil {
+			return false, err
+		}
+		if trusted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
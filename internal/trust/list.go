@@ -0,0 +1,190 @@
+package trust
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+// maxListSize limits the size of trust list documents.
+const maxListSize = 10 << 20
+
+// List trusts the issuers of a trust list document, which is loaded from
+// a file or URL, e.g. an export of the Gaia-X registry. The document is
+// loaded again in background when it is older than the refresh interval,
+// while the previously loaded issuers are used. If loading fails, they are
+// kept.
+//
+// The document is a JSON array of issuers, or an object with the array in
+// one of the fields "trustedIssuers", "issuers", "trustAnchors" or "items".
+// Issuers are given as strings or as objects with an "id", "did" or "issuer".
+type List struct {
+	source     string
+	refresh    time.Duration
+	httpClient *http.Client
+	logger     *zap.Logger
+
+	// the document is loaded only once for concurrent requests
+	group    singleflight.Group
+	mu       sync.Mutex
+	issuers  map[string]bool
+	loadedAt time.Time
+}
+
+// NewList loads the trust list document from the given source, which is
+// a local file path or an HTTP(S) URL.
+func NewList(ctx context.Context, source string, refresh time.Duration, httpClient *http.Client, logger *zap.Logger) (*List, error) {
+	l := &List{
+		source:     source,
+		refresh:    refresh,
+		httpClient: httpClient,
+		logger:     logger,
+	}
+
+	issuers, err := l.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	l.issuers, l.loadedAt = issuers, time.Now()
+
+	return l, nil
+}
+
+func (l *List) IsTrustedIssuer(ctx context.Context, issuer string) (bool, error) {
+	if l.expired() {
+		// the refresh must not be canceled with the request which triggered it
+		ctx := context.WithoutCancel(ctx)
+		l.group.DoChan("refresh", func() (interface{}, error) {
+			// the list may have been refreshed in the meantime
+			if l.expired() {
+				l.reload(ctx)
+			}
+			return nil, nil
+		})
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.issuers[issuer], nil
+}
+
+// expired reports whether the document is older than the refresh interval.
+func (l *List) expired() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.refresh > 0 && time.Since(l.loadedAt) > l.refresh
+}
+
+// reload loads the document again and replaces the issuers, if it
+// could be loaded. Failed loads are retried after the refresh interval.
+func (l *List) reload(ctx context.Context) {
+	issuers, err := l.load(ctx)
+	if err != nil {
+		l.logger.Error("error refreshing trust list", zap.String("source", l.source), zap.Error(err))
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if err == nil {
+		l.issuers = issuers
+	}
+	l.loadedAt = time.Now()
+}
+
+func (l *List) load(ctx context.Context) (map[string]bool, error) {
+	var (
+		b   []byte
+		err error
+	)
+	if strings.HasPrefix(l.source, "http://") || strings.HasPrefix(l.source, "https://") {
+		b, err = l.fetch(ctx)
+	} else {
+		b, err = os.ReadFile(l.source)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error loading trust list: %w", err)
+	}
+
+	issuers, err := parseList(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid trust list: %w", err)
+	}
+
+	return issuers, nil
+}
+
+func (l *List) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", l.source, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := l.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() // nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxListSize))
+}
+
+func parseList(b []byte) (map[string]bool, error) {
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	entries, ok := doc.([]interface{})
+	if !ok {
+		obj, isObj := doc.(map[string]interface{})
+		if !isObj {
+			return nil, fmt.Errorf("unexpected document type %T", doc)
+		}
+		for _, field := range []string{"trustedIssuers", "issuers", "trustAnchors", "items"} {
+			if entries, ok = obj[field].([]interface{}); ok {
+				break
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("document doesn't contain a list of issuers")
+		}
+	}
+
+	issuers := make(map[string]bool, len(entries))
+	for i, entry := range entries {
+		issuer := entryIssuer(entry)
+		if issuer == "" {
+			return nil, fmt.Errorf("entry %d doesn't contain an issuer", i)
+		}
+		issuers[issuer] = true
+	}
+
+	return issuers, nil
+}
+
+func entryIssuer(entry interface{}) string {
+	switch e := entry.(type) {
+	case string:
+		return e
+	case map[string]interface{}:
+		for _, field := range []string{"id", "did", "issuer"} {
+			if id, ok := e[field].(string); ok && id != "" {
+				return id
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,171 @@
+package trust_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/eclipse-xfsc/trusted-info-hub/internal/trust"
+)
+
+func TestAny(t *testing.T) {
+	anchors := trust.Any(trust.NewStatic("did:web:a.example.com"), trust.NewStatic("did:web:b.example.com"))
+
+	for issuer, expected := range map[string]bool{
+		"did:web:a.example.com": true,
+		"did:web:b.example.com": true,
+		"did:web:c.example.com": false,
+	} {
+		trusted, err := anchors.IsTrustedIssuer(context.Background(), issuer)
+		require.NoError(t, err)
+		assert.Equal(t, expected, trusted, issuer)
+	}
+}
+
+func TestNewList(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+
+		errtext string
+		trusted []string
+	}{
+		{
+			name:    "array of issuers",
+			doc:     `["did:web:a.example.com", {"id": "did:web:b.example.com"}]`,
+			trusted: []string{"did:web:a.example.com", "did:web:b.example.com"},
+		},
+		{
+			name:    "registry document",
+			doc:     `{"trustedIssuers": [{"did": "did:web:a.example.com", "name": "A"}, {"issuer": "https://b.example.com"}]}`,
+			trusted: []string{"did:web:a.example.com", "https://b.example.com"},
+		},
+		{
+			name:    "document without issuers",
+			doc:     `{"participants": []}`,
+			errtext: "document doesn't contain a list of issuers",
+		},
+		{
+			name:    "entry without issuer",
+			doc:     `[{"name": "A"}]`,
+			errtext: "entry 0 doesn't contain an issuer",
+		},
+		{
+			name:    "invalid document",
+			doc:     `not json`,
+			errtext: "invalid trust list",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "trustlist.json")
+			require.NoError(t, os.WriteFile(path, []byte(test.doc), 0o600))
+
+			list, err := trust.NewList(context.Background(), path, time.Hour, http.DefaultClient, zap.NewNop())
+			if test.errtext != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.errtext)
+				return
+			}
+
+			require.NoError(t, err)
+			for _, issuer := range test.trusted {
+				trusted, err := list.IsTrustedIssuer(context.Background(), issuer)
+				require.NoError(t, err)
+				assert.True(t, trusted, issuer)
+			}
+			trusted, err := list.IsTrustedIssuer(context.Background(), "did:web:other.example.com")
+			require.NoError(t, err)
+			assert.False(t, trusted)
+		})
+	}
+}
+
+func TestList_Refresh(t *testing.T) {
+	var version atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch version.Load() {
+		case 0:
+			_, _ = w.Write([]byte(`["did:web:a.example.com"]`))
+		case 1:
+			_, _ = w.Write([]byte(`["did:web:b.example.com"]`))
+		default:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer srv.Close()
+
+	list, err := trust.NewList(context.Background(), srv.URL, 50*time.Millisecond, srv.Client(), zap.NewNop())
+	require.NoError(t, err)
+
+	trusted, _ := list.IsTrustedIssuer(context.Background(), "did:web:a.example.com")
+	assert.True(t, trusted)
+
+	// the refreshed list replaces the previous one
+	version.Store(1)
+	time.Sleep(100 * time.Millisecond)
+	assert.Eventually(t, func() bool {
+		trusted, _ := list.IsTrustedIssuer(context.Background(), "did:web:b.example.com")
+		return trusted
+	}, time.Second, 10*time.Millisecond)
+	trusted, _ = list.IsTrustedIssuer(context.Background(), "did:web:a.example.com")
+	assert.False(t, trusted)
+
+	// the list is kept when refreshing fails
+	version.Store(2)
+	time.Sleep(100 * time.Millisecond)
+	for i := 0; i < 5; i++ {
+		trusted, _ = list.IsTrustedIssuer(context.Background(), "did:web:b.example.com")
+		assert.True(t, trusted)
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestList_BackgroundRefresh(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) > 1 {
+			time.Sleep(200 * time.Millisecond)
+			_, _ = w.Write([]byte(`["did:web:b.example.com"]`))
+			return
+		}
+		_, _ = w.Write([]byte(`["did:web:a.example.com"]`))
+	}))
+	defer srv.Close()
+
+	list, err := trust.NewList(context.Background(), srv.URL, 50*time.Millisecond, srv.Client(), zap.NewNop())
+	require.NoError(t, err)
+	time.Sleep(100 * time.Millisecond)
+
+	// concurrent requests don't wait for the refresh of the expired list,
+	// which is loaded only once
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			trusted, _ := list.IsTrustedIssuer(context.Background(), "did:web:a.example.com")
+			assert.True(t, trusted)
+		}()
+	}
+	wg.Wait()
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		trusted, _ := list.IsTrustedIssuer(context.Background(), "did:web:b.example.com")
+		return trusted
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, int32(2), calls.Load())
+}